@@ -0,0 +1,433 @@
+// Package arrow builds Apache Arrow record batches from parsed records, with a
+// schema derived from the message descriptor, and writes them in the Arrow
+// IPC streaming format, so that partner files reach analytics tooling, such
+// as pyarrow, DuckDB or a Parquet writer, without a JSON step in between.
+//
+// A RecordBuilder appends messages to columns in the Arrow memory layout, and
+// NewRecord takes the batch built so far. A Writer streams batches of records
+// as they are parsed.
+//
+// Fields map to Arrow types as follows:
+//
+//   - string to utf8, bytes to binary, bool to boolean, float and double
+//     to float32 and float64;
+//   - int32 to int32 and other signed integers to int64, uint32 to uint32
+//     and uint64 to uint64;
+//   - enums to utf8 of the proto value names;
+//   - j5 dates to date32;
+//   - j5 decimals to decimal128 when the field has a fixed_scale, its
+//     precision from max_digits or the width of the field, or else to utf8;
+//   - wrapper types to their value;
+//   - other messages to structs and repeated fields to lists.
+//
+// Fields with presence, such as messages, wrappers and proto3 optional
+// fields, are nullable, null when unset. Other fields are not nullable, and
+// unset is their zero value, as in proto3. Map fields and recursive messages
+// have no Arrow type, and are an error.
+package arrow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Type identifies an Arrow data type.
+type Type int
+
+const (
+	Utf8 Type = iota + 1
+	Binary
+	Boolean
+	Int32
+	Int64
+	Uint32
+	Uint64
+	Float32
+	Float64
+	Date32
+	Decimal128
+	Struct
+	List
+)
+
+var typeNames = map[Type]string{
+	Utf8:       "utf8",
+	Binary:     "binary",
+	Boolean:    "bool",
+	Int32:      "int32",
+	Int64:      "int64",
+	Uint32:     "uint32",
+	Uint64:     "uint64",
+	Float32:    "float32",
+	Float64:    "float64",
+	Date32:     "date32",
+	Decimal128: "decimal128",
+	Struct:     "struct",
+	List:       "list",
+}
+
+func (t Type) String() string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("Type(%d)", int(t))
+}
+
+// width is the size in bytes of each value of fixed width types, or 0.
+func (t Type) width() int {
+	switch t {
+	case Int32, Uint32, Float32, Date32:
+		return 4
+	case Int64, Uint64, Float64:
+		return 8
+	case Decimal128:
+		return 16
+	default:
+		return 0
+	}
+}
+
+// DataType is an Arrow type, with the precision and scale of decimals.
+type DataType struct {
+	Type      Type
+	Precision int32
+	Scale     int32
+}
+
+func (t DataType) String() string {
+	if t.Type == Decimal128 {
+		return fmt.Sprintf("decimal128(%d, %d)", t.Precision, t.Scale)
+	}
+	return t.Type.String()
+}
+
+// Field is a column of a schema, or a child of a struct or list column.
+type Field struct {
+	Name     string
+	Type     DataType
+	Nullable bool
+
+	// Children are the fields of a struct, or the one item field of a list.
+	Children []*Field
+}
+
+// Schema is the fields of the records built from one message type, a column
+// for each field of the message in the order it declares them.
+type Schema struct {
+	Fields []*Field
+}
+
+// Record is a batch of rows, with an array for each field of the schema.
+type Record struct {
+	Schema  *Schema
+	NumRows int
+	Columns []*Array
+}
+
+// Array holds the values of one column of a record, or of the children of a
+// struct or list column, in the Arrow memory layout.
+type Array struct {
+	Field     *Field
+	Len       int
+	NullCount int
+
+	// Validity has a bit for each value, least significant first, set when
+	// the value is not null. It is nil when no value is null.
+	Validity []byte
+
+	// Offsets are the start of each value, and the end of the last, in Data
+	// for utf8 and binary arrays, or in the child of list arrays.
+	Offsets []int32
+
+	// Data holds the values of fixed width types, little endian, booleans as
+	// a bitmap like Validity, and the bytes of utf8 and binary values.
+	Data []byte
+
+	// Children are the arrays of the fields of a struct, or of the items of
+	// a list.
+	Children []*Array
+}
+
+// IsNull reports whether the value at idx is null.
+func (a *Array) IsNull(idx int) bool {
+	return a.Validity != nil && a.Validity[idx/8]&(1<<(idx%8)) == 0
+}
+
+// Bool returns the value at idx of a boolean array.
+func (a *Array) Bool(idx int) bool {
+	return a.Data[idx/8]&(1<<(idx%8)) != 0
+}
+
+// Int32 returns the value at idx of an int32 or date32 array, dates as days
+// since 1970-01-01.
+func (a *Array) Int32(idx int) int32 {
+	return int32(binary.LittleEndian.Uint32(a.Data[idx*4:]))
+}
+
+// Int64 returns the value at idx of an int64 array.
+func (a *Array) Int64(idx int) int64 {
+	return int64(binary.LittleEndian.Uint64(a.Data[idx*8:]))
+}
+
+// Uint32 returns the value at idx of a uint32 array.
+func (a *Array) Uint32(idx int) uint32 {
+	return binary.LittleEndian.Uint32(a.Data[idx*4:])
+}
+
+// Uint64 returns the value at idx of a uint64 array.
+func (a *Array) Uint64(idx int) uint64 {
+	return binary.LittleEndian.Uint64(a.Data[idx*8:])
+}
+
+// Float32 returns the value at idx of a float32 array.
+func (a *Array) Float32(idx int) float32 {
+	return math.Float32frombits(a.Uint32(idx))
+}
+
+// Float64 returns the value at idx of a float64 array.
+func (a *Array) Float64(idx int) float64 {
+	return math.Float64frombits(a.Uint64(idx))
+}
+
+// Bytes returns the value at idx of a utf8 or binary array, which is part of
+// Data and must not be modified.
+func (a *Array) Bytes(idx int) []byte {
+	return a.Data[a.Offsets[idx]:a.Offsets[idx+1]]
+}
+
+// Decimal returns the unscaled value at idx of a decimal128 array.
+func (a *Array) Decimal(idx int) *big.Int {
+	data := a.Data[idx*16 : idx*16+16]
+	be := make([]byte, 16)
+	for i := range be {
+		be[i] = data[15-i]
+	}
+	n := new(big.Int).SetBytes(be)
+	if be[0]&0x80 != 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	return n
+}
+
+// ListRange returns the items of the list at idx, as the range of indexes of
+// the child array.
+func (a *Array) ListRange(idx int) (start, end int) {
+	return int(a.Offsets[idx]), int(a.Offsets[idx+1])
+}
+
+// RecordBuilder appends messages of one type to the columns of a record.
+type RecordBuilder struct {
+	desc    protoreflect.MessageDescriptor
+	schema  *Schema
+	fields  []protoreflect.FieldDescriptor
+	columns []*arrayBuilder
+	rows    int
+}
+
+// NewRecordBuilder derives the Arrow schema of the message and resolves how
+// each field is appended.
+func NewRecordBuilder(desc protoreflect.MessageDescriptor) (*RecordBuilder, error) {
+	b := &RecordBuilder{
+		desc:   desc,
+		schema: &Schema{},
+	}
+	resolve := &resolver{active: map[protoreflect.FullName]bool{desc.FullName(): true}}
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		column, err := resolve.field(fieldDesc)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", fieldDesc.FullName(), err)
+		}
+		b.schema.Fields = append(b.schema.Fields, column.field)
+		b.fields = append(b.fields, fieldDesc)
+		b.columns = append(b.columns, column)
+	}
+	return b, nil
+}
+
+// Descriptor returns the message type of the builder.
+func (b *RecordBuilder) Descriptor() protoreflect.MessageDescriptor {
+	return b.desc
+}
+
+// Schema returns the Arrow schema of the records.
+func (b *RecordBuilder) Schema() *Schema {
+	return b.schema
+}
+
+// Len returns the number of rows appended since the last NewRecord.
+func (b *RecordBuilder) Len() int {
+	return b.rows
+}
+
+// Append adds the message as a row. A message which fails leaves the
+// columns as they were.
+func (b *RecordBuilder) Append(msg proto.Message) error {
+	refl := msg.ProtoReflect()
+	if refl.Descriptor().FullName() != b.desc.FullName() {
+		return fmt.Errorf("builder for %s cannot append %s", b.desc.FullName(), refl.Descriptor().FullName())
+	}
+	for idx, fieldDesc := range b.fields {
+		if err := b.columns[idx].appendValue(fieldValue(refl, fieldDesc)); err != nil {
+			for _, column := range b.columns[:idx+1] {
+				column.truncate(b.rows)
+			}
+			return fmt.Errorf("%s: %w", fieldDesc.Name(), err)
+		}
+	}
+	b.rows++
+	return nil
+}
+
+// NewRecord returns the rows appended since the last call as a record, and
+// starts the next.
+func (b *RecordBuilder) NewRecord() *Record {
+	rec := &Record{
+		Schema:  b.schema,
+		NumRows: b.rows,
+		Columns: make([]*Array, len(b.columns)),
+	}
+	for idx, column := range b.columns {
+		rec.Columns[idx] = column.finish()
+	}
+	b.rows = 0
+	return rec
+}
+
+// fieldValue is the value of the field, or an invalid value when a field
+// with presence is unset.
+func fieldValue(msg protoreflect.Message, fieldDesc protoreflect.FieldDescriptor) protoreflect.Value {
+	if nullable(fieldDesc) && !msg.Has(fieldDesc) {
+		return protoreflect.Value{}
+	}
+	return msg.Get(fieldDesc)
+}
+
+func nullable(fieldDesc protoreflect.FieldDescriptor) bool {
+	return fieldDesc.HasPresence() && !fieldDesc.IsList() && !fieldDesc.IsMap()
+}
+
+// arrayBuilder appends values to one array.
+type arrayBuilder struct {
+	field *Field
+	width int
+
+	length    int
+	nullCount int
+	validity  []byte
+	offsets   []int32
+	data      []byte
+	children  []*arrayBuilder
+
+	// appendData writes the data of a value which is not null, or of the
+	// zero value when val is invalid.
+	appendData func(b *arrayBuilder, val protoreflect.Value) error
+}
+
+func newArrayBuilder(field *Field, appendData func(b *arrayBuilder, val protoreflect.Value) error) *arrayBuilder {
+	b := &arrayBuilder{
+		field:      field,
+		width:      field.Type.Type.width(),
+		appendData: appendData,
+	}
+	switch field.Type.Type {
+	case Utf8, Binary, List:
+		b.offsets = []int32{0}
+	}
+	return b
+}
+
+// appendValue appends the value, or a null when it is invalid.
+func (b *arrayBuilder) appendValue(val protoreflect.Value) error {
+	if err := b.appendData(b, val); err != nil {
+		return err
+	}
+	b.appendValidity(val.IsValid())
+	return nil
+}
+
+func (b *arrayBuilder) appendValidity(valid bool) {
+	if b.length%8 == 0 {
+		b.validity = append(b.validity, 0)
+	}
+	if valid {
+		b.validity[b.length/8] |= 1 << (b.length % 8)
+	} else {
+		b.nullCount++
+	}
+	b.length++
+}
+
+// truncate drops the values after the first length, after a row fails part
+// way through.
+func (b *arrayBuilder) truncate(length int) {
+	if length >= b.length {
+		return
+	}
+	switch b.field.Type.Type {
+	case Utf8, Binary:
+		b.data = b.data[:b.offsets[length]]
+		b.offsets = b.offsets[:length+1]
+	case List:
+		b.children[0].truncate(int(b.offsets[length]))
+		b.offsets = b.offsets[:length+1]
+	case Struct:
+		for _, child := range b.children {
+			child.truncate(length)
+		}
+	case Boolean:
+		b.data = truncateBitmap(b.data, length)
+	default:
+		b.data = b.data[:length*b.width]
+	}
+	b.validity = truncateBitmap(b.validity, length)
+	b.length = length
+	b.nullCount = length
+	for _, word := range b.validity {
+		b.nullCount -= bits.OnesCount8(word)
+	}
+}
+
+func truncateBitmap(bitmap []byte, length int) []byte {
+	bitmap = bitmap[:(length+7)/8]
+	if length%8 != 0 {
+		bitmap[length/8] &= 1<<(length%8) - 1
+	}
+	return bitmap
+}
+
+// finish returns the array built so far, and starts the next.
+func (b *arrayBuilder) finish() *Array {
+	arr := &Array{
+		Field:     b.field,
+		Len:       b.length,
+		NullCount: b.nullCount,
+		Offsets:   b.offsets,
+		Data:      b.data,
+	}
+	if b.nullCount > 0 {
+		arr.Validity = b.validity
+	}
+	if b.field.Type.Type == Boolean && arr.Data == nil {
+		arr.Data = []byte{}
+	}
+	for _, child := range b.children {
+		arr.Children = append(arr.Children, child.finish())
+	}
+
+	b.length = 0
+	b.nullCount = 0
+	b.validity = nil
+	b.data = nil
+	if b.offsets != nil {
+		b.offsets = []int32{0}
+	}
+	return arr
+}
@@ -0,0 +1,300 @@
+package arrow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flowtest/prototest"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const testProto = `
+	syntax = "proto3";
+	package arrow.v1;
+
+	import "flatfile/v1/annotations.proto";
+	import "google/protobuf/wrappers.proto";
+	import "j5/types/date/v1/date.proto";
+	import "j5/types/decimal/v1/decimal.proto";
+
+	message Payment {
+	  option (flatfile.v1.message) = { record_length: 32 };
+	  string account = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 6 }, string: { trim: TRIM_RIGHT } }];
+	  j5.types.decimal.v1.Decimal amount = 2 [(flatfile.v1.field) = { fixed_width: { offset: 6, length: 7 }, number: { fixed_scale: 2 } }];
+	  j5.types.date.v1.Date posted = 3 [(flatfile.v1.field) = { fixed_width: { offset: 13, length: 8 }, date: { format: "YYYYMMDD" } }];
+	  Status status = 4 [(flatfile.v1.field) = { fixed_width: { offset: 21, length: 1 } }];
+	  int32 count = 5 [(flatfile.v1.field) = { fixed_width: { offset: 22, length: 3 }, number: {} }];
+	  google.protobuf.StringValue memo = 6 [(flatfile.v1.field) = { fixed_width: { offset: 25, length: 4 }, string: { trim: TRIM_RIGHT } }];
+	  j5.types.decimal.v1.Decimal fee = 7 [(flatfile.v1.field) = { fixed_width: { offset: 29, length: 3 } }];
+	  repeated Status history = 8;
+	  Party payee = 9;
+	  bool flagged = 10;
+	}
+
+	message Party {
+	  string name = 1;
+	  uint64 id = 2;
+	}
+
+	message Node {
+	  Node next = 1;
+	}
+
+	enum Status {
+	  STATUS_UNSPECIFIED = 0;
+	  STATUS_POSTED = 1 [(flatfile.v1.enum).key = "P"];
+	}`
+
+func testDescriptors(t *testing.T) *prototest.ResultSet {
+	return prototest.DescriptorsFromSource(t, map[string]string{"test.proto": testProto})
+}
+
+func testDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	return testDescriptors(t).MessageByName(t, "arrow.v1.Payment")
+}
+
+// schemaString is the fields of the schema as name: type, with the children
+// of structs and lists in braces and a ? for nullable fields.
+func schemaString(fields []*Field) string {
+	parts := make([]string, len(fields))
+	for idx, field := range fields {
+		part := fmt.Sprintf("%s: %s", field.Name, field.Type)
+		if field.Nullable {
+			part += "?"
+		}
+		if len(field.Children) > 0 {
+			part += " {" + schemaString(field.Children) + "}"
+		}
+		parts[idx] = part
+	}
+	return strings.Join(parts, ", ")
+}
+
+func TestSchema(t *testing.T) {
+	builder, err := NewRecordBuilder(testDescriptor(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "account: utf8, amount: decimal128(7, 2)?, posted: date32?, status: utf8, count: int32, " +
+		"memo: utf8?, fee: utf8?, history: list {item: utf8}, payee: struct? {name: utf8, id: uint64}, flagged: bool"
+	if got := schemaString(builder.Schema().Fields); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	if _, err := NewRecordBuilder(testDescriptors(t).MessageByName(t, "arrow.v1.Node")); err == nil {
+		t.Error("expected an error for a recursive message")
+	}
+}
+
+func TestRecordBuilder(t *testing.T) {
+	msgDesc := testDescriptor(t)
+	builder, err := NewRecordBuilder(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := dynamicpb.NewMessage(msgDesc)
+	if err := binfile.ParseMessage(first, []byte("ACME  "+"-012.50"+"20240131"+"P"+"064"+"NOTE"+"1.5")); err != nil {
+		t.Fatal(err)
+	}
+	fields := msgDesc.Fields()
+	history := first.Mutable(fields.ByName("history")).List()
+	history.Append(protoreflect.ValueOfEnum(1))
+	history.Append(protoreflect.ValueOfEnum(0))
+	payee := first.Mutable(fields.ByName("payee")).Message()
+	payee.Set(payee.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString("Bob"))
+	payee.Set(payee.Descriptor().Fields().ByName("id"), protoreflect.ValueOfUint64(7))
+	first.Set(fields.ByName("flagged"), protoreflect.ValueOfBool(true))
+	if err := builder.Append(first); err != nil {
+		t.Fatal(err)
+	}
+
+	second := dynamicpb.NewMessage(msgDesc)
+	second.Set(fields.ByName("account"), protoreflect.ValueOfString("B"))
+	if err := builder.Append(second); err != nil {
+		t.Fatal(err)
+	}
+
+	// A row which fails leaves the columns as they were.
+	bad := dynamicpb.NewMessage(msgDesc)
+	if err := binfile.ParseMessage(bad, []byte("BAD   "+"012.505"+"20240131"+"P"+"001"+"    "+"   ")); err != nil {
+		t.Fatal(err)
+	}
+	if err := builder.Append(bad); err == nil {
+		t.Error("expected an error for a decimal of 3 places")
+	}
+	if builder.Len() != 2 {
+		t.Fatalf("got %d rows", builder.Len())
+	}
+
+	rec := builder.NewRecord()
+	if rec.NumRows != 2 || builder.Len() != 0 {
+		t.Fatalf("got %d rows, %d left in the builder", rec.NumRows, builder.Len())
+	}
+	column := func(name string) *Array {
+		for idx, field := range rec.Schema.Fields {
+			if field.Name == name {
+				return rec.Columns[idx]
+			}
+		}
+		t.Fatalf("no column %s", name)
+		return nil
+	}
+
+	if got := column("account"); string(got.Bytes(0)) != "ACME" || string(got.Bytes(1)) != "B" || got.NullCount != 0 {
+		t.Errorf("account: got %q, %q", got.Bytes(0), got.Bytes(1))
+	}
+	if got := column("amount"); got.Decimal(0).Int64() != -1250 || !got.IsNull(1) || got.NullCount != 1 {
+		t.Errorf("amount: got %s, null %v", got.Decimal(0), got.IsNull(1))
+	}
+	if got := column("posted"); got.Int32(0) != 19753 || !got.IsNull(1) {
+		t.Errorf("posted: got %d", got.Int32(0))
+	}
+	if got := column("status"); string(got.Bytes(0)) != "STATUS_POSTED" || string(got.Bytes(1)) != "STATUS_UNSPECIFIED" {
+		t.Errorf("status: got %q, %q", got.Bytes(0), got.Bytes(1))
+	}
+	if got := column("count"); got.Int32(0) != 64 || got.Int32(1) != 0 || got.Validity != nil {
+		t.Errorf("count: got %d, %d", got.Int32(0), got.Int32(1))
+	}
+	if got := column("memo"); string(got.Bytes(0)) != "NOTE" || !got.IsNull(1) {
+		t.Errorf("memo: got %q", got.Bytes(0))
+	}
+	if got := column("fee"); string(got.Bytes(0)) != "1.5" {
+		t.Errorf("fee: got %q", got.Bytes(0))
+	}
+	if got := column("flagged"); !got.Bool(0) || got.Bool(1) {
+		t.Errorf("flagged: got %v, %v", got.Bool(0), got.Bool(1))
+	}
+
+	got := column("history")
+	items := got.Children[0]
+	if start, end := got.ListRange(0); start != 0 || end != 2 {
+		t.Errorf("history: got items %d to %d", start, end)
+	}
+	if start, end := got.ListRange(1); start != end {
+		t.Errorf("history: got items %d to %d for an empty list", start, end)
+	}
+	if string(items.Bytes(0)) != "STATUS_POSTED" || string(items.Bytes(1)) != "STATUS_UNSPECIFIED" {
+		t.Errorf("history: got %q, %q", items.Bytes(0), items.Bytes(1))
+	}
+
+	// A null struct still has a slot in each child.
+	got = column("payee")
+	name, id := got.Children[0], got.Children[1]
+	if got.IsNull(0) || !got.IsNull(1) || name.Len != 2 || id.Len != 2 {
+		t.Fatalf("payee: got null %v, %v, children of %d and %d", got.IsNull(0), got.IsNull(1), name.Len, id.Len)
+	}
+	if string(name.Bytes(0)) != "Bob" || id.Uint64(0) != 7 {
+		t.Errorf("payee: got %q, %d", name.Bytes(0), id.Uint64(0))
+	}
+}
+
+func TestImpliedScale(t *testing.T) {
+	msgDesc := testDescriptor(t)
+	builder, err := NewRecordBuilder(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The amount has no decimal point in the record, only the fixed scale.
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := binfile.ParseMessage(msg, []byte("ACME  "+"0001234"+"20240131"+"P"+"001"+"    "+"   ")); err != nil {
+		t.Fatal(err)
+	}
+	if err := builder.Append(msg); err != nil {
+		t.Fatal(err)
+	}
+	rec := builder.NewRecord()
+	amount := rec.Columns[1]
+	dataType := rec.Schema.Fields[1].Type
+	if got := decimal.NewFromBigInt(amount.Decimal(0), -dataType.Scale); got.String() != "12.34" {
+		t.Errorf("got amount %s, want 12.34", got)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	msgDesc := testDescriptor(t)
+	builder, err := NewRecordBuilder(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	writer, err := NewWriter(buf, builder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := binfile.ParseMessage(msg, []byte("ACME  "+"0012.50"+"20240131"+"P"+"001"+"NOTE"+"   ")); err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		if err := writer.Write(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The stream is the schema, one batch, and the end of stream marker,
+	// each message aligned to 8 bytes.
+	data := buf.Bytes()
+	var headerTypes []byte
+	var bodyLengths []int64
+	for len(data) > 0 {
+		if len(data) < 8 || binary.LittleEndian.Uint32(data) != continuation {
+			t.Fatalf("got % x at the start of a message", data[:min(len(data), 8)])
+		}
+		size := int(binary.LittleEndian.Uint32(data[4:]))
+		data = data[8:]
+		if size == 0 {
+			break
+		}
+		if size%8 != 0 {
+			t.Errorf("got metadata of %d bytes", size)
+		}
+		headerType, bodyLength := readMessage(t, data[:size])
+		headerTypes = append(headerTypes, headerType)
+		bodyLengths = append(bodyLengths, bodyLength)
+		data = data[int64(size)+bodyLength:]
+	}
+	if len(data) != 0 {
+		t.Errorf("got %d bytes after the end of the stream", len(data))
+	}
+	if !bytes.Equal(headerTypes, []byte{headerSchema, headerRecordBatch}) {
+		t.Fatalf("got messages %v", headerTypes)
+	}
+	if bodyLengths[0] != 0 || bodyLengths[1]%8 != 0 {
+		t.Errorf("got bodies of %v bytes", bodyLengths)
+	}
+}
+
+// readMessage reads the header type and body length of the flatbuffer of a
+// Message.
+func readMessage(t *testing.T, fb []byte) (headerType byte, bodyLength int64) {
+	t.Helper()
+	table := int(binary.LittleEndian.Uint32(fb))
+	vtable := table - int(int32(binary.LittleEndian.Uint32(fb[table:])))
+	field := func(slot int) int {
+		if 4+2*slot >= int(binary.LittleEndian.Uint16(fb[vtable:])) {
+			return 0
+		}
+		return int(binary.LittleEndian.Uint16(fb[vtable+4+2*slot:]))
+	}
+	if off := field(0); off == 0 || binary.LittleEndian.Uint16(fb[table+off:]) != metadataV5 {
+		t.Fatal("message is not of metadata version 5")
+	}
+	if off := field(1); off != 0 {
+		headerType = fb[table+off]
+	}
+	if off := field(3); off != 0 {
+		bodyLength = int64(binary.LittleEndian.Uint64(fb[table+off:]))
+	}
+	return headerType, bodyLength
+}
@@ -0,0 +1,191 @@
+package arrow
+
+import (
+	"encoding/binary"
+)
+
+// fbBuilder builds a flatbuffer, the encoding of Arrow IPC metadata, from the
+// back, so that each table, vector and string is written before the tables
+// which refer to it. Offsets are counted from the end of the buffer until
+// finish.
+type fbBuilder struct {
+	buf      []byte
+	head     int
+	minAlign int
+
+	// vtable holds the offset of each field of the table being built, or 0
+	// when the field is absent, and objectEnd the offset the table starts at.
+	vtable    []int
+	objectEnd int
+}
+
+func newFBBuilder() *fbBuilder {
+	return &fbBuilder{
+		buf:      make([]byte, 1024),
+		head:     1024,
+		minAlign: 1,
+	}
+}
+
+// offset is the number of bytes written so far.
+func (b *fbBuilder) offset() int {
+	return len(b.buf) - b.head
+}
+
+// prep pads the buffer so that after writing additional bytes it is aligned
+// to size, growing it as needed.
+func (b *fbBuilder) prep(size, additional int) {
+	b.minAlign = max(b.minAlign, size)
+	pad := (-(b.offset() + additional)) & (size - 1)
+	for b.head < pad+size+additional {
+		grown := make([]byte, 2*len(b.buf))
+		copy(grown[len(grown)-b.offset():], b.buf[b.head:])
+		b.head += len(grown) - len(b.buf)
+		b.buf = grown
+	}
+	for range pad {
+		b.head--
+		b.buf[b.head] = 0
+	}
+}
+
+func (b *fbBuilder) placeUint8(v uint8) {
+	b.head--
+	b.buf[b.head] = v
+}
+
+func (b *fbBuilder) placeUint16(v uint16) {
+	b.head -= 2
+	binary.LittleEndian.PutUint16(b.buf[b.head:], v)
+}
+
+func (b *fbBuilder) placeUint32(v uint32) {
+	b.head -= 4
+	binary.LittleEndian.PutUint32(b.buf[b.head:], v)
+}
+
+func (b *fbBuilder) placeUint64(v uint64) {
+	b.head -= 8
+	binary.LittleEndian.PutUint64(b.buf[b.head:], v)
+}
+
+func (b *fbBuilder) prependUint8(v uint8) {
+	b.prep(1, 0)
+	b.placeUint8(v)
+}
+
+func (b *fbBuilder) prependUint16(v uint16) {
+	b.prep(2, 0)
+	b.placeUint16(v)
+}
+
+func (b *fbBuilder) prependUint32(v uint32) {
+	b.prep(4, 0)
+	b.placeUint32(v)
+}
+
+func (b *fbBuilder) prependUint64(v uint64) {
+	b.prep(8, 0)
+	b.placeUint64(v)
+}
+
+// prependOffset writes a reference to the object written at off.
+func (b *fbBuilder) prependOffset(off int) {
+	b.prep(4, 0)
+	b.placeUint32(uint32(b.offset() - off + 4))
+}
+
+// createString writes a null terminated string, returning its offset.
+func (b *fbBuilder) createString(s string) int {
+	b.prep(4, len(s)+1)
+	b.placeUint8(0)
+	b.head -= len(s)
+	copy(b.buf[b.head:], s)
+	b.placeUint32(uint32(len(s)))
+	return b.offset()
+}
+
+// createOffsets writes a vector of references to the objects at offs,
+// returning its offset.
+func (b *fbBuilder) createOffsets(offs []int) int {
+	b.prep(4, 4*len(offs))
+	for idx := len(offs) - 1; idx >= 0; idx-- {
+		b.prependOffset(offs[idx])
+	}
+	b.placeUint32(uint32(len(offs)))
+	return b.offset()
+}
+
+// createStructs writes a vector of structs of two longs, the layout of both
+// FieldNode and Buffer, returning its offset.
+func (b *fbBuilder) createStructs(structs [][2]int64) int {
+	b.prep(4, 16*len(structs))
+	b.prep(8, 16*len(structs))
+	for idx := len(structs) - 1; idx >= 0; idx-- {
+		b.placeUint64(uint64(structs[idx][1]))
+		b.placeUint64(uint64(structs[idx][0]))
+	}
+	b.placeUint32(uint32(len(structs)))
+	return b.offset()
+}
+
+// startTable starts a table of numFields fields. The fields are added with
+// the add methods, and the table ended with endTable.
+func (b *fbBuilder) startTable(numFields int) {
+	b.vtable = make([]int, numFields)
+	b.objectEnd = b.offset()
+}
+
+func (b *fbBuilder) addUint8(slot int, v uint8) {
+	b.prependUint8(v)
+	b.vtable[slot] = b.offset()
+}
+
+func (b *fbBuilder) addUint16(slot int, v uint16) {
+	b.prependUint16(v)
+	b.vtable[slot] = b.offset()
+}
+
+func (b *fbBuilder) addUint32(slot int, v uint32) {
+	b.prependUint32(v)
+	b.vtable[slot] = b.offset()
+}
+
+func (b *fbBuilder) addUint64(slot int, v uint64) {
+	b.prependUint64(v)
+	b.vtable[slot] = b.offset()
+}
+
+func (b *fbBuilder) addOffset(slot int, off int) {
+	b.prependOffset(off)
+	b.vtable[slot] = b.offset()
+}
+
+// endTable writes the vtable of the table, returning the offset of the
+// table.
+func (b *fbBuilder) endTable() int {
+	// The table starts with the offset of its vtable, filled in below.
+	b.prependUint32(0)
+	object := b.offset()
+
+	for idx := len(b.vtable) - 1; idx >= 0; idx-- {
+		var field uint16
+		if b.vtable[idx] != 0 {
+			field = uint16(object - b.vtable[idx])
+		}
+		b.prependUint16(field)
+	}
+	b.prependUint16(uint16(object - b.objectEnd))
+	b.prependUint16(uint16(2 * (len(b.vtable) + 2)))
+
+	binary.LittleEndian.PutUint32(b.buf[len(b.buf)-object:], uint32(b.offset()-object))
+	b.vtable = nil
+	return object
+}
+
+// finish writes the reference to the root table, returning the flatbuffer.
+func (b *fbBuilder) finish(root int) []byte {
+	b.prep(b.minAlign, 4)
+	b.prependOffset(root)
+	return b.buf[b.head:]
+}
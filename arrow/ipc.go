@@ -0,0 +1,267 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// BatchSize is the number of rows at which a Writer writes a record batch.
+const BatchSize = 64 * 1024
+
+// The flatbuffer enums and unions of the Arrow IPC format, from Schema.fbs
+// and Message.fbs.
+const (
+	metadataV5 = 4
+
+	headerSchema      = 1
+	headerRecordBatch = 3
+
+	typeInt           = 2
+	typeFloatingPoint = 3
+	typeBinary        = 4
+	typeUtf8          = 5
+	typeBool          = 6
+	typeDecimal       = 7
+	typeDate          = 8
+	typeList          = 12
+	typeStruct        = 13
+
+	precisionSingle = 1
+	precisionDouble = 2
+
+	dateUnitDay = 0
+)
+
+// continuation starts each message of a stream.
+const continuation = 0xFFFFFFFF
+
+// Writer writes an Arrow IPC stream, of the schema of the builder and record
+// batches of the messages written.
+type Writer struct {
+	w       io.Writer
+	builder *RecordBuilder
+}
+
+// NewWriter writes the schema message of the stream.
+func NewWriter(w io.Writer, builder *RecordBuilder) (*Writer, error) {
+	writer := &Writer{w: w, builder: builder}
+	if err := writer.writeMessage(schemaMessage(builder.Schema()), nil); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Write appends the message to the current batch, writing the batch once it
+// reaches BatchSize rows.
+func (w *Writer) Write(msg proto.Message) error {
+	if err := w.builder.Append(msg); err != nil {
+		return fmt.Errorf("appending %s: %w", w.builder.Descriptor().FullName(), err)
+	}
+	if w.builder.Len() >= BatchSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes the rows of the current batch, if there are any.
+func (w *Writer) Flush() error {
+	if w.builder.Len() == 0 {
+		return nil
+	}
+	return w.WriteRecord(w.builder.NewRecord())
+}
+
+// WriteRecord writes the record as a batch, which must be of the schema of
+// the builder.
+func (w *Writer) WriteRecord(rec *Record) error {
+	if rec.Schema != w.builder.Schema() {
+		return fmt.Errorf("record is not of the schema of the stream")
+	}
+	batch := &recordBatch{}
+	for _, column := range rec.Columns {
+		batch.add(column)
+	}
+	return w.writeMessage(batch.message(rec.NumRows), batch.body)
+}
+
+// Close flushes the last batch and ends the stream, leaving the underlying
+// writer open.
+func (w *Writer) Close() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	eos := binary.LittleEndian.AppendUint32(nil, continuation)
+	eos = binary.LittleEndian.AppendUint32(eos, 0)
+	_, err := w.w.Write(eos)
+	return err
+}
+
+// writeMessage writes the metadata, padded so that the body is aligned to 8
+// bytes, and the body.
+func (w *Writer) writeMessage(metadata, body []byte) error {
+	padded := (len(metadata) + 7) &^ 7
+	header := binary.LittleEndian.AppendUint32(nil, continuation)
+	header = binary.LittleEndian.AppendUint32(header, uint32(padded))
+	header = append(header, metadata...)
+	header = append(header, make([]byte, padded-len(metadata))...)
+	if _, err := w.w.Write(header); err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	_, err := w.w.Write(body)
+	return err
+}
+
+// schemaMessage is the flatbuffer of the Message holding the schema.
+func schemaMessage(schema *Schema) []byte {
+	b := newFBBuilder()
+	fields := make([]int, len(schema.Fields))
+	for idx, field := range schema.Fields {
+		fields[idx] = buildField(b, field)
+	}
+	fieldsVec := b.createOffsets(fields)
+
+	b.startTable(4)
+	b.addOffset(1, fieldsVec)
+	// Little endian, the default, is 0.
+	b.addUint16(0, 0)
+	return finishMessage(b, headerSchema, b.endTable(), 0)
+}
+
+func finishMessage(b *fbBuilder, headerType uint8, header int, bodyLength int64) []byte {
+	b.startTable(5)
+	b.addUint64(3, uint64(bodyLength))
+	b.addOffset(2, header)
+	b.addUint16(0, metadataV5)
+	b.addUint8(1, headerType)
+	return b.finish(b.endTable())
+}
+
+func buildField(b *fbBuilder, field *Field) int {
+	name := b.createString(field.Name)
+	children := make([]int, len(field.Children))
+	for idx, child := range field.Children {
+		children[idx] = buildField(b, child)
+	}
+	childrenVec := b.createOffsets(children)
+	typeType, typ := buildType(b, field.Type)
+
+	b.startTable(7)
+	b.addOffset(0, name)
+	b.addOffset(3, typ)
+	b.addOffset(5, childrenVec)
+	b.addUint8(2, typeType)
+	b.addUint8(1, boolByte(field.Nullable))
+	return b.endTable()
+}
+
+// buildType writes the table of the type, returning its union type and
+// offset.
+func buildType(b *fbBuilder, typ DataType) (uint8, int) {
+	switch typ.Type {
+	case Int32, Int64, Uint32, Uint64:
+		b.startTable(2)
+		b.addUint32(0, uint32(8*typ.Type.width()))
+		b.addUint8(1, boolByte(typ.Type == Int32 || typ.Type == Int64))
+		return typeInt, b.endTable()
+	case Float32, Float64:
+		precision := uint16(precisionSingle)
+		if typ.Type == Float64 {
+			precision = precisionDouble
+		}
+		b.startTable(1)
+		b.addUint16(0, precision)
+		return typeFloatingPoint, b.endTable()
+	case Decimal128:
+		b.startTable(3)
+		b.addUint32(0, uint32(typ.Precision))
+		b.addUint32(1, uint32(typ.Scale))
+		b.addUint32(2, 128)
+		return typeDecimal, b.endTable()
+	case Date32:
+		b.startTable(1)
+		b.addUint16(0, dateUnitDay)
+		return typeDate, b.endTable()
+	}
+
+	b.startTable(0)
+	table := b.endTable()
+	switch typ.Type {
+	case Binary:
+		return typeBinary, table
+	case Boolean:
+		return typeBool, table
+	case List:
+		return typeList, table
+	case Struct:
+		return typeStruct, table
+	default:
+		return typeUtf8, table
+	}
+}
+
+func boolByte(v bool) uint8 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// recordBatch collects the field nodes and buffers of the arrays of a record,
+// depth first, as the IPC format lays them out.
+type recordBatch struct {
+	nodes   [][2]int64
+	buffers [][2]int64
+	body    []byte
+}
+
+func (r *recordBatch) add(arr *Array) {
+	r.nodes = append(r.nodes, [2]int64{int64(arr.Len), int64(arr.NullCount)})
+	r.addBuffer(arr.Validity)
+	switch arr.Field.Type.Type {
+	case Struct:
+	case List:
+		r.addBuffer(offsetBytes(arr.Offsets))
+	case Utf8, Binary:
+		r.addBuffer(offsetBytes(arr.Offsets))
+		r.addBuffer(arr.Data)
+	default:
+		r.addBuffer(arr.Data)
+	}
+	for _, child := range arr.Children {
+		r.add(child)
+	}
+}
+
+// addBuffer appends the buffer to the body, padded to 8 bytes.
+func (r *recordBatch) addBuffer(buf []byte) {
+	r.buffers = append(r.buffers, [2]int64{int64(len(r.body)), int64(len(buf))})
+	r.body = append(r.body, buf...)
+	r.body = append(r.body, make([]byte, (-len(buf))&7)...)
+}
+
+func offsetBytes(offsets []int32) []byte {
+	buf := make([]byte, 0, 4*len(offsets))
+	for _, offset := range offsets {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(offset))
+	}
+	return buf
+}
+
+// message is the flatbuffer of the Message holding the record batch.
+func (r *recordBatch) message(numRows int) []byte {
+	b := newFBBuilder()
+	nodes := b.createStructs(r.nodes)
+	buffers := b.createStructs(r.buffers)
+
+	b.startTable(3)
+	b.addUint64(0, uint64(numRows))
+	b.addOffset(1, nodes)
+	b.addOffset(2, buffers)
+	return finishMessage(b, headerRecordBatch, b.endTable(), int64(len(r.body)))
+}
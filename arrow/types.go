@@ -0,0 +1,361 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxDecimalPrecision is the most digits a decimal128 holds.
+const maxDecimalPrecision = 38
+
+// resolver builds the array builders of fields.
+type resolver struct {
+	// active holds the messages being resolved, as recursive messages have
+	// no Arrow type.
+	active map[protoreflect.FullName]bool
+}
+
+func (r *resolver) field(fieldDesc protoreflect.FieldDescriptor) (*arrayBuilder, error) {
+	if fieldDesc.IsMap() {
+		return nil, fmt.Errorf("map fields are not supported")
+	}
+	tc, _ := proto.GetExtension(fieldDesc.Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+
+	if fieldDesc.IsList() {
+		item, err := r.value(fieldDesc, tc, "item", false)
+		if err != nil {
+			return nil, err
+		}
+		field := &Field{
+			Name:     string(fieldDesc.Name()),
+			Type:     DataType{Type: List},
+			Children: []*Field{item.field},
+		}
+		b := newArrayBuilder(field, appendList)
+		b.children = []*arrayBuilder{item}
+		return b, nil
+	}
+	return r.value(fieldDesc, tc, string(fieldDesc.Name()), nullable(fieldDesc))
+}
+
+// value returns the builder of one value of the field.
+func (r *resolver) value(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field, name string, isNullable bool) (*arrayBuilder, error) {
+	field := &Field{Name: name, Nullable: isNullable}
+	scalar := func(typ Type, appendData func(b *arrayBuilder, val protoreflect.Value) error) (*arrayBuilder, error) {
+		field.Type = DataType{Type: typ}
+		return newArrayBuilder(field, appendData), nil
+	}
+
+	switch fieldDesc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msgDesc := fieldDesc.Message()
+		switch msgDesc.FullName() {
+		case "google.protobuf.StringValue":
+			return scalar(Utf8, wrapped(msgDesc, appendString))
+		case "google.protobuf.BytesValue":
+			return scalar(Binary, wrapped(msgDesc, appendBytes))
+		case "google.protobuf.BoolValue":
+			return scalar(Boolean, wrapped(msgDesc, appendBool))
+		case "google.protobuf.Int32Value":
+			return scalar(Int32, wrapped(msgDesc, appendInt32))
+		case "google.protobuf.Int64Value":
+			return scalar(Int64, wrapped(msgDesc, appendInt64))
+		case "google.protobuf.UInt32Value":
+			return scalar(Uint32, wrapped(msgDesc, appendUint32))
+		case "google.protobuf.UInt64Value":
+			return scalar(Uint64, wrapped(msgDesc, appendUint64))
+		case "google.protobuf.FloatValue":
+			return scalar(Float32, wrapped(msgDesc, appendFloat32))
+		case "google.protobuf.DoubleValue":
+			return scalar(Float64, wrapped(msgDesc, appendFloat64))
+		case "j5.types.date.v1.Date":
+			return scalar(Date32, appendDate)
+		case "j5.types.decimal.v1.Decimal":
+			precision, scale := decimalType(tc)
+			if precision == 0 {
+				return scalar(Utf8, wrapped(msgDesc, appendString))
+			}
+			field.Type = DataType{Type: Decimal128, Precision: precision, Scale: scale}
+			return newArrayBuilder(field, decimalAppender(precision, scale)), nil
+		}
+		return r.message(field, msgDesc)
+
+	case protoreflect.StringKind:
+		return scalar(Utf8, appendString)
+	case protoreflect.BytesKind:
+		return scalar(Binary, appendBytes)
+	case protoreflect.BoolKind:
+		return scalar(Boolean, appendBool)
+	case protoreflect.FloatKind:
+		return scalar(Float32, appendFloat32)
+	case protoreflect.DoubleKind:
+		return scalar(Float64, appendFloat64)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return scalar(Int32, appendInt32)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return scalar(Int64, appendInt64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return scalar(Uint32, appendUint32)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return scalar(Uint64, appendUint64)
+
+	case protoreflect.EnumKind:
+		enumDesc := fieldDesc.Enum()
+		return scalar(Utf8, func(b *arrayBuilder, val protoreflect.Value) error {
+			if !val.IsValid() {
+				return appendString(b, val)
+			}
+			valueDesc := enumDesc.Values().ByNumber(val.Enum())
+			if valueDesc == nil {
+				return fmt.Errorf("enum value %d is not a value of %s", val.Enum(), enumDesc.FullName())
+			}
+			return appendString(b, protoreflect.ValueOfString(string(valueDesc.Name())))
+		})
+
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", fieldDesc.Kind())
+	}
+}
+
+// message returns the builder of a struct of the fields of the message.
+func (r *resolver) message(field *Field, msgDesc protoreflect.MessageDescriptor) (*arrayBuilder, error) {
+	if r.active[msgDesc.FullName()] {
+		return nil, fmt.Errorf("recursive message %s is not supported", msgDesc.FullName())
+	}
+	r.active[msgDesc.FullName()] = true
+	defer delete(r.active, msgDesc.FullName())
+
+	field.Type = DataType{Type: Struct}
+	b := newArrayBuilder(field, nil)
+	var fieldDescs []protoreflect.FieldDescriptor
+	fields := msgDesc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		child, err := r.field(fieldDesc)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", fieldDesc.FullName(), err)
+		}
+		field.Children = append(field.Children, child.field)
+		b.children = append(b.children, child)
+		fieldDescs = append(fieldDescs, fieldDesc)
+	}
+
+	b.appendData = func(b *arrayBuilder, val protoreflect.Value) error {
+		if !val.IsValid() {
+			// Each child has a slot for every row, null in the children
+			// which can be.
+			for _, child := range b.children {
+				child.appendEmpty()
+			}
+			return nil
+		}
+		msg := val.Message()
+		for idx, fieldDesc := range fieldDescs {
+			if err := b.children[idx].appendValue(fieldValue(msg, fieldDesc)); err != nil {
+				for _, child := range b.children[:idx] {
+					child.truncate(b.length)
+				}
+				return fmt.Errorf("%s: %w", fieldDesc.Name(), err)
+			}
+		}
+		return nil
+	}
+	return b, nil
+}
+
+// appendEmpty appends a null, or the zero value to arrays which are not
+// nullable.
+func (b *arrayBuilder) appendEmpty() {
+	if b.field.Type.Type == List {
+		b.offsets = append(b.offsets, b.offsets[len(b.offsets)-1])
+		b.appendValidity(true)
+		return
+	}
+	// The zero value of every type appends without error.
+	_ = b.appendData(b, protoreflect.Value{})
+	b.appendValidity(!b.field.Nullable)
+}
+
+// decimalType is the precision and scale of decimal128 for fields with a
+// fixed scale, and otherwise 0, as the scale of each value may differ.
+func decimalType(tc *flatfile_pb.Field) (precision, scale int32) {
+	precision, scale = binfile.DecimalPrecision(tc)
+	return min(precision, maxDecimalPrecision), scale
+}
+
+func decimalAppender(precision, scale int32) func(b *arrayBuilder, val protoreflect.Value) error {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	return func(b *arrayBuilder, val protoreflect.Value) error {
+		if !val.IsValid() {
+			b.data = append(b.data, make([]byte, 16)...)
+			return nil
+		}
+		msg := val.Message()
+		text := msg.Get(msg.Descriptor().Fields().ByName("value")).String()
+		value, err := decimal.NewFromString(text)
+		if err != nil {
+			return fmt.Errorf("invalid decimal %q: %w", text, err)
+		}
+		unscaled := value.Shift(scale)
+		if !unscaled.IsInteger() {
+			return fmt.Errorf("decimal %s has more than %d decimal places", value, scale)
+		}
+		n := unscaled.BigInt()
+		if new(big.Int).Abs(n).Cmp(limit) >= 0 {
+			return fmt.Errorf("decimal %s has more than %d digits", value, precision)
+		}
+		b.data = appendInt128(b.data, n)
+		return nil
+	}
+}
+
+// appendInt128 writes n as 16 bytes of little endian two's complement.
+func appendInt128(buf []byte, n *big.Int) []byte {
+	if n.Sign() < 0 {
+		n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	var be [16]byte
+	n.FillBytes(be[:])
+	for idx := 15; idx >= 0; idx-- {
+		buf = append(buf, be[idx])
+	}
+	return buf
+}
+
+// wrapped appends the value of a wrapper message.
+func wrapped(desc protoreflect.MessageDescriptor, appendData func(b *arrayBuilder, val protoreflect.Value) error) func(b *arrayBuilder, val protoreflect.Value) error {
+	valueField := desc.Fields().ByName("value")
+	return func(b *arrayBuilder, val protoreflect.Value) error {
+		if !val.IsValid() {
+			return appendData(b, val)
+		}
+		return appendData(b, val.Message().Get(valueField))
+	}
+}
+
+func appendList(b *arrayBuilder, val protoreflect.Value) error {
+	item := b.children[0]
+	if val.IsValid() {
+		list := val.List()
+		for idx := range list.Len() {
+			if err := item.appendValue(list.Get(idx)); err != nil {
+				item.truncate(int(b.offsets[len(b.offsets)-1]))
+				return fmt.Errorf("item %d: %w", idx, err)
+			}
+		}
+	}
+	b.offsets = append(b.offsets, int32(item.length))
+	return nil
+}
+
+func appendString(b *arrayBuilder, val protoreflect.Value) error {
+	if val.IsValid() {
+		text := val.String()
+		if !utf8.ValidString(text) {
+			return fmt.Errorf("string %q is not UTF-8", text)
+		}
+		b.data = append(b.data, text...)
+	}
+	b.offsets = append(b.offsets, int32(len(b.data)))
+	return nil
+}
+
+func appendBytes(b *arrayBuilder, val protoreflect.Value) error {
+	if val.IsValid() {
+		b.data = append(b.data, val.Bytes()...)
+	}
+	b.offsets = append(b.offsets, int32(len(b.data)))
+	return nil
+}
+
+func appendBool(b *arrayBuilder, val protoreflect.Value) error {
+	if b.length%8 == 0 {
+		b.data = append(b.data, 0)
+	}
+	if val.IsValid() && val.Bool() {
+		b.data[b.length/8] |= 1 << (b.length % 8)
+	}
+	return nil
+}
+
+func appendInt32(b *arrayBuilder, val protoreflect.Value) error {
+	var n int64
+	if val.IsValid() {
+		n = val.Int()
+	}
+	b.data = binary.LittleEndian.AppendUint32(b.data, uint32(int32(n)))
+	return nil
+}
+
+func appendInt64(b *arrayBuilder, val protoreflect.Value) error {
+	var n int64
+	if val.IsValid() {
+		n = val.Int()
+	}
+	b.data = binary.LittleEndian.AppendUint64(b.data, uint64(n))
+	return nil
+}
+
+func appendUint32(b *arrayBuilder, val protoreflect.Value) error {
+	var n uint64
+	if val.IsValid() {
+		n = val.Uint()
+	}
+	b.data = binary.LittleEndian.AppendUint32(b.data, uint32(n))
+	return nil
+}
+
+func appendUint64(b *arrayBuilder, val protoreflect.Value) error {
+	var n uint64
+	if val.IsValid() {
+		n = val.Uint()
+	}
+	b.data = binary.LittleEndian.AppendUint64(b.data, n)
+	return nil
+}
+
+func appendFloat32(b *arrayBuilder, val protoreflect.Value) error {
+	var f float64
+	if val.IsValid() {
+		f = val.Float()
+	}
+	b.data = binary.LittleEndian.AppendUint32(b.data, math.Float32bits(float32(f)))
+	return nil
+}
+
+func appendFloat64(b *arrayBuilder, val protoreflect.Value) error {
+	var f float64
+	if val.IsValid() {
+		f = val.Float()
+	}
+	b.data = binary.LittleEndian.AppendUint64(b.data, math.Float64bits(f))
+	return nil
+}
+
+// appendDate writes the days since 1970-01-01.
+func appendDate(b *arrayBuilder, val protoreflect.Value) error {
+	var days int64
+	if val.IsValid() {
+		msg := val.Message()
+		fields := msg.Descriptor().Fields()
+		date := time.Date(
+			int(msg.Get(fields.ByName("year")).Int()),
+			time.Month(msg.Get(fields.ByName("month")).Int()),
+			int(msg.Get(fields.ByName("day")).Int()),
+			0, 0, 0, 0, time.UTC)
+		// Midnight UTC is a whole number of days from the epoch.
+		days = date.Unix() / (24 * 60 * 60)
+	}
+	b.data = binary.LittleEndian.AppendUint32(b.data, uint32(int32(days)))
+	return nil
+}
@@ -0,0 +1,499 @@
+// Package avro encodes parsed records as Apache Avro, with a schema derived
+// from the message descriptor, for landing partner files on Kafka topics and
+// in data lakes with Avro contracts.
+//
+// An Encoder appends the binary encoding of each record, which Kafka
+// producers frame as their schema registry requires, and a Writer streams
+// records into an object container file as they are parsed.
+//
+// Fields map to Avro types as follows:
+//
+//   - string, bool, bytes, float and double to their Avro types, and
+//     int32 to int, other integers to long;
+//   - enums to an Avro enum of the proto value names;
+//   - j5 dates to int with the date logical type;
+//   - j5 decimals to bytes with the decimal logical type when the field
+//     has a fixed_scale, its precision from max_digits or the width of the
+//     field, or else to string;
+//   - wrapper types to a union of null and the value;
+//   - other messages to records, repeated fields to arrays and map fields to
+//     maps with string keys.
+//
+// Fields with presence, such as messages, wrappers and proto3 optional
+// fields, are a union of null and the value, null when unset. Other fields
+// are never null, and unset is their zero value, as in proto3.
+package avro
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Encoder encodes messages of one type as Avro.
+type Encoder struct {
+	desc   protoreflect.MessageDescriptor
+	schema []byte
+	encode encodeFunc
+}
+
+type encodeFunc func(buf []byte, val protoreflect.Value) ([]byte, error)
+
+// NewEncoder derives the Avro schema of the message and resolves how each
+// field is encoded.
+func NewEncoder(desc protoreflect.MessageDescriptor) (*Encoder, error) {
+	b := &builder{
+		named:   map[protoreflect.FullName]bool{},
+		records: map[protoreflect.FullName]*recordEncoder{},
+	}
+	schema, encode, err := b.record(desc)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &Encoder{
+		desc:   desc,
+		schema: data,
+		encode: encode,
+	}, nil
+}
+
+// Descriptor returns the message type of the encoder.
+func (e *Encoder) Descriptor() protoreflect.MessageDescriptor {
+	return e.desc
+}
+
+// Schema returns the Avro schema, as JSON.
+func (e *Encoder) Schema() []byte {
+	return e.schema
+}
+
+// Append appends the Avro binary encoding of the message to buf.
+func (e *Encoder) Append(buf []byte, msg proto.Message) ([]byte, error) {
+	refl := msg.ProtoReflect()
+	if refl.Descriptor().FullName() != e.desc.FullName() {
+		return buf, fmt.Errorf("encoder for %s cannot encode %s", e.desc.FullName(), refl.Descriptor().FullName())
+	}
+	return e.encode(buf, protoreflect.ValueOfMessage(refl))
+}
+
+// The schema types marshal to Avro schema JSON.
+type (
+	recordSchema struct {
+		Type      string        `json:"type"`
+		Name      string        `json:"name"`
+		Namespace string        `json:"namespace,omitempty"`
+		Fields    []fieldSchema `json:"fields"`
+	}
+
+	fieldSchema struct {
+		Name    string          `json:"name"`
+		Doc     string          `json:"doc,omitempty"`
+		Type    any             `json:"type"`
+		Default json.RawMessage `json:"default"`
+	}
+
+	enumSchema struct {
+		Type      string   `json:"type"`
+		Name      string   `json:"name"`
+		Namespace string   `json:"namespace,omitempty"`
+		Symbols   []string `json:"symbols"`
+		Default   string   `json:"default"`
+	}
+
+	logicalSchema struct {
+		Type        string `json:"type"`
+		LogicalType string `json:"logicalType"`
+		Precision   int    `json:"precision,omitempty"`
+		Scale       int    `json:"scale,omitempty"`
+	}
+
+	arraySchema struct {
+		Type  string `json:"type"`
+		Items any    `json:"items"`
+	}
+
+	mapSchema struct {
+		Type   string `json:"type"`
+		Values any    `json:"values"`
+	}
+)
+
+type builder struct {
+	// named holds the records and enums already defined, which later uses
+	// refer to by full name, as Avro names may only be defined once.
+	named map[protoreflect.FullName]bool
+	// records holds the encoders of records, filled in once their fields
+	// are resolved, so that recursive messages can refer to themselves.
+	records map[protoreflect.FullName]*recordEncoder
+}
+
+type recordEncoder struct {
+	fields []protoreflect.FieldDescriptor
+	encode []encodeFunc
+}
+
+func (r *recordEncoder) encodeRecord(buf []byte, val protoreflect.Value) ([]byte, error) {
+	msg := val.Message()
+	var err error
+	for idx, fieldDesc := range r.fields {
+		buf, err = r.encode[idx](buf, fieldValue(msg, fieldDesc))
+		if err != nil {
+			return buf, fmt.Errorf("%s: %w", fieldDesc.Name(), err)
+		}
+	}
+	return buf, nil
+}
+
+// fieldValue is the value of the field, or an invalid value when a field
+// with presence is unset.
+func fieldValue(msg protoreflect.Message, fieldDesc protoreflect.FieldDescriptor) protoreflect.Value {
+	if nullable(fieldDesc) && !msg.Has(fieldDesc) {
+		return protoreflect.Value{}
+	}
+	return msg.Get(fieldDesc)
+}
+
+func nullable(fieldDesc protoreflect.FieldDescriptor) bool {
+	return fieldDesc.HasPresence() && !fieldDesc.IsList() && !fieldDesc.IsMap()
+}
+
+func (b *builder) record(desc protoreflect.MessageDescriptor) (any, encodeFunc, error) {
+	if b.named[desc.FullName()] {
+		rec := b.records[desc.FullName()]
+		return string(desc.FullName()), rec.encodeRecord, nil
+	}
+	b.named[desc.FullName()] = true
+	rec := &recordEncoder{}
+	b.records[desc.FullName()] = rec
+
+	schema := &recordSchema{
+		Type:      "record",
+		Name:      string(desc.Name()),
+		Namespace: string(desc.FullName().Parent()),
+		Fields:    []fieldSchema{},
+	}
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		field, encode, err := b.field(fieldDesc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("field %s: %w", fieldDesc.FullName(), err)
+		}
+		schema.Fields = append(schema.Fields, field)
+		rec.fields = append(rec.fields, fieldDesc)
+		rec.encode = append(rec.encode, encode)
+	}
+	return schema, rec.encodeRecord, nil
+}
+
+func (b *builder) field(fieldDesc protoreflect.FieldDescriptor) (fieldSchema, encodeFunc, error) {
+	field := fieldSchema{Name: string(fieldDesc.Name())}
+	tc, _ := proto.GetExtension(fieldDesc.Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+	field.Doc = tc.GetDescription()
+	if field.Doc == "" {
+		loc := fieldDesc.ParentFile().SourceLocations().ByDescriptor(fieldDesc)
+		field.Doc = strings.Join(strings.Fields(loc.LeadingComments), " ")
+	}
+
+	switch {
+	case fieldDesc.IsMap():
+		values, encodeValue, _, err := b.value(fieldDesc.MapValue(), nil)
+		if err != nil {
+			return field, nil, err
+		}
+		field.Type = mapSchema{Type: "map", Values: values}
+		field.Default = json.RawMessage(`{}`)
+		return field, mapEncoder(encodeValue), nil
+
+	case fieldDesc.IsList():
+		items, encodeItem, _, err := b.value(fieldDesc, nil)
+		if err != nil {
+			return field, nil, err
+		}
+		field.Type = arraySchema{Type: "array", Items: items}
+		field.Default = json.RawMessage(`[]`)
+		return field, listEncoder(encodeItem), nil
+	}
+
+	schema, encode, zero, err := b.value(fieldDesc, tc)
+	if err != nil {
+		return field, nil, err
+	}
+	if nullable(fieldDesc) {
+		field.Type = []any{"null", schema}
+		field.Default = json.RawMessage(`null`)
+		return field, nullableEncoder(encode), nil
+	}
+	field.Type = schema
+	field.Default = zero
+	return field, encode, nil
+}
+
+// value returns the schema and encoder of one value of the field, and the
+// JSON of its zero value, as the default of fields without presence.
+func (b *builder) value(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) (any, encodeFunc, json.RawMessage, error) {
+	switch fieldDesc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msgDesc := fieldDesc.Message()
+		switch msgDesc.FullName() {
+		case "google.protobuf.StringValue":
+			return "string", wrapperEncoder(msgDesc, encodeString), nil, nil
+		case "google.protobuf.BoolValue":
+			return "boolean", wrapperEncoder(msgDesc, encodeBool), nil, nil
+		case "j5.types.date.v1.Date":
+			return logicalSchema{Type: "int", LogicalType: "date"}, encodeDate, nil, nil
+		case "j5.types.decimal.v1.Decimal":
+			schema, encode := decimalSchema(tc)
+			return schema, encode, nil, nil
+		}
+		schema, encode, err := b.record(msgDesc)
+		return schema, encode, nil, err
+
+	case protoreflect.StringKind:
+		return "string", encodeString, json.RawMessage(`""`), nil
+	case protoreflect.BytesKind:
+		return "bytes", encodeBytes, json.RawMessage(`""`), nil
+	case protoreflect.BoolKind:
+		return "boolean", encodeBool, json.RawMessage(`false`), nil
+	case protoreflect.FloatKind:
+		return "float", encodeFloat, json.RawMessage(`0`), nil
+	case protoreflect.DoubleKind:
+		return "double", encodeDouble, json.RawMessage(`0`), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int", encodeInt, json.RawMessage(`0`), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "long", encodeInt, json.RawMessage(`0`), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "long", encodeUint, json.RawMessage(`0`), nil
+
+	case protoreflect.EnumKind:
+		enumDesc := fieldDesc.Enum()
+		values := enumDesc.Values()
+		index := make(map[protoreflect.EnumNumber]int64, values.Len())
+		symbols := make([]string, 0, values.Len())
+		for i := range values.Len() {
+			valueDesc := values.Get(i)
+			if _, ok := index[valueDesc.Number()]; ok {
+				// Aliases encode as the first value of their number.
+				continue
+			}
+			index[valueDesc.Number()] = int64(len(symbols))
+			symbols = append(symbols, string(valueDesc.Name()))
+		}
+		encode := func(buf []byte, val protoreflect.Value) ([]byte, error) {
+			idx, ok := index[val.Enum()]
+			if !ok {
+				return buf, fmt.Errorf("enum value %d is not a value of %s", val.Enum(), enumDesc.FullName())
+			}
+			return appendLong(buf, idx), nil
+		}
+		zero, err := json.Marshal(symbols[0])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if b.named[enumDesc.FullName()] {
+			return string(enumDesc.FullName()), encode, zero, nil
+		}
+		b.named[enumDesc.FullName()] = true
+		return enumSchema{
+			Type:      "enum",
+			Name:      string(enumDesc.Name()),
+			Namespace: string(enumDesc.FullName().Parent()),
+			Symbols:   symbols,
+			Default:   symbols[0],
+		}, encode, zero, nil
+
+	default:
+		return nil, nil, nil, fmt.Errorf("unsupported kind %s", fieldDesc.Kind())
+	}
+}
+
+// decimalSchema is the decimal logical type for fields with a fixed scale,
+// with the precision of the digits the field can hold, and otherwise a
+// string, as the scale of each value may differ.
+func decimalSchema(tc *flatfile_pb.Field) (any, encodeFunc) {
+	precision, scale := binfile.DecimalPrecision(tc)
+	if scale == 0 {
+		return "string", func(buf []byte, val protoreflect.Value) ([]byte, error) {
+			return encodeString(buf, decimalText(val.Message()))
+		}
+	}
+
+	return logicalSchema{Type: "bytes", LogicalType: "decimal", Precision: int(precision), Scale: int(scale)},
+		func(buf []byte, val protoreflect.Value) ([]byte, error) {
+			text := decimalText(val.Message())
+			value, err := decimal.NewFromString(text.String())
+			if err != nil {
+				return buf, fmt.Errorf("invalid decimal %q: %w", text.String(), err)
+			}
+			unscaled := value.Shift(scale)
+			if !unscaled.IsInteger() {
+				return buf, fmt.Errorf("decimal %s has more than %d decimal places", value, scale)
+			}
+			return appendBytes(buf, twosComplement(unscaled.BigInt())), nil
+		}
+}
+
+func decimalText(msg protoreflect.Message) protoreflect.Value {
+	return msg.Get(msg.Descriptor().Fields().ByName("value"))
+}
+
+// twosComplement is the big endian two's complement of n in the fewest bytes,
+// as Avro decimals are written.
+func twosComplement(n *big.Int) []byte {
+	if n.Sign() >= 0 {
+		data := n.Bytes()
+		if len(data) == 0 || data[0]&0x80 != 0 {
+			data = append([]byte{0}, data...)
+		}
+		return data
+	}
+	// -n - 1 has the inverted bits of n.
+	inverted := new(big.Int).Not(n).Bytes()
+	data := make([]byte, len(inverted)+1)
+	copy(data[1:], inverted)
+	for idx := range data {
+		data[idx] = ^data[idx]
+	}
+	for len(data) > 1 && data[0] == 0xff && data[1]&0x80 != 0 {
+		data = data[1:]
+	}
+	return data
+}
+
+// encodeDate writes the days since 1970-01-01.
+func encodeDate(buf []byte, val protoreflect.Value) ([]byte, error) {
+	msg := val.Message()
+	fields := msg.Descriptor().Fields()
+	date := time.Date(
+		int(msg.Get(fields.ByName("year")).Int()),
+		time.Month(msg.Get(fields.ByName("month")).Int()),
+		int(msg.Get(fields.ByName("day")).Int()),
+		0, 0, 0, 0, time.UTC)
+	// Midnight UTC is a whole number of days from the epoch.
+	return appendLong(buf, date.Unix()/(24*60*60)), nil
+}
+
+func wrapperEncoder(desc protoreflect.MessageDescriptor, encode encodeFunc) encodeFunc {
+	valueField := desc.Fields().ByName("value")
+	return func(buf []byte, val protoreflect.Value) ([]byte, error) {
+		return encode(buf, val.Message().Get(valueField))
+	}
+}
+
+func nullableEncoder(encode encodeFunc) encodeFunc {
+	return func(buf []byte, val protoreflect.Value) ([]byte, error) {
+		if !val.IsValid() {
+			return appendLong(buf, 0), nil
+		}
+		return encode(appendLong(buf, 1), val)
+	}
+}
+
+// listEncoder writes the items in one block, then the empty block which ends
+// the array.
+func listEncoder(encodeItem encodeFunc) encodeFunc {
+	return func(buf []byte, val protoreflect.Value) ([]byte, error) {
+		list := val.List()
+		if list.Len() > 0 {
+			buf = appendLong(buf, int64(list.Len()))
+			var err error
+			for idx := range list.Len() {
+				buf, err = encodeItem(buf, list.Get(idx))
+				if err != nil {
+					return buf, fmt.Errorf("item %d: %w", idx, err)
+				}
+			}
+		}
+		return appendLong(buf, 0), nil
+	}
+}
+
+// mapEncoder writes the entries in one block, keyed by the text of the proto
+// keys, then the empty block which ends the map.
+func mapEncoder(encodeValue encodeFunc) encodeFunc {
+	return func(buf []byte, val protoreflect.Value) ([]byte, error) {
+		entries := val.Map()
+		if entries.Len() > 0 {
+			buf = appendLong(buf, int64(entries.Len()))
+			var err error
+			entries.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				buf = appendBytes(buf, []byte(key.String()))
+				buf, err = encodeValue(buf, value)
+				if err != nil {
+					err = fmt.Errorf("key %s: %w", key.String(), err)
+				}
+				return err == nil
+			})
+			if err != nil {
+				return buf, err
+			}
+		}
+		return appendLong(buf, 0), nil
+	}
+}
+
+func encodeString(buf []byte, val protoreflect.Value) ([]byte, error) {
+	text := val.String()
+	if !utf8.ValidString(text) {
+		return buf, fmt.Errorf("string %q is not UTF-8", text)
+	}
+	buf = appendLong(buf, int64(len(text)))
+	return append(buf, text...), nil
+}
+
+func encodeBytes(buf []byte, val protoreflect.Value) ([]byte, error) {
+	return appendBytes(buf, val.Bytes()), nil
+}
+
+func encodeBool(buf []byte, val protoreflect.Value) ([]byte, error) {
+	if val.Bool() {
+		return append(buf, 1), nil
+	}
+	return append(buf, 0), nil
+}
+
+func encodeFloat(buf []byte, val protoreflect.Value) ([]byte, error) {
+	return binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(val.Float()))), nil
+}
+
+func encodeDouble(buf []byte, val protoreflect.Value) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(buf, math.Float64bits(val.Float())), nil
+}
+
+func encodeInt(buf []byte, val protoreflect.Value) ([]byte, error) {
+	return appendLong(buf, val.Int()), nil
+}
+
+func encodeUint(buf []byte, val protoreflect.Value) ([]byte, error) {
+	n := val.Uint()
+	if n > math.MaxInt64 {
+		return buf, fmt.Errorf("%d is too large for an Avro long", n)
+	}
+	return appendLong(buf, int64(n)), nil
+}
+
+// appendLong writes an Avro int or long, a zig-zag varint.
+func appendLong(buf []byte, n int64) []byte {
+	return binary.AppendUvarint(buf, uint64(n<<1)^uint64(n>>63))
+}
+
+func appendBytes(buf []byte, data []byte) []byte {
+	buf = appendLong(buf, int64(len(data)))
+	return append(buf, data...)
+}
@@ -0,0 +1,225 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flowtest/prototest"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const testProto = `
+	syntax = "proto3";
+	package avro.v1;
+
+	import "flatfile/v1/annotations.proto";
+	import "google/protobuf/wrappers.proto";
+	import "j5/types/date/v1/date.proto";
+	import "j5/types/decimal/v1/decimal.proto";
+
+	message Payment {
+	  option (flatfile.v1.message) = { record_length: 32 };
+	  string account = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 6 }, string: { trim: TRIM_RIGHT }, description: "The payer." }];
+	  j5.types.decimal.v1.Decimal amount = 2 [(flatfile.v1.field) = { fixed_width: { offset: 6, length: 7 }, number: { fixed_scale: 2 } }];
+	  j5.types.date.v1.Date posted = 3 [(flatfile.v1.field) = { fixed_width: { offset: 13, length: 8 }, date: { format: "YYYYMMDD" } }];
+	  Status status = 4 [(flatfile.v1.field) = { fixed_width: { offset: 21, length: 1 } }];
+	  int32 count = 5 [(flatfile.v1.field) = { fixed_width: { offset: 22, length: 3 }, number: {} }];
+	  google.protobuf.StringValue memo = 6 [(flatfile.v1.field) = { fixed_width: { offset: 25, length: 4 }, string: { trim: TRIM_RIGHT } }];
+	  j5.types.decimal.v1.Decimal fee = 7 [(flatfile.v1.field) = { fixed_width: { offset: 29, length: 3 } }];
+	  repeated Status history = 8;
+	  Payment reversal = 9;
+	}
+
+	enum Status {
+	  STATUS_UNSPECIFIED = 0;
+	  STATUS_POSTED = 1 [(flatfile.v1.enum).key = "P"];
+	}`
+
+func testDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": testProto})
+	return fileDesc.MessageByName(t, "avro.v1.Payment")
+}
+
+func TestSchema(t *testing.T) {
+	encoder, err := NewEncoder(testDescriptor(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"type": "record", "name": "Payment", "namespace": "avro.v1", "fields": [
+	  {"name": "account", "doc": "The payer.", "type": "string", "default": ""},
+	  {"name": "amount", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 7, "scale": 2}], "default": null},
+	  {"name": "posted", "type": ["null", {"type": "int", "logicalType": "date"}], "default": null},
+	  {"name": "status", "type": {"type": "enum", "name": "Status", "namespace": "avro.v1",
+		"symbols": ["STATUS_UNSPECIFIED", "STATUS_POSTED"], "default": "STATUS_UNSPECIFIED"}, "default": "STATUS_UNSPECIFIED"},
+	  {"name": "count", "type": "int", "default": 0},
+	  {"name": "memo", "type": ["null", "string"], "default": null},
+	  {"name": "fee", "type": ["null", "string"], "default": null},
+	  {"name": "history", "type": {"type": "array", "items": "avro.v1.Status"}, "default": []},
+	  {"name": "reversal", "type": ["null", "avro.v1.Payment"], "default": null}
+	]}`
+	compact := &bytes.Buffer{}
+	if err := json.Compact(compact, []byte(want)); err != nil {
+		t.Fatal(err)
+	}
+	if got := encoder.Schema(); string(got) != compact.String() {
+		t.Errorf("got:\n%s\nwant:\n%s", got, compact)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	msgDesc := testDescriptor(t)
+	encoder, err := NewEncoder(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := binfile.ParseMessage(msg, []byte("ACME  "+"-012.50"+"20240131"+"P"+"064"+"    "+"1.5")); err != nil {
+		t.Fatal(err)
+	}
+	got, err := encoder.Append(nil, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []byte{
+		0x08, 'A', 'C', 'M', 'E', // account
+		0x02, 0x04, 0xfb, 0x1e, // amount, -1250 in two bytes
+		0x02, 0xd2, 0xb4, 0x02, // posted, 19753 days
+		0x02,       // status 1
+		0x80, 0x01, // count 64
+		0x00,                      // memo null
+		0x02, 0x06, '1', '.', '5', // fee as a string
+		0x00, // empty history
+		0x00, // reversal null
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got  % x\nwant % x", got, want)
+	}
+
+	// Decimals with more places than the scale are an error rather than
+	// rounded.
+	if err := binfile.ParseMessage(msg, []byte("ACME  "+"012.505"+"20240131"+"P"+"064"+"    "+"1.5")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := encoder.Append(nil, msg); err == nil {
+		t.Error("expected an error for a decimal of 3 places")
+	}
+}
+
+func TestImpliedScale(t *testing.T) {
+	msgDesc := testDescriptor(t)
+	encoder, err := NewEncoder(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The amount has no decimal point in the record, only the fixed scale.
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := binfile.ParseMessage(msg, []byte("ACME  "+"0001234"+"20240131"+"P"+"001"+"    "+"   ")); err != nil {
+		t.Fatal(err)
+	}
+	got, err := encoder.Append(nil, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// After the account, the union branch, the length and the unscaled bytes.
+	amount := got[5:]
+	if amount[0] != 0x02 || amount[1] != 0x04 {
+		t.Fatalf("got amount % x", amount[:2])
+	}
+	unscaled := new(big.Int).SetBytes(amount[2:4])
+	if value := decimal.NewFromBigInt(unscaled, -2); value.String() != "12.34" {
+		t.Errorf("got amount %s, want 12.34", value)
+	}
+}
+
+func TestTwosComplement(t *testing.T) {
+	for n, want := range map[int64][]byte{
+		0:    {0x00},
+		127:  {0x7f},
+		128:  {0x00, 0x80},
+		-1:   {0xff},
+		-128: {0x80},
+		-129: {0xff, 0x7f},
+		-256: {0xff, 0x00},
+	} {
+		if got := twosComplement(big.NewInt(n)); !bytes.Equal(got, want) {
+			t.Errorf("%d: got % x, want % x", n, got, want)
+		}
+	}
+}
+
+func TestWriter(t *testing.T) {
+	msgDesc := testDescriptor(t)
+	encoder, err := NewEncoder(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	writer, err := NewWriter(buf, encoder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := binfile.ParseMessage(msg, []byte("ACME  "+"0012.50"+"20240131"+"P"+"001"+"NOTE"+"   ")); err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		if err := writer.Write(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buf.Bytes()
+	if !bytes.HasPrefix(data, []byte("Obj\x01")) {
+		t.Fatalf("got header % x", data[:4])
+	}
+	data = data[4:]
+	readLong := func() int64 {
+		n, size := binary.Uvarint(data)
+		data = data[size:]
+		return int64(n>>1) ^ -int64(n&1)
+	}
+	readBytes := func() []byte {
+		n := readLong()
+		out := data[:n]
+		data = data[n:]
+		return out
+	}
+
+	meta := map[string]string{}
+	for count := readLong(); count > 0; count-- {
+		key := readBytes()
+		meta[string(key)] = string(readBytes())
+	}
+	if readLong() != 0 || meta["avro.codec"] != "null" || meta["avro.schema"] != string(encoder.Schema()) {
+		t.Fatalf("got metadata %v", meta)
+	}
+	sync := data[:16]
+	data = data[16:]
+
+	record, err := encoder.Append(nil, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := readLong(); count != 3 {
+		t.Errorf("got %d records in the block", count)
+	}
+	if block := readBytes(); !bytes.Equal(block, bytes.Repeat(record, 3)) {
+		t.Errorf("got block % x", block)
+	}
+	if !bytes.Equal(data, sync) {
+		t.Errorf("got % x after the block, want the sync marker", data)
+	}
+}
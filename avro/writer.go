@@ -0,0 +1,88 @@
+package avro
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// BlockSize is the size of the encoded records at which a Writer writes a
+// block, so that readers can split files of many records.
+const BlockSize = 64 * 1024
+
+// Writer writes an Avro object container file, with the schema of the
+// encoder and uncompressed blocks of records.
+type Writer struct {
+	w       io.Writer
+	encoder *Encoder
+	sync    [16]byte
+
+	// block holds the records not yet written, and count their number.
+	block []byte
+	count int64
+}
+
+// NewWriter writes the header of the file, with the schema of the encoder.
+func NewWriter(w io.Writer, encoder *Encoder) (*Writer, error) {
+	writer := &Writer{w: w, encoder: encoder}
+	if _, err := rand.Read(writer.sync[:]); err != nil {
+		return nil, err
+	}
+
+	header := []byte("Obj\x01")
+	// The metadata is a map of one block of two entries.
+	header = appendLong(header, 2)
+	header = appendBytes(header, []byte("avro.schema"))
+	header = appendBytes(header, encoder.Schema())
+	header = appendBytes(header, []byte("avro.codec"))
+	header = appendBytes(header, []byte("null"))
+	header = appendLong(header, 0)
+	header = append(header, writer.sync[:]...)
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Write adds the message to the current block, writing the block once it
+// reaches BlockSize.
+func (w *Writer) Write(msg proto.Message) error {
+	block, err := w.encoder.Append(w.block, msg)
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", w.encoder.Descriptor().FullName(), err)
+	}
+	w.block = block
+	w.count++
+	if len(w.block) >= BlockSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes the records of the current block, if there are any.
+func (w *Writer) Flush() error {
+	if w.count == 0 {
+		return nil
+	}
+	header := appendLong(nil, w.count)
+	header = appendLong(header, int64(len(w.block)))
+	if _, err := w.w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(w.block); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(w.sync[:]); err != nil {
+		return err
+	}
+	w.block = w.block[:0]
+	w.count = 0
+	return nil
+}
+
+// Close flushes the last block, leaving the underlying writer open.
+func (w *Writer) Close() error {
+	return w.Flush()
+}
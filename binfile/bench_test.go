@@ -0,0 +1,136 @@
+package binfile
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func benchMessage(b *testing.B) protoreflect.MessageDescriptor {
+	fileDesc := prototest.DescriptorsFromSource(b, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package bench.v1;
+
+		import "flatfile/v1/annotations.proto";
+		import "j5/types/date/v1/date.proto";
+		import "j5/types/decimal/v1/decimal.proto";
+
+		message Record {
+		  Type type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+		  string account = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 1, length: 10 }
+			string: { trim: TRIM_BOTH }
+		  }];
+		  string name = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 11, length: 20 }
+			string: { trim: TRIM_RIGHT }
+		  }];
+		  j5.types.date.v1.Date posted = 4 [(flatfile.v1.field) = {
+			fixed_width: { offset: 31, length: 8 }
+			date: { format: "YYYYMMDD" }
+		  }];
+		  j5.types.decimal.v1.Decimal amount = 5 [(flatfile.v1.field) = {
+			fixed_width: { offset: 39, length: 12 }
+			number: { fixed_scale: 2 }
+		  }];
+		  int32 count = 6 [(flatfile.v1.field) = {
+			fixed_width: { offset: 51, length: 5 }
+			number: {}
+		  }];
+		  int64 sequence = 7 [(flatfile.v1.field) = {
+			fixed_width: { offset: 56, length: 10 }
+			number: {}
+		  }];
+		  bool active = 8 [(flatfile.v1.field) = { fixed_width: { offset: 66, length: 1 } }];
+		}
+
+		enum Type {
+		  TYPE_UNSPECIFIED = 0;
+		  TYPE_DETAIL = 1 [(flatfile.v1.enum).key = "D"];
+		  TYPE_TRAILER = 2 [(flatfile.v1.enum).key = "T"];
+		}`})
+	return fileDesc.MessageByName(b, "bench.v1.Record")
+}
+
+var benchRecord = []byte(strings.Join([]string{
+	"D",
+	"  12345678",
+	"ACME WIDGETS        ",
+	"20240131",
+	"000000123456",
+	"00042",
+	"0000001234",
+	"Y",
+}, ""))
+
+func BenchmarkParseMessage(b *testing.B) {
+	msgDesc := benchMessage(b)
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := ParseMessage(dynamicpb.NewMessage(msgDesc), benchRecord); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMessageParser(b *testing.B) {
+	msgDesc := benchMessage(b)
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := parser.Parse(dynamicpb.NewMessage(msgDesc), benchRecord); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseBatch(b *testing.B) {
+	msgDesc := benchMessage(b)
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	records := make([][]byte, 100)
+	out := make([]proto.Message, len(records))
+	for idx := range records {
+		records[idx] = benchRecord
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for idx := range out {
+			out[idx] = dynamicpb.NewMessage(msgDesc)
+		}
+		if err := parser.ParseBatch(records, out); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFileWriter(b *testing.B) {
+	msgDesc := benchMessage(b)
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, benchRecord); err != nil {
+		b.Fatal(err)
+	}
+
+	fw := NewFileWriter(io.Discard)
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := fw.Write(msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if err := fw.Flush(); err != nil {
+		b.Fatal(err)
+	}
+}
@@ -1,16 +1,23 @@
 package binfile
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
 	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
-	"github.com/pentops/golib/gl"
+	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
 	"github.com/pentops/j5/j5types/date_j5t"
 	"github.com/pentops/j5/j5types/decimal_j5t"
 	"github.com/shopspring/decimal"
@@ -19,41 +26,125 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-func ParseMessage(msg proto.Message, data []byte) error {
-	refl := msg.ProtoReflect()
-	desc := refl.Descriptor()
+// ParseMessage parses the fixed width record into msg, following the flatfile
+// annotations of its message. Options change how this call parses, such as
+// WithCollectErrors or WithFieldMask.
+func ParseMessage(msg proto.Message, data []byte, opts ...ParseOption) error {
+	_, err := ParseMessageWithWarnings(msg, data, opts...)
+	return err
+}
 
-	oneBased := false
+// ParseMessageWithWarnings parses the record as ParseMessage does, also
+// returning the errors of fields annotated with SEVERITY_WARNING. Those fields
+// are left unset and do not fail the record.
+func ParseMessageWithWarnings(msg proto.Message, data []byte, opts ...ParseOption) ([]*FieldError, error) {
+	return parseMessage(msg.ProtoReflect(), data, newParseOptions(opts))
+}
 
-	ext, ok := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
-	if ok && ext != nil {
-		oneBased = ext.OneBased
-	}
+// ParseMessagePartial parses every field it can rather than stopping at the
+// first failure, so that diagnostics can show which fields did parse. Fields
+// which fail are left unset and their errors are joined into the returned
+// error, alongside any record length error. The record level checks, for
+// trailing data, coverage and rules, only run when every field parses.
+func ParseMessagePartial(msg proto.Message, data []byte) ([]*FieldError, error) {
+	return parseMessage(msg.ProtoReflect(), data, parseOptions{partial: true})
+}
 
-	rr := NewReader(data, oneBased)
+// ParseReflect parses the record into m as ParseMessage does, for callers
+// which work with protoreflect.Message, such as generic pipelines over
+// dynamicpb messages.
+func ParseReflect(m protoreflect.Message, data []byte, opts ...ParseOption) error {
+	_, err := parseMessage(m, data, newParseOptions(opts))
+	return err
+}
 
-	fields := desc.Fields()
+func parseMessage(refl protoreflect.Message, data []byte, options parseOptions) ([]*FieldError, error) {
+	parser, err := cachedParser(refl.Descriptor())
+	if err != nil {
+		return nil, err
+	}
+	return parser.parse(refl, data, options)
+}
 
-	for i := range fields.Len() {
-		fieldDesc := fields.Get(i)
+func checkRecordLength(ext *flatfile_pb.Message, length int) error {
+	if ext.RecordLength == 0 {
+		return nil
+	}
+	want := int(ext.RecordLength)
 
-		val, err := rr.ReadField(fieldDesc)
-		if err != nil {
-			return fmt.Errorf("error reading field %s: %w", fieldDesc.FullName(), err)
+	switch ext.RecordLengthMode {
+	case flatfile_pb.LengthMode_LENGTH_MODE_UNSPECIFIED, flatfile_pb.LengthMode_LENGTH_MODE_EXACT:
+		if length != want {
+			return fmt.Errorf("%w: got %d bytes, expected %d", ErrRecordLength, length, want)
 		}
-		if val == nil {
-			continue
+	case flatfile_pb.LengthMode_LENGTH_MODE_MINIMUM:
+		if length < want {
+			return fmt.Errorf("%w: got %d bytes, expected at least %d", ErrRecordLength, length, want)
+		}
+	case flatfile_pb.LengthMode_LENGTH_MODE_MAXIMUM:
+		if length > want {
+			return fmt.Errorf("%w: got %d bytes, expected at most %d", ErrRecordLength, length, want)
 		}
-		refl.Set(fieldDesc, *val)
+	default:
+		return fmt.Errorf("unknown record length mode %d", ext.RecordLengthMode)
+	}
+	return nil
+}
 
+// fieldOptions returns the annotations of the field, with the defaults of its
+// message, such as default_date_format, set where the field omits them.
+func fieldOptions(fieldDesc protoreflect.FieldDescriptor) *flatfile_pb.Field {
+	tc, _ := proto.GetExtension(fieldDesc.Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+	msgDesc, ok := fieldDesc.Parent().(protoreflect.MessageDescriptor)
+	if !ok {
+		return tc
 	}
+	ext, _ := proto.GetExtension(msgDesc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	return fieldDefaults{
+		trim:       ext.GetDefaultTrim(),
+		dateFormat: ext.GetDefaultDateFormat(),
+	}.apply(fieldDesc, tc)
+}
 
+// setRawField copies the raw text of the field into the sibling named by
+// raw_field, if any.
+func (r *Reader) setRawField(refl protoreflect.Message, field *compiledField) error {
+	strVal, ok, err := r.rawText(field)
+	if err != nil || !ok {
+		return err
+	}
+	refl.Set(field.rawDesc, protoreflect.ValueOfString(strVal))
 	return nil
 }
 
+// rawText reads the untrimmed text of the field for its raw_field sibling,
+// returning false when there is no sibling or the record is too short to set
+// it.
+func (r *Reader) rawText(field *compiledField) (string, bool, error) {
+	if field.rawDesc == nil {
+		return "", false, nil
+	}
+	if field.tc.TreatShortAs == flatfile_pb.ShortIs_SHORT_IS_UNSET && r.isShort(field.tc) {
+		return "", false, nil
+	}
+
+	strVal, err := r.getString(field.tc)
+	if err != nil {
+		return "", false, err
+	}
+	return strVal, true, nil
+}
+
 type Reader struct {
 	Record   []byte
 	OneBased bool
+
+	// pattern is the compiled pattern of the field being read.
+	pattern *regexp.Regexp
+
+	// warnings are the errors of the SEVERITY_WARNING fields read through
+	// GeneratedParser.Read, returned by FinishWithWarnings.
+	warnings []*FieldError
 }
 
 func NewReader(data []byte, oneBased bool) *Reader {
@@ -63,14 +154,50 @@ func NewReader(data []byte, oneBased bool) *Reader {
 	}
 }
 
-func (r *Reader) getBytes(tc *flatfile_pb.Field) ([]byte, error) {
+// span returns the zero based offset and the length of the field.
+func (r *Reader) span(tc *flatfile_pb.Field) (int, int) {
 	offset := int(tc.FixedWidth.Offset)
 	length := int(tc.FixedWidth.Length)
 	if r.OneBased {
 		offset = offset - 1
 	}
+	return offset, length
+}
+
+// isShort is true when the record ends before the end of the field.
+func (r *Reader) isShort(tc *flatfile_pb.Field) bool {
+	offset, length := r.span(tc)
+	return offset+length > len(r.Record)
+}
+
+func (r *Reader) checkRequired(tc *flatfile_pb.Field) error {
+	if r.isShort(tc) {
+		return ErrRequired
+	}
+	if tc.GetNumber().GetEncoding() == flatfile_pb.Encoding_ENCODING_BINARY {
+		return nil
+	}
+	raw, err := r.getBytes(tc)
+	if err != nil {
+		return err
+	}
+	if len(bytes.Trim(raw, " \x00")) == 0 {
+		return ErrRequired
+	}
+	return nil
+}
+
+func (r *Reader) getBytes(tc *flatfile_pb.Field) ([]byte, error) {
+	offset, length := r.span(tc)
 	if offset+length > len(r.Record) {
-		return nil, fmt.Errorf("short record")
+		if tc.TreatShortAs != flatfile_pb.ShortIs_SHORT_IS_PADDED {
+			return nil, ErrShortRecord
+		}
+		padded := bytes.Repeat([]byte{' '}, length)
+		if offset < len(r.Record) {
+			copy(padded, r.Record[offset:])
+		}
+		return padded, nil
 	}
 	return r.Record[offset : offset+length], nil
 }
@@ -84,38 +211,94 @@ func (r *Reader) getString(tc *flatfile_pb.Field) (string, error) {
 }
 
 func (r *Reader) getNumberString(tc *flatfile_pb.Field) (string, error) {
-	strVal, err := r.getString(tc)
+	byteVal, err := r.getBytes(tc)
 	if err != nil {
 		return "", err
 
 	}
 	number := tc.GetNumber()
 	if number == nil {
-		return strings.TrimSpace(strVal), nil
+		return string(bytes.TrimSpace(byteVal)), nil
 	}
 
+	var strVal string
 	switch number.Encoding {
 	case flatfile_pb.Encoding_ENCODING_UNSPECIFIED:
-		return strings.TrimSpace(strVal), nil
+		if number.Policy == flatfile_pb.NumberPolicy_NUMBER_POLICY_SALVAGE {
+			strVal = salvageNumber(byteVal)
+		} else {
+			strVal = string(bytes.TrimSpace(byteVal))
+		}
 	case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL:
-		strVal, err = UnpackPacked([]byte(strVal))
+		strVal, err = UnpackPacked(byteVal)
 		if err != nil {
-			return "", fmt.Errorf("error unpacking packed decimal: %w", err)
+			return "", fmt.Errorf("%w: unpacking packed decimal: %w", ErrInvalidNumber, err)
 		}
-		return strVal, nil
 	case flatfile_pb.Encoding_ENCODING_OVERPUNCH:
-		strVal, err = DecodeOverpunch([]byte(strVal))
+		strVal, err = DecodeOverpunch(byteVal)
 		if err != nil {
-			return "", fmt.Errorf("error decoding overpunch decimal: %w", err)
+			return "", fmt.Errorf("%w: decoding overpunch decimal: %w", ErrInvalidNumber, err)
 		}
-		return strVal, nil
 	default:
 		return "", fmt.Errorf("unknown number encoding %d", number.Encoding)
 	}
+
+	if err := checkDigits(strVal, number); err != nil {
+		return "", err
+	}
+	return strVal, nil
+}
+
+// salvageNumber extracts a number from dirty text, keeping the digits and the
+// first decimal point, and treating a '-' anywhere as a negative sign.
+func salvageNumber(in []byte) string {
+	negative := false
+	hadPoint := false
+	out := make([]byte, 0, len(in)+1)
+	for _, c := range in {
+		switch {
+		case c >= '0' && c <= '9':
+			out = append(out, c)
+		case c == '.' && !hadPoint:
+			hadPoint = true
+			out = append(out, c)
+		case c == '-':
+			negative = true
+		}
+	}
+	if negative && len(out) > 0 {
+		return "-" + string(out)
+	}
+	return string(out)
+}
+
+// checkDigits validates a decoded number string against the declared
+// max_digits and max_fraction_digits of the field.
+func checkDigits(numString string, number *flatfile_pb.NumberField) error {
+	if number.MaxDigits == 0 && number.MaxFractionDigits == nil {
+		return nil
+	}
+
+	_, digits := splitSign(numString)
+	intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+
+	if maxFrac := number.MaxFractionDigits; maxFrac != nil {
+		if *maxFrac == 0 && hasPoint {
+			return fmt.Errorf("%w: unexpected decimal point in %q", ErrInvalidNumber, numString)
+		}
+		if len(fracPart) > int(*maxFrac) {
+			return fmt.Errorf("%w: %q has more than %d fraction digits", ErrOverflow, numString, *maxFrac)
+		}
+	}
+
+	if number.MaxDigits > 0 && len(intPart)+len(fracPart) > int(number.MaxDigits) {
+		return fmt.Errorf("%w: %q has more than %d digits", ErrOverflow, numString, number.MaxDigits)
+	}
+	return nil
 }
 
 func (r *Reader) ReadField(fieldDesc protoreflect.FieldDescriptor) (*protoreflect.Value, error) {
-	tc := proto.GetExtension(fieldDesc.Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+	tc := defaults().fieldOptions(fieldDesc)
 	if tc == nil {
 		return nil, nil
 	}
@@ -123,150 +306,462 @@ func (r *Reader) ReadField(fieldDesc protoreflect.FieldDescriptor) (*protoreflec
 		return nil, nil
 	}
 
+	read, err := fieldReader(fieldDesc, tc)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := compilePattern(tc)
+	if err != nil {
+		return nil, err
+	}
+	val, err := r.readField(&compiledField{desc: fieldDesc, tc: tc, read: read, pattern: pattern})
+	if err != nil || !val.IsValid() {
+		return nil, err
+	}
+	return &val, nil
+}
+
+// readField runs the checks common to every type of field, then reads the
+// value with read. The value is returned rather than a pointer to it, to
+// avoid allocating for every field, and is invalid when the field is unset.
+func (r *Reader) readField(field *compiledField) (protoreflect.Value, error) {
+	tc := field.tc
+	r.pattern = field.pattern
+
+	if tc.Required {
+		if err := r.checkRequired(tc); err != nil {
+			return protoreflect.Value{}, err
+		}
+	}
+
+	if tc.TreatShortAs == flatfile_pb.ShortIs_SHORT_IS_UNSET && r.isShort(tc) {
+		return protoreflect.Value{}, nil
+	}
+
+	if tc.CheckDigit != flatfile_pb.CheckDigit_CHECK_DIGIT_UNSPECIFIED {
+		if err := r.checkDigit(tc); err != nil {
+			return protoreflect.Value{}, err
+		}
+	}
+
+	if tc.Checksum != nil {
+		if err := r.checkChecksum(tc); err != nil {
+			return protoreflect.Value{}, err
+		}
+	}
+
+	return field.read(r, tc)
+}
+
+type fieldReadFunc func(r *Reader, tc *flatfile_pb.Field) (protoreflect.Value, error)
+
+// fieldReader picks the read method for the type of the field.
+func fieldReader(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) (fieldReadFunc, error) {
+	if codec, err := customCodec(tc); codec != nil || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		return codecReader(fieldDesc, codec), nil
+	}
+
 	switch fieldDesc.Kind() {
 	case protoreflect.MessageKind:
 		switch fieldDesc.Message().FullName() {
 		case "google.protobuf.StringValue":
-			return r.readStringValue(tc)
+			return (*Reader).readStringValue, nil
 		case "google.protobuf.BoolValue":
-			return r.readBoolValue(tc)
+			return (*Reader).readBoolWrapper, nil
 		case "j5.types.decimal.v1.Decimal":
-			return r.readDecimal(tc)
+			return (*Reader).readDecimal, nil
 		case "j5.types.date.v1.Date":
-			return r.readDate(tc)
+			return dateReader(tc), nil
 		default:
+			if codec, ok := lookupMessageCodec(fieldDesc.Message().FullName()); ok {
+				return codecReader(fieldDesc, codec), nil
+			}
 			return nil, fmt.Errorf("unknown struct type %s", fieldDesc.Message().FullName())
 		}
 
 	case protoreflect.StringKind:
-		return r.readString(tc)
+		if isUUIDKey(fieldDesc) {
+			return (*Reader).readUUID, nil
+		}
+		return (*Reader).readString, nil
 
 	case protoreflect.BoolKind:
-		return r.readBoolValue(tc)
+		return (*Reader).readBoolValue, nil
 
 	case protoreflect.EnumKind:
-		return r.readEnum(tc, fieldDesc.Enum())
+		keys := enumKeysFor(fieldDesc.Enum())
+		return func(r *Reader, tc *flatfile_pb.Field) (protoreflect.Value, error) {
+			return r.readEnum(tc, keys)
+		}, nil
 
 	case protoreflect.Uint32Kind:
-		return r.readUint32(tc)
+		return (*Reader).readUint32, nil
 
 	case protoreflect.Uint64Kind:
-		return r.readUint64(tc)
+		return (*Reader).readUint64, nil
 
 	case protoreflect.Int32Kind:
-		return r.readInt32(tc)
+		return (*Reader).readInt32, nil
 
 	case protoreflect.Int64Kind:
-		return r.readInt64(tc)
+		return (*Reader).readInt64, nil
 
 	default:
 		return nil, fmt.Errorf("unknown type/kind: %s", fieldDesc.Kind())
 	}
 }
 
-func trimString(str string, tc *flatfile_pb.Field) string {
+func trimString(val []byte, tc *flatfile_pb.Field) []byte {
 	stringField := tc.GetString_()
 	if stringField == nil {
-		return str
+		return val
 	}
 
-	trimChars := stringField.TrimChars
+	return applyTrim(val, stringField.Trim, stringField.TrimChars)
+}
+
+// applyTrim trims the field's bytes, returning a sub slice so that only the
+// trimmed value is copied when it becomes a string.
+func applyTrim(val []byte, trim flatfile_pb.Trim, trimChars string) []byte {
 	if trimChars == "" {
 		trimChars = " "
 	}
 
-	switch stringField.Trim {
+	switch trim {
 	case flatfile_pb.Trim_TRIM_UNSPECIFIED:
-		return str
+		return val
 	case flatfile_pb.Trim_TRIM_LEFT:
-		return strings.TrimLeft(str, trimChars)
+		return bytes.TrimLeft(val, trimChars)
 	case flatfile_pb.Trim_TRIM_RIGHT:
-		return strings.TrimRight(str, trimChars)
+		return bytes.TrimRight(val, trimChars)
 	case flatfile_pb.Trim_TRIM_BOTH:
-		return strings.Trim(str, trimChars)
+		return bytes.Trim(val, trimChars)
+	case flatfile_pb.Trim_TRIM_RIGHT_PADDING:
+		return bytes.TrimRight(val, " \x00")
 	default:
-		return str
+		return val
 	}
 }
 
-func (r *Reader) readString(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
-	strVal, err := r.getString(tc)
+func (r *Reader) checkDigit(tc *flatfile_pb.Field) error {
+	byteVal, err := r.getBytes(tc)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	byteVal = bytes.TrimSpace(byteVal)
+	if len(byteVal) == 0 {
+		return nil
+	}
+	strVal := string(byteVal)
+	valid, err := ValidCheckDigit(tc.CheckDigit, strVal)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCheckDigit, err)
+	}
+	if !valid {
+		return fmt.Errorf("%w: %q", ErrCheckDigit, strVal)
+	}
+	return nil
+}
+
+// getTrimmedString reads the field as a string, trims and then validates it
+// according to the StringField options.
+func (r *Reader) getTrimmedString(tc *flatfile_pb.Field) (string, error) {
+	byteVal, err := r.getBytes(tc)
+	if err != nil {
+		return "", err
+	}
+	strVal := string(trimString(byteVal, tc))
+
+	stringField := tc.GetString_()
+	if stringField.GetCollapseSpaces() {
+		strVal = collapseSpaces(strVal)
+	}
+
+	strVal, err = normalizeString(strVal, stringField.GetNormalize())
+	if err != nil {
+		return "", err
+	}
+
+	if err := validateString(strVal, stringField, r.pattern); err != nil {
+		return "", err
+	}
+
+	if len(stringField.GetMapping()) > 0 {
+		mapped, ok := stringField.Mapping[strVal]
+		if ok {
+			return mapped, nil
+		}
+		if stringField.MappingRequired && strVal != "" {
+			return "", fmt.Errorf("%w: no mapping for value %q", ErrInvalidString, strVal)
+		}
 	}
-	strVal = trimString(strVal, tc)
+	return strVal, nil
+}
 
-	return gl.Ptr(protoreflect.ValueOfString(strVal)), nil
+func collapseSpaces(strVal string) string {
+	if !strings.Contains(strVal, "  ") {
+		return strVal
+	}
+	out := make([]byte, 0, len(strVal))
+	for idx := range len(strVal) {
+		if strVal[idx] == ' ' && idx > 0 && strVal[idx-1] == ' ' {
+			continue
+		}
+		out = append(out, strVal[idx])
+	}
+	return string(out)
 }
 
-func (r *Reader) readStringValue(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
-	strVal, err := r.getString(tc)
+func normalizeString(strVal string, normalize flatfile_pb.Normalize) (string, error) {
+	switch normalize {
+	case flatfile_pb.Normalize_NORMALIZE_UNSPECIFIED:
+		return strVal, nil
+	case flatfile_pb.Normalize_NORMALIZE_UPPER:
+		return strings.ToUpper(strVal), nil
+	case flatfile_pb.Normalize_NORMALIZE_LOWER:
+		return strings.ToLower(strVal), nil
+	case flatfile_pb.Normalize_NORMALIZE_TITLE:
+		out := []rune(strings.ToLower(strVal))
+		startOfWord := true
+		for idx, c := range out {
+			if startOfWord {
+				out[idx] = unicode.ToUpper(c)
+			}
+			startOfWord = !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '\''
+		}
+		return string(out), nil
+	default:
+		return "", fmt.Errorf("unknown normalize %d", normalize)
+	}
+}
+
+func validateString(strVal string, stringField *flatfile_pb.StringField, pattern *regexp.Regexp) error {
+	if stringField == nil || strVal == "" {
+		return nil
+	}
+
+	if stringField.MinLength > 0 || stringField.MaxLength > 0 {
+		length := utf8.RuneCountInString(strVal)
+		if stringField.MinLength > 0 && length < int(stringField.MinLength) {
+			return fmt.Errorf("%w: value %q is shorter than %d characters", ErrInvalidString, strVal, stringField.MinLength)
+		}
+		if stringField.MaxLength > 0 && length > int(stringField.MaxLength) {
+			return fmt.Errorf("%w: value %q is longer than %d characters", ErrInvalidString, strVal, stringField.MaxLength)
+		}
+	}
+
+	if stringField.CharsetClass != flatfile_pb.CharsetClass_CHARSET_CLASS_UNSPECIFIED {
+		for idx, c := range []byte(strVal) {
+			ok, err := inCharsetClass(stringField.CharsetClass, c)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("%w: character %q at %d of %q is not %s", ErrInvalidString, c, idx, strVal, stringField.CharsetClass.ShortString())
+			}
+		}
+	}
+
+	if pattern != nil && !pattern.MatchString(strVal) {
+		return fmt.Errorf("%w: value %q does not match pattern %q", ErrInvalidString, strVal, stringField.Pattern)
+	}
+
+	return nil
+}
+
+func inCharsetClass(class flatfile_pb.CharsetClass, c byte) (bool, error) {
+	isDigit := c >= '0' && c <= '9'
+	isAlpha := (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || c == ' '
+	switch class {
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_NUMERIC:
+		return isDigit, nil
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_ALPHA:
+		return isAlpha, nil
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_ALPHANUMERIC:
+		return isAlpha || isDigit, nil
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_PRINTABLE:
+		return c >= 0x20 && c <= 0x7e, nil
+	default:
+		return false, fmt.Errorf("unknown charset class %d", class)
+	}
+}
+
+func (r *Reader) readString(tc *flatfile_pb.Field) (protoreflect.Value, error) {
+	strVal, err := r.getTrimmedString(tc)
 	if err != nil {
-		return nil, err
+		return protoreflect.Value{}, err
+	}
+	if strVal == "" && tc.GetString_().GetTreatBlankAs() == flatfile_pb.BlankIs_BLANK_IS_UNSET {
+		return protoreflect.Value{}, nil
+	}
+
+	return protoreflect.ValueOfString(strVal), nil
+}
+
+func (r *Reader) readStringValue(tc *flatfile_pb.Field) (protoreflect.Value, error) {
+	strVal, err := r.getTrimmedString(tc)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if strVal == "" && tc.GetString_().GetTreatBlankAs() != flatfile_pb.BlankIs_BLANK_IS_EMPTY {
+		return protoreflect.Value{}, nil
+	}
+	return protoreflect.ValueOfMessage((&wrapperspb.StringValue{Value: strVal}).ProtoReflect()), nil
+}
+
+// isUUIDKey is true for j5 key fields with the UUID format.
+func isUUIDKey(fieldDesc protoreflect.FieldDescriptor) bool {
+	j5Field, ok := proto.GetExtension(fieldDesc.Options(), ext_j5pb.E_Field).(*ext_j5pb.FieldOptions)
+	if !ok || j5Field == nil {
+		return false
 	}
-	strVal = trimString(strVal, tc)
+	return j5Field.GetKey().GetFormat() == ext_j5pb.KeyField_FORMAT_UUID
+}
+
+// readUUID accepts UUIDs with or without dashes, setting the canonical
+// lower case, dashed form.
+func (r *Reader) readUUID(tc *flatfile_pb.Field) (protoreflect.Value, error) {
+	strVal, err := r.getTrimmedString(tc)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	strVal = strings.TrimSpace(strVal)
 	if strVal == "" {
-		return nil, nil
+		return protoreflect.Value{}, nil
+	}
+	if len(strVal) != 32 && len(strVal) != 36 {
+		return protoreflect.Value{}, fmt.Errorf("%w: %q", ErrInvalidUUID, strVal)
+	}
+	id, err := uuid.Parse(strVal)
+	if err != nil {
+		return protoreflect.Value{}, fmt.Errorf("%w: %q", ErrInvalidUUID, strVal)
 	}
-	return gl.Ptr(protoreflect.ValueOfMessage((&wrapperspb.StringValue{Value: strVal}).ProtoReflect())), nil
+	return protoreflect.ValueOfString(id.String()), nil
 }
 
 var (
-	ErrMissingBool = errors.New("missing bool value")
+	ErrMissingBool  = errors.New("missing bool value")
+	ErrCheckDigit   = errors.New("invalid check digit")
+	ErrRecordLength = errors.New("invalid record length")
+	ErrUnmappedData = errors.New("data in unmapped bytes")
+	ErrTrailingData = errors.New("data after the last field")
+	ErrRule         = errors.New("record failed rule")
+	ErrRequired     = errors.New("required field is blank")
+	ErrChecksum     = errors.New("checksum mismatch")
+	ErrDuplicateKey = errors.New("duplicate key")
+
+	ErrShortRecord   = errors.New("short record")
+	ErrRecordTooLong = errors.New("record too long")
+	ErrInvalidNumber = errors.New("invalid number")
+	ErrOverflow      = errors.New("number out of range")
+	ErrInvalidString = errors.New("invalid string")
+	ErrInvalidDate   = errors.New("invalid date value")
+	ErrInvalidEnum   = errors.New("invalid enum value")
+	ErrInvalidUUID   = errors.New("invalid UUID value")
 )
 
-func (r *Reader) readBoolValue(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
-	strVal, err := r.getString(tc)
+// defaultBoolField applies to bool fields without bool options.
+var defaultBoolField = &flatfile_pb.BoolField{
+	TrueValues:     []string{"T", "t", "Y", "y", "1"},
+	FalseValues:    []string{"F", "f", "N", "n", "0"},
+	TreatMissingAs: flatfile_pb.MissingIs_MISSING_IS_ERROR,
+	Trim:           flatfile_pb.Trim_TRIM_BOTH,
+}
+
+func (r *Reader) readBoolValue(tc *flatfile_pb.Field) (protoreflect.Value, error) {
+	byteVal, err := r.getBytes(tc)
 	if err != nil {
-		return nil, err
+		return protoreflect.Value{}, err
 	}
 
 	boolField := tc.GetBool()
 
 	if boolField == nil {
-		boolField = &flatfile_pb.BoolField{
-			TrueValues:     []string{"T", "t", "Y", "y", "1"},
-			FalseValues:    []string{"F", "f", "N", "n", "0"},
-			TreatMissingAs: flatfile_pb.MissingIs_MISSING_IS_ERROR,
+		boolField = defaultBoolField
+	}
+
+	byteVal = applyTrim(byteVal, boolField.Trim, "")
+
+	matches := func(val string) bool {
+		if boolField.CaseInsensitive {
+			return bytes.EqualFold([]byte(val), byteVal)
 		}
+		return val == string(byteVal)
 	}
 
-	if slices.Contains(boolField.TrueValues, strVal) {
-		return gl.Ptr(protoreflect.ValueOf(true)), nil
+	if slices.ContainsFunc(boolField.NullValues, matches) {
+		return protoreflect.Value{}, nil
+	}
+	if slices.ContainsFunc(boolField.TrueValues, matches) {
+		return protoreflect.ValueOf(true), nil
 	}
-	if slices.Contains(boolField.FalseValues, strVal) {
-		return gl.Ptr(protoreflect.ValueOf(false)), nil
+	if slices.ContainsFunc(boolField.FalseValues, matches) {
+		return protoreflect.ValueOf(false), nil
 	}
 
 	switch boolField.TreatMissingAs {
 	case flatfile_pb.MissingIs_MISSING_IS_UNSPECIFIED, flatfile_pb.MissingIs_MISSING_IS_FALSE:
-		return gl.Ptr(protoreflect.ValueOfBool(false)), nil
+		return protoreflect.ValueOfBool(false), nil
 	case flatfile_pb.MissingIs_MISSING_IS_TRUE:
-		return gl.Ptr(protoreflect.ValueOfBool(true)), nil
+		return protoreflect.ValueOfBool(true), nil
 	case flatfile_pb.MissingIs_MISSING_IS_ERROR:
-		return nil, ErrMissingBool
+		return protoreflect.Value{}, ErrMissingBool
 	default:
-		return nil, fmt.Errorf("unknown missing value def for bool")
+		return protoreflect.Value{}, fmt.Errorf("unknown missing value def for bool")
 	}
 }
 
-func (r *Reader) readDecimal(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
+func (r *Reader) readBoolWrapper(tc *flatfile_pb.Field) (protoreflect.Value, error) {
+	val, err := r.readBoolValue(tc)
+	if err != nil || !val.IsValid() {
+		return protoreflect.Value{}, err
+	}
+	return protoreflect.ValueOfMessage(wrapperspb.Bool(val.Bool()).ProtoReflect()), nil
+}
+
+func (r *Reader) readDecimal(tc *flatfile_pb.Field) (protoreflect.Value, error) {
 	stringVal, err := r.getNumberString(tc)
 	if err != nil {
-		return nil, err
+		return protoreflect.Value{}, err
 	}
 	if stringVal == "" {
-		return nil, nil
+		return protoreflect.Value{}, nil
+	}
+	// Signs are read as for integers, so "+  12.50" is 12.50.
+	sign, digits := splitSign(stringVal)
+	if digits == "" || digits[0] == '.' {
+		// splitSign drops the zeros of "000" and of "0.50".
+		digits = "0" + digits
 	}
-	val, err := decimal.NewFromString(stringVal)
+	val, err := decimal.NewFromString(sign + digits)
 	if err != nil {
-		return nil, fmt.Errorf("invalid decimal value: %q", stringVal)
+		return protoreflect.Value{}, fmt.Errorf("%w: invalid decimal value %q", ErrInvalidNumber, stringVal)
+	}
+	if scale := tc.GetNumber().GetFixedScale(); scale != 0 && !strings.Contains(digits, ".") {
+		// The decimal point is implied, so "12345" with scale 2 is 123.45.
+		val = val.Shift(-scale)
 	}
 	msgVal := decimal_j5t.FromShop(val)
-	return gl.Ptr(protoreflect.ValueOfMessage(msgVal.ProtoReflect())), nil
+	return protoreflect.ValueOfMessage(msgVal.ProtoReflect()), nil
 }
 
-var reNumbers = regexp.MustCompile(`[MDY]`)
+// dateFormatLetters marks the bytes of a date format which stand for digits.
+var dateFormatLetters = [256]bool{'Y': true, 'M': true, 'D': true}
+
+// maskDateFormat replaces the digit placeholders of the format with fill,
+// giving the all zero or all blank forms of an empty date.
+func maskDateFormat(format string, fill byte) string {
+	out := []byte(format)
+	for idx, c := range out {
+		if dateFormatLetters[c] {
+			out[idx] = fill
+		}
+	}
+	return string(out)
+}
 
 func goTimeFormat(a string) (string, error) {
 	a = strings.Replace(a, "YYYY", "2006", 1)
@@ -276,18 +771,21 @@ func goTimeFormat(a string) (string, error) {
 	return a, nil
 }
 
-func (r *Reader) readDate(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
+// dateLayout is the resolved format of a date field, built once per field
+// rather than for every record.
+type dateLayout struct {
+	layout    string
+	emptyVals []string
 
-	dateField := tc.GetDate()
+	// pivot is the century_pivot of formats with two digit years.
+	pivot *uint32
+}
+
+func newDateLayout(dateField *flatfile_pb.DateField) (*dateLayout, error) {
 	if dateField == nil || dateField.Format == "" {
 		return nil, fmt.Errorf("missing date format for date field")
 	}
 
-	stringVal, err := r.getString(tc)
-	if err != nil {
-		return nil, err
-	}
-
 	layout, err := goTimeFormat(dateField.Format)
 	if err != nil {
 		return nil, fmt.Errorf("invalid time layout: %s", dateField.Format)
@@ -295,36 +793,99 @@ func (r *Reader) readDate(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
 
 	emptyVals := []string{
 		strings.Repeat(" ", len(dateField.Format)),
-		reNumbers.ReplaceAllString(dateField.Format, "0"),
-		reNumbers.ReplaceAllString(dateField.Format, " "),
+		maskDateFormat(dateField.Format, '0'),
+		maskDateFormat(dateField.Format, ' '),
 	}
-
 	emptyVals = append(emptyVals, dateField.ZeroVals...)
 
-	if slices.Contains(emptyVals, stringVal) {
-		return nil, nil
+	dl := &dateLayout{
+		layout:    layout,
+		emptyVals: emptyVals,
 	}
+	if !hasFourDigitYear(dateField) {
+		dl.pivot = dateField.CenturyPivot
+	}
+	return dl, nil
+}
 
-	timeVal, err := time.Parse(layout, stringVal)
+// dateReader resolves the date layout of the field, deferring any error in
+// the annotation to when the field is read.
+func dateReader(tc *flatfile_pb.Field) fieldReadFunc {
+	layout, err := newDateLayout(tc.GetDate())
 	if err != nil {
-		return nil, fmt.Errorf("invalid date value: %s", stringVal)
+		return func(*Reader, *flatfile_pb.Field) (protoreflect.Value, error) {
+			return protoreflect.Value{}, err
+		}
+	}
+	return func(r *Reader, tc *flatfile_pb.Field) (protoreflect.Value, error) {
+		return r.readDate(tc, layout)
+	}
+}
+
+func (r *Reader) readDate(tc *flatfile_pb.Field, layout *dateLayout) (protoreflect.Value, error) {
+	byteVal, err := r.getBytes(tc)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	// Comparing against the converted bytes does not allocate.
+	for _, emptyVal := range layout.emptyVals {
+		if string(byteVal) == emptyVal {
+			return protoreflect.Value{}, nil
+		}
+	}
+	stringVal := string(byteVal)
+
+	timeVal, err := time.Parse(layout.layout, stringVal)
+	if err != nil {
+		return protoreflect.Value{}, fmt.Errorf("%w: %s", ErrInvalidDate, stringVal)
 	}
 
 	yy, mm, dd := timeVal.Date()
+	if layout.pivot != nil {
+		yy = 1900 + yy%100
+		if yy%100 < int(*layout.pivot) {
+			yy += 100
+		}
+		// 29 February of a leap year in the 2000s may not be one in the
+		// 1900s.
+		if time.Date(yy, mm, dd, 0, 0, 0, 0, time.UTC).Day() != dd {
+			return protoreflect.Value{}, fmt.Errorf("%w: %s", ErrInvalidDate, stringVal)
+		}
+	}
 	dateVal := &date_j5t.Date{
 		Year:  int32(yy),
 		Month: int32(mm),
 		Day:   int32(dd),
 	}
-	return gl.Ptr(protoreflect.ValueOfMessage(dateVal.ProtoReflect())), nil
+	return protoreflect.ValueOfMessage(dateVal.ProtoReflect()), nil
 }
 
-func (r *Reader) readEnum(tc *flatfile_pb.Field, enum protoreflect.EnumDescriptor) (*protoreflect.Value, error) {
-	stringVal, err := r.getString(tc)
-	if err != nil {
-		return nil, err
+// enumKeys maps the flatfile keys of an enum's values to their numbers.
+type enumKeys struct {
+	enum protoreflect.EnumDescriptor
+
+	// byKey holds the trimmed keys, and byFolded the same keys with ASCII
+	// letters in lower case for case insensitive fields. The first value
+	// declared with a key wins.
+	byKey    map[string]protoreflect.EnumNumber
+	byFolded map[string]protoreflect.EnumNumber
+}
+
+// enumKeyCache holds the keys of each enum descriptor, so that ReadField,
+// which resolves the reader of a field on every call, doesn't rebuild them.
+var enumKeyCache sync.Map // protoreflect.EnumDescriptor -> *enumKeys
+
+func enumKeysFor(enum protoreflect.EnumDescriptor) *enumKeys {
+	if cached, ok := enumKeyCache.Load(enum); ok {
+		return cached.(*enumKeys)
 	}
 
+	keys := &enumKeys{
+		enum:     enum,
+		byKey:    map[string]protoreflect.EnumNumber{},
+		byFolded: map[string]protoreflect.EnumNumber{},
+	}
 	values := enum.Values()
 	for i := range values.Len() {
 		valueDesc := values.Get(i)
@@ -332,51 +893,240 @@ func (r *Reader) readEnum(tc *flatfile_pb.Field, enum protoreflect.EnumDescripto
 		if tc == nil {
 			continue
 		}
+		key := strings.TrimSpace(tc.Key)
+		if _, ok := keys.byKey[key]; !ok {
+			keys.byKey[key] = valueDesc.Number()
+		}
+		if folded := foldASCII(nil, []byte(key)); folded != nil {
+			if _, ok := keys.byFolded[string(folded)]; !ok {
+				keys.byFolded[string(folded)] = valueDesc.Number()
+			}
+		}
+	}
+
+	cached, _ := enumKeyCache.LoadOrStore(enum, keys)
+	return cached.(*enumKeys)
+}
+
+// lookup finds the value for the key, folding case when caseInsensitive is
+// set. Keys with non ASCII letters are compared with bytes.EqualFold.
+func (keys *enumKeys) lookup(key []byte, caseInsensitive bool) (protoreflect.EnumNumber, bool) {
+	if number, ok := keys.byKey[string(key)]; ok {
+		return number, true
+	}
+	if !caseInsensitive {
+		return 0, false
+	}
+
+	var scratch [32]byte
+	folded := foldASCII(scratch[:0], key)
+	if folded != nil {
+		number, ok := keys.byFolded[string(folded)]
+		return number, ok
+	}
 
-		if strings.TrimSpace(tc.Key) == stringVal {
-			return gl.Ptr(protoreflect.ValueOfEnum(valueDesc.Number())), nil
+	values := keys.enum.Values()
+	for i := range values.Len() {
+		valueDesc := values.Get(i)
+		tc := proto.GetExtension(valueDesc.Options(), flatfile_pb.E_Enum).(*flatfile_pb.Enum)
+		if tc != nil && bytes.EqualFold([]byte(strings.TrimSpace(tc.Key)), key) {
+			return valueDesc.Number(), true
 		}
 	}
+	return 0, false
+}
 
-	if strings.TrimSpace(stringVal) == "" {
-		return nil, nil
+// foldASCII appends src to dst with ASCII letters in lower case, returning
+// nil when src has bytes outside ASCII.
+func foldASCII(dst, src []byte) []byte {
+	for _, c := range src {
+		if c >= 0x80 {
+			return nil
+		}
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	if dst == nil {
+		return []byte{}
 	}
+	return dst
+}
 
-	return nil, fmt.Errorf("invalid enum value: %q", stringVal)
+func (r *Reader) readEnum(tc *flatfile_pb.Field, keys *enumKeys) (protoreflect.Value, error) {
+	byteVal, err := r.getBytes(tc)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	enumField := tc.GetEnum()
+	if enumField != nil {
+		byteVal = applyTrim(byteVal, enumField.Trim, "")
+	}
+
+	if number, ok := keys.lookup(byteVal, enumField.GetCaseInsensitive()); ok {
+		return protoreflect.ValueOfEnum(number), nil
+	}
+	enum := keys.enum
+	values := enum.Values()
+
+	if len(bytes.TrimSpace(byteVal)) == 0 {
+		return protoreflect.Value{}, nil
+	}
+
+	switch enumField.GetTreatUnknownAs() {
+	case flatfile_pb.UnknownIs_UNKNOWN_IS_UNSPECIFIED, flatfile_pb.UnknownIs_UNKNOWN_IS_ERROR:
+		return protoreflect.Value{}, fmt.Errorf("%w: %q", ErrInvalidEnum, byteVal)
+	case flatfile_pb.UnknownIs_UNKNOWN_IS_ZERO:
+		return protoreflect.Value{}, nil
+	case flatfile_pb.UnknownIs_UNKNOWN_IS_FALLBACK:
+		fallback := values.ByName(protoreflect.Name(enumField.FallbackValue))
+		if fallback == nil {
+			return protoreflect.Value{}, fmt.Errorf("fallback value %q not found in %s", enumField.FallbackValue, enum.FullName())
+		}
+		return protoreflect.ValueOfEnum(fallback.Number()), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unknown treat_unknown_as def for enum")
+	}
+}
+
+// splitSign separates an optional leading '+' or '-' from a display numeric,
+// returning the sign ("" for '+') and the digits with leading spaces and
+// zeros removed, so "+00123", "+  123" and "123" all read the same.
+func splitSign(numString string) (string, string) {
+	numString = strings.TrimLeft(numString, " ")
+	sign := ""
+	if numString != "" && (numString[0] == '+' || numString[0] == '-') {
+		if numString[0] == '-' {
+			sign = "-"
+		}
+		numString = numString[1:]
+	}
+	return sign, strings.TrimLeft(numString, " 0")
+}
+
+// isPlainDisplay is true for display numerics without salvage or digit
+// limits, which can be read by parseDisplay without building a string.
+func isPlainDisplay(tc *flatfile_pb.Field) bool {
+	number := tc.GetNumber()
+	if number == nil {
+		return true
+	}
+	return number.Encoding == flatfile_pb.Encoding_ENCODING_UNSPECIFIED &&
+		number.Policy != flatfile_pb.NumberPolicy_NUMBER_POLICY_SALVAGE &&
+		number.MaxDigits == 0 &&
+		number.MaxFractionDigits == nil
+}
+
+// parseDisplay reads a display numeric directly from the field's bytes,
+// accepting the same forms as splitSign: leading spaces, an optional sign,
+// then leading spaces and zeros, digits and trailing spaces. ok is false for
+// anything else, including values which overflow uint64, so that the caller
+// can fall back to strconv for the error.
+func parseDisplay(raw []byte) (val uint64, negative bool, isSet bool, ok bool) {
+	idx := 0
+	for idx < len(raw) && raw[idx] == ' ' {
+		idx++
+	}
+	if idx < len(raw) && (raw[idx] == '+' || raw[idx] == '-') {
+		negative = raw[idx] == '-'
+		idx++
+	}
+	for idx < len(raw) && (raw[idx] == ' ' || raw[idx] == '0') {
+		idx++
+	}
+	for ; idx < len(raw); idx++ {
+		c := raw[idx]
+		if c < '0' || c > '9' {
+			break
+		}
+		if val > (math.MaxUint64-uint64(c-'0'))/10 {
+			return 0, false, false, false
+		}
+		val = val*10 + uint64(c-'0')
+		isSet = true
+	}
+	for ; idx < len(raw); idx++ {
+		if raw[idx] != ' ' {
+			return 0, false, false, false
+		}
+	}
+	// Zeros alone read as unset, as they do through splitSign.
+	return val, negative, isSet, true
 }
 
 func (r *Reader) unsignedStringNumber(tc *flatfile_pb.Field, size int) (uint64, bool, error) {
+	if isPlainDisplay(tc) {
+		raw, err := r.getBytes(tc)
+		if err != nil {
+			return 0, false, err
+		}
+		val, negative, isSet, ok := parseDisplay(raw)
+		if ok && (!isSet || !negative && val <= math.MaxUint64>>(64-size)) {
+			return val, isSet, nil
+		}
+	}
+
 	numString, err := r.getNumberString(tc)
 	if err != nil {
 		return 0, false, err
 	}
-	numString = strings.TrimLeft(numString, " 0")
-	if numString == "" {
+	sign, digits := splitSign(numString)
+	if digits == "" {
 		return 0, false, nil
 	}
+	numString = sign + digits
 	val, err := strconv.ParseUint(numString, 10, size)
 	if err != nil {
-		return 0, false, fmt.Errorf("parsing %q as uint: %w", numString, err)
+		return 0, false, numberError(numString, "uint", err)
 	}
 	return val, true, nil
 }
 
 func (r *Reader) signedStringNumber(tc *flatfile_pb.Field, size int) (int64, bool, error) {
+	if isPlainDisplay(tc) {
+		raw, err := r.getBytes(tc)
+		if err != nil {
+			return 0, false, err
+		}
+		val, negative, isSet, ok := parseDisplay(raw)
+		limit := uint64(1) << (size - 1)
+		switch {
+		case !ok:
+		case !isSet:
+			return 0, false, nil
+		case negative && val <= limit:
+			return -int64(val-1) - 1, true, nil
+		case !negative && val < limit:
+			return int64(val), true, nil
+		}
+	}
+
 	numString, err := r.getNumberString(tc)
 	if err != nil {
 		return 0, false, err
 	}
-	numString = strings.TrimLeft(numString, " 0")
-	if numString == "" {
+	sign, digits := splitSign(numString)
+	if digits == "" {
 		return 0, false, nil
 	}
+	numString = sign + digits
 	val, err := strconv.ParseInt(numString, 10, size)
 	if err != nil {
-		return 0, false, fmt.Errorf("parsing %q as int: %w", numString, err)
+		return 0, false, numberError(numString, "int", err)
 	}
 	return val, true, nil
 }
 
+// numberError classifies a strconv failure as ErrOverflow or ErrInvalidNumber.
+func numberError(numString string, kind string, err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return fmt.Errorf("%w: parsing %q as %s: %w", ErrOverflow, numString, kind, err)
+	}
+	return fmt.Errorf("%w: parsing %q as %s: %w", ErrInvalidNumber, numString, kind, err)
+}
+
 func (r *Reader) leftPaddedBytes(tc *flatfile_pb.Field, typeLength int) ([]byte, error) {
 	readLength := int(tc.FixedWidth.Length)
 	if typeLength < readLength {
@@ -393,23 +1143,26 @@ func (r *Reader) leftPaddedBytes(tc *flatfile_pb.Field, typeLength int) ([]byte,
 	}
 
 	newVal := make([]byte, typeLength)
-	copy(newVal[readLength-len(byteVal):], byteVal)
+	copy(newVal[typeLength-len(byteVal):], byteVal)
 	return newVal, nil
 }
 
 var overpunchVals = `{ABCDEFGHI}JKLMNOPQR`
 
+// DecodeOverpunch decodes a signed overpunch number, where the last byte holds
+// both the last digit and the sign. The input is not modified.
 func DecodeOverpunch(in []byte) (string, error) {
 	last := in[len(in)-1]
 	overpunchIndex := strings.IndexByte(overpunchVals, last)
 	if overpunchIndex < 0 {
 		return "", fmt.Errorf("invalid overpunch byte: %x", last)
 	}
-	out := []byte(in)
-	out[len(in)-1] = byte(overpunchIndex%10 + 0x30)
+	out := make([]byte, 0, len(in)+1)
 	if overpunchIndex > 9 {
-		return "-" + string(out), nil
+		out = append(out, '-')
 	}
+	out = append(out, in[:len(in)-1]...)
+	out = append(out, byte(overpunchIndex%10+0x30))
 	return string(out), nil
 }
 
@@ -471,88 +1224,88 @@ func numberFormat(tc *flatfile_pb.Field) flatfile_pb.Encoding {
 	return flatfile_pb.Encoding_ENCODING_UNSPECIFIED
 }
 
-func (r *Reader) readUint32(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
+func (r *Reader) readUint32(tc *flatfile_pb.Field) (protoreflect.Value, error) {
 	format := numberFormat(tc)
 	if format == flatfile_pb.Encoding_ENCODING_BINARY {
 		byteVal, err := r.leftPaddedBytes(tc, 32/8)
 		if err != nil {
-			return nil, err
+			return protoreflect.Value{}, err
 		}
-		val := byteVal[0]
-		return gl.Ptr(protoreflect.ValueOfUint32(uint32(val))), nil
+		val := binary.BigEndian.Uint32(byteVal)
+		return protoreflect.ValueOfUint32(val), nil
 	}
 
 	val, isSet, err := r.unsignedStringNumber(tc, 32)
 	if err != nil {
-		return nil, err
+		return protoreflect.Value{}, err
 	}
 	if !isSet {
-		return nil, nil
+		return protoreflect.Value{}, nil
 	}
-	return gl.Ptr(protoreflect.ValueOfUint32(uint32(val))), nil
+	return protoreflect.ValueOfUint32(uint32(val)), nil
 }
 
-func (r *Reader) readUint64(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
+func (r *Reader) readUint64(tc *flatfile_pb.Field) (protoreflect.Value, error) {
 	format := numberFormat(tc)
 	if format == flatfile_pb.Encoding_ENCODING_BINARY {
 		byteVal, err := r.leftPaddedBytes(tc, 64/8)
 		if err != nil {
-			return nil, err
+			return protoreflect.Value{}, err
 		}
-		val := byteVal[0]
-		return gl.Ptr(protoreflect.ValueOfUint64(uint64(val))), nil
+		val := binary.BigEndian.Uint64(byteVal)
+		return protoreflect.ValueOfUint64(val), nil
 	}
 
 	val, isSet, err := r.unsignedStringNumber(tc, 64)
 	if err != nil {
-		return nil, err
+		return protoreflect.Value{}, err
 	}
 	if !isSet {
-		return nil, nil
+		return protoreflect.Value{}, nil
 	}
-	return gl.Ptr(protoreflect.ValueOfUint64(val)), nil
+	return protoreflect.ValueOfUint64(val), nil
 }
 
-func (r *Reader) readInt32(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
+func (r *Reader) readInt32(tc *flatfile_pb.Field) (protoreflect.Value, error) {
 	format := numberFormat(tc)
 	if format == flatfile_pb.Encoding_ENCODING_BINARY {
 		byteVal, err := r.leftPaddedBytes(tc, 32/8)
 		if err != nil {
-			return nil, err
+			return protoreflect.Value{}, err
 		}
-		val := byteVal[0]
+		val := binary.BigEndian.Uint32(byteVal)
 		signedVal := int32(val)
-		return gl.Ptr(protoreflect.ValueOfInt32(signedVal)), nil
+		return protoreflect.ValueOfInt32(signedVal), nil
 	}
 
 	val, isSet, err := r.signedStringNumber(tc, 32)
 	if err != nil {
-		return nil, err
+		return protoreflect.Value{}, err
 	}
 	if !isSet {
-		return nil, nil
+		return protoreflect.Value{}, nil
 	}
-	return gl.Ptr(protoreflect.ValueOfInt32(int32(val))), nil
+	return protoreflect.ValueOfInt32(int32(val)), nil
 }
 
-func (r *Reader) readInt64(tc *flatfile_pb.Field) (*protoreflect.Value, error) {
+func (r *Reader) readInt64(tc *flatfile_pb.Field) (protoreflect.Value, error) {
 	format := numberFormat(tc)
 	if format == flatfile_pb.Encoding_ENCODING_BINARY {
 		byteVal, err := r.leftPaddedBytes(tc, 64/8)
 		if err != nil {
-			return nil, err
+			return protoreflect.Value{}, err
 		}
-		val := byteVal[0]
+		val := binary.BigEndian.Uint64(byteVal)
 		signedVal := int64(val)
-		return gl.Ptr(protoreflect.ValueOfInt64(signedVal)), nil
+		return protoreflect.ValueOfInt64(signedVal), nil
 	}
 
 	val, isSet, err := r.signedStringNumber(tc, 64)
 	if err != nil {
-		return nil, err
+		return protoreflect.Value{}, err
 	}
 	if !isSet {
-		return nil, nil
+		return protoreflect.Value{}, nil
 	}
-	return gl.Ptr(protoreflect.ValueOfInt64(val)), nil
+	return protoreflect.ValueOfInt64(val), nil
 }
@@ -0,0 +1,61 @@
+package binfile
+
+import (
+	"fmt"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+)
+
+// ValidCheckDigit reports whether the final character of value is the correct
+// check digit for the preceding characters under the given algorithm.
+func ValidCheckDigit(alg flatfile_pb.CheckDigit, value string) (bool, error) {
+	if len(value) < 2 {
+		return false, fmt.Errorf("%q is too short to carry a check digit", value)
+	}
+	body := value[:len(value)-1]
+	check := value[len(value)-1]
+
+	for _, c := range []byte(body) {
+		if c < '0' || c > '9' {
+			return false, fmt.Errorf("non-digit %q in %q", c, value)
+		}
+	}
+
+	switch alg {
+	case flatfile_pb.CheckDigit_CHECK_DIGIT_LUHN:
+		sum := 0
+		for idx := range len(body) {
+			d := int(body[len(body)-1-idx] - '0')
+			if idx%2 == 0 {
+				d *= 2
+				if d > 9 {
+					d -= 9
+				}
+			}
+			sum += d
+		}
+		return check == byte('0'+(10-sum%10)%10), nil
+
+	case flatfile_pb.CheckDigit_CHECK_DIGIT_ABA:
+		weights := []int{3, 7, 1}
+		sum := 0
+		for idx, c := range []byte(body) {
+			sum += int(c-'0') * weights[idx%3]
+		}
+		return check == byte('0'+(10-sum%10)%10), nil
+
+	case flatfile_pb.CheckDigit_CHECK_DIGIT_MOD11:
+		sum := 0
+		for idx := range len(body) {
+			sum += int(body[len(body)-1-idx]-'0') * (idx + 2)
+		}
+		want := (11 - sum%11) % 11
+		if want == 10 {
+			return check == 'X' || check == 'x', nil
+		}
+		return check == byte('0'+want), nil
+
+	default:
+		return false, fmt.Errorf("unknown check digit algorithm %s", alg)
+	}
+}
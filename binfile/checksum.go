@@ -0,0 +1,100 @@
+package binfile
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+)
+
+// ComputeChecksum calculates the checksum of data under the algorithm and
+// modulus of cs.
+func ComputeChecksum(cs *flatfile_pb.Checksum, data []byte) (uint64, error) {
+	switch cs.Algorithm {
+	case flatfile_pb.ChecksumAlgorithm_CHECKSUM_ALGORITHM_CRC16:
+		crc := uint16(0xFFFF)
+		for _, b := range data {
+			crc ^= uint16(b) << 8
+			for range 8 {
+				if crc&0x8000 != 0 {
+					crc = crc<<1 ^ 0x1021
+				} else {
+					crc <<= 1
+				}
+			}
+		}
+		return uint64(crc), nil
+
+	case flatfile_pb.ChecksumAlgorithm_CHECKSUM_ALGORITHM_CRC32:
+		return uint64(crc32.ChecksumIEEE(data)), nil
+
+	case flatfile_pb.ChecksumAlgorithm_CHECKSUM_ALGORITHM_SUM:
+		modulus := uint64(cs.Modulus)
+		if modulus == 0 {
+			modulus = 256
+		}
+		sum := uint64(0)
+		for _, b := range data {
+			sum = (sum + uint64(b)) % modulus
+		}
+		return sum, nil
+
+	default:
+		return 0, fmt.Errorf("unknown checksum algorithm %s", cs.Algorithm)
+	}
+}
+
+func (r *Reader) checkChecksum(tc *flatfile_pb.Field) error {
+	cs := tc.Checksum
+	if cs.Range == nil {
+		return fmt.Errorf("checksum has no range")
+	}
+	offset := int(cs.Range.Offset)
+	if r.OneBased {
+		offset = offset - 1
+	}
+	end := offset + int(cs.Range.Length)
+	if offset < 0 || end > len(r.Record) {
+		return fmt.Errorf("%w: range %d-%d is outside the record", ErrChecksum, offset, end)
+	}
+
+	raw, err := r.getBytes(tc)
+	if err != nil {
+		return err
+	}
+
+	var stored uint64
+	if tc.GetNumber().GetEncoding() == flatfile_pb.Encoding_ENCODING_BINARY {
+		if len(raw) > 8 {
+			return fmt.Errorf("binary checksum is wider than 8 bytes")
+		}
+		padded := make([]byte, 8)
+		copy(padded[8-len(raw):], raw)
+		stored = binary.BigEndian.Uint64(padded)
+	} else {
+		strVal := strings.TrimSpace(string(raw))
+		if strVal == "" {
+			return nil
+		}
+		base := 10
+		if cs.Hex {
+			base = 16
+		}
+		stored, err = strconv.ParseUint(strVal, base, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %q is not a number", ErrChecksum, strVal)
+		}
+	}
+
+	want, err := ComputeChecksum(cs, r.Record[offset:end])
+	if err != nil {
+		return err
+	}
+	if stored != want {
+		return fmt.Errorf("%w: got %d, computed %d", ErrChecksum, stored, want)
+	}
+	return nil
+}
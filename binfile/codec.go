@@ -0,0 +1,162 @@
+package binfile
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldCodec reads and writes fields in a format of the application's own,
+// such as an identifier with a check digit, or a message type the parser does
+// not know. Codecs are chosen by name with the custom option of the field, see
+// RegisterFieldCodec, or by message type, see RegisterMessageCodec.
+type FieldCodec interface {
+	// Decode returns the value of the field from the bytes of the record it
+	// spans, which must be of the kind of the field, or a message of its type,
+	// or an invalid Value to leave the field unset. raw is part of the
+	// record, which readers reuse, so must not be modified, or kept after
+	// Decode returns, including as the value of a bytes field.
+	Decode(raw []byte, fieldDesc protoreflect.FieldDescriptor) (protoreflect.Value, error)
+
+	// Encode writes the value into dst, which is the width of the field and
+	// filled with spaces.
+	Encode(dst []byte, fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) error
+}
+
+// MessageCodec reads and writes the fields of a message type which the parser
+// does not know, such as an application's own money type.
+type MessageCodec = FieldCodec
+
+// builtinMessages are the message types the parser reads and writes itself.
+var builtinMessages = map[protoreflect.FullName]bool{
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BoolValue":   true,
+	"j5.types.decimal.v1.Decimal": true,
+	"j5.types.date.v1.Date":       true,
+}
+
+var messageCodecs sync.Map // protoreflect.FullName -> MessageCodec
+
+// RegisterMessageCodec reads and writes fields of the named message type with
+// codec, in every parser compiled after it is registered. Parsers are cached
+// by descriptor, so codecs should be registered at init, before any record of
+// a message holding the type is parsed. Registering a name twice, or the name
+// of a type the parser already supports, panics.
+func RegisterMessageCodec(name protoreflect.FullName, codec MessageCodec) {
+	if builtinMessages[name] {
+		panic(fmt.Sprintf("binfile: %s is a built in message type", name))
+	}
+	if _, loaded := messageCodecs.LoadOrStore(name, codec); loaded {
+		panic(fmt.Sprintf("binfile: message codec for %s registered twice", name))
+	}
+}
+
+var fieldCodecs sync.Map // string -> FieldCodec
+
+// RegisterFieldCodec names codec for the custom option of fields, e.g.
+// custom: { codec: "ssn" }, which then read and write with it whatever their
+// type. As with RegisterMessageCodec, codecs should be registered at init,
+// and registering a name twice panics.
+func RegisterFieldCodec(name string, codec FieldCodec) {
+	if name == "" {
+		panic("binfile: field codec registered without a name")
+	}
+	if _, loaded := fieldCodecs.LoadOrStore(name, codec); loaded {
+		panic(fmt.Sprintf("binfile: field codec %q registered twice", name))
+	}
+}
+
+// customCodec returns the codec named by the custom option of the field, or
+// nil when it has none.
+func customCodec(tc *flatfile_pb.Field) (FieldCodec, error) {
+	custom := tc.GetCustom()
+	if custom == nil {
+		return nil, nil
+	}
+	codec, ok := fieldCodecs.Load(custom.Codec)
+	if !ok {
+		return nil, fmt.Errorf("custom codec %q is not registered", custom.Codec)
+	}
+	return codec.(FieldCodec), nil
+}
+
+func lookupMessageCodec(name protoreflect.FullName) (MessageCodec, bool) {
+	codec, ok := messageCodecs.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return codec.(MessageCodec), true
+}
+
+// codecReader reads the field with the codec. Blank fields are left unset
+// without calling it, as they are for the built in types.
+func codecReader(fieldDesc protoreflect.FieldDescriptor, codec FieldCodec) fieldReadFunc {
+	return func(r *Reader, tc *flatfile_pb.Field) (protoreflect.Value, error) {
+		raw, err := r.getBytes(tc)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		if len(bytes.Trim(raw, " \x00")) == 0 {
+			return protoreflect.Value{}, nil
+		}
+		val, err := codec.Decode(raw, fieldDesc)
+		if err != nil || !val.IsValid() {
+			return protoreflect.Value{}, err
+		}
+		if !valueFits(fieldDesc, val) {
+			return protoreflect.Value{}, fmt.Errorf("codec returned %T for a %s field", val.Interface(), fieldTypeName(fieldDesc))
+		}
+		return val, nil
+	}
+}
+
+// valueFits reports whether the value can be set on the field, rather than
+// letting Set panic on a codec which returns the wrong type.
+func valueFits(fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+	switch v := val.Interface().(type) {
+	case protoreflect.Message:
+		return fieldDesc.Message() != nil && v.Descriptor().FullName() == fieldDesc.Message().FullName()
+	case protoreflect.EnumNumber:
+		return fieldDesc.Kind() == protoreflect.EnumKind
+	case string:
+		return fieldDesc.Kind() == protoreflect.StringKind
+	case []byte:
+		return fieldDesc.Kind() == protoreflect.BytesKind
+	case bool:
+		return fieldDesc.Kind() == protoreflect.BoolKind
+	case int32:
+		switch fieldDesc.Kind() {
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+			return true
+		}
+	case int64:
+		switch fieldDesc.Kind() {
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			return true
+		}
+	case uint32:
+		switch fieldDesc.Kind() {
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+			return true
+		}
+	case uint64:
+		switch fieldDesc.Kind() {
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			return true
+		}
+	case float32:
+		return fieldDesc.Kind() == protoreflect.FloatKind
+	case float64:
+		return fieldDesc.Kind() == protoreflect.DoubleKind
+	}
+	return false
+}
+
+func codecWriter(fieldDesc protoreflect.FieldDescriptor, codec FieldCodec) fieldWriteFunc {
+	return func(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+		return codec.Encode(dst, fieldDesc, val)
+	}
+}
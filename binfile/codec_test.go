@@ -0,0 +1,166 @@
+package binfile
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func init() {
+	RegisterMessageCodec("codec.v1.Money", moneyCodec{})
+	RegisterFieldCodec("ssn", ssnCodec{})
+}
+
+// ssnCodec reads nine digits as a dashed social security number.
+type ssnCodec struct{}
+
+func (ssnCodec) Decode(raw []byte, fieldDesc protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	if len(raw) != 9 || strings.Trim(string(raw), "0123456789") != "" {
+		return protoreflect.Value{}, fmt.Errorf("%w: %q is not nine digits", ErrInvalidString, raw)
+	}
+	if fieldDesc.Kind() == protoreflect.Int64Kind {
+		// The wrong type for the field, which is an error rather than a panic.
+		return protoreflect.ValueOfString(string(raw)), nil
+	}
+	return protoreflect.ValueOfString(fmt.Sprintf("%s-%s-%s", raw[:3], raw[3:5], raw[5:])), nil
+}
+
+func (ssnCodec) Encode(dst []byte, fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) error {
+	copy(dst, strings.ReplaceAll(val.String(), "-", ""))
+	return nil
+}
+
+// moneyCodec reads a currency code followed by six digits of minor units.
+type moneyCodec struct{}
+
+func (moneyCodec) Decode(raw []byte, fieldDesc protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	if len(raw) != 9 {
+		return protoreflect.Value{}, fmt.Errorf("money is 9 bytes, got %d", len(raw))
+	}
+	units, err := strconv.ParseInt(string(raw[3:]), 10, 64)
+	if err != nil {
+		return protoreflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidNumber, err)
+	}
+	msg := dynamicpb.NewMessage(fieldDesc.Message())
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("currency"), protoreflect.ValueOfString(string(raw[:3])))
+	msg.Set(fields.ByName("units"), protoreflect.ValueOfInt64(units))
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+func (moneyCodec) Encode(dst []byte, fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) error {
+	msg := val.Message()
+	fields := msg.Descriptor().Fields()
+	copy(dst, fmt.Sprintf("%3s%06d", msg.Get(fields.ByName("currency")).String(), msg.Get(fields.ByName("units")).Int()))
+	return nil
+}
+
+func TestMessageCodec(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"codec.proto": `
+		syntax = "proto3";
+		package codec.v1;
+
+		import "flatfile/v1/annotations.proto";
+
+		message Money {
+		  string currency = 1;
+		  int64 units = 2;
+		}
+
+		message Payment {
+		  option (flatfile.v1.message) = { record_length: 12 };
+		  Money amount = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 9 } }];
+		  string ref = 2 [(flatfile.v1.field) = { fixed_width: { offset: 9, length: 3 } }];
+		}`,
+	})
+	msgDesc := fileDesc.MessageByName(t, "codec.v1.Payment")
+
+	if err := ValidateMessageDescriptor(msgDesc); err != nil {
+		t.Fatal(err)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, []byte("USD001250ABC")); err != nil {
+		t.Fatal(err)
+	}
+	amount := msg.Get(msgDesc.Fields().ByName("amount")).Message()
+	moneyFields := amount.Descriptor().Fields()
+	currency := amount.Get(moneyFields.ByName("currency")).String()
+	units := amount.Get(moneyFields.ByName("units")).Int()
+	if currency != "USD" || units != 1250 {
+		t.Errorf("got %s %d", currency, units)
+	}
+
+	record, err := MarshalRecord(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(record) != "USD001250ABC" {
+		t.Errorf("got %q", record)
+	}
+
+	// Blank fields are unset without the codec.
+	msg = dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, []byte("         ABC")); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Has(msgDesc.Fields().ByName("amount")) {
+		t.Error("expected amount to be unset")
+	}
+
+	if err := ParseMessage(dynamicpb.NewMessage(msgDesc), []byte("USD0012X0ABC")); err == nil {
+		t.Error("expected an error from the codec")
+	}
+}
+
+func TestFieldCodec(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string ssn = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 9 }, custom: { codec: "ssn" } }];
+	  int64 number = 2 [(flatfile.v1.field) = { fixed_width: { offset: 9, length: 9 }, custom: { codec: "ssn" } }];
+	`)
+
+	if err := ValidateMessageDescriptor(msgDesc); err != nil {
+		t.Fatal(err)
+	}
+	if layout := DescribeLayout(msgDesc); layout.Fields[0].Codec != "ssn" || layout.Fields[0].Format != "ssn codec" {
+		t.Errorf("got layout %+v", layout.Fields[0])
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, []byte("123456789         ")); err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.Get(msgDesc.Fields().ByName("ssn")).String(); got != "123-45-6789" {
+		t.Errorf("got %q", got)
+	}
+	record, err := MarshalRecord(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(record) != "123456789         " {
+		t.Errorf("got %q", record)
+	}
+
+	if err := ParseMessage(msg, []byte("12345678X         ")); !errors.Is(err, ErrInvalidString) {
+		t.Errorf("expected ErrInvalidString, got %v", err)
+	}
+	if err := ParseMessage(msg, []byte("123456789123456789")); err == nil {
+		t.Error("expected an error for a string from the codec of an int64 field")
+	}
+
+	unknown := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 }, custom: { codec: "unregistered" } }];
+	`)
+	if err := ValidateMessageDescriptor(unknown); err == nil || !strings.Contains(err.Error(), `custom codec "unregistered" is not registered`) {
+		t.Errorf("got %v", err)
+	}
+	if _, err := Compile(unknown); err == nil {
+		t.Error("expected Compile to fail")
+	}
+}
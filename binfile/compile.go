@@ -0,0 +1,352 @@
+package binfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/flatfile/recordio"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessageParser parses records into one message type. Compile resolves the
+// annotations of the message once, so that parsing many records does not
+// repeat the work for every field of every record.
+//
+// A MessageParser is not modified after Compile returns, and the state of
+// each parse is kept in a Reader local to the call, so one parser can be
+// shared by any number of goroutines. Methods which derive a parser, such as
+// Select, return a new one rather than changing the receiver.
+type MessageParser struct {
+	desc   protoreflect.MessageDescriptor
+	ext    *flatfile_pb.Message
+	fields []*compiledField
+	rules  []compiledRule
+
+	// index maps the name of each field in fields to its position.
+	index map[protoreflect.Name]int
+
+	// width is the length of the records AppendRecord writes.
+	width int
+
+	// spans are the fields and filler of the layout in offset order, and end
+	// the offset after the last of them, for the trailing and coverage
+	// checks.
+	spans []fieldSpan
+	end   int
+
+	// trailing is the trailing_field of messages which capture the data
+	// after the last field.
+	trailing protoreflect.FieldDescriptor
+
+	// charset decodes records unless the call gives its own, see Config.
+	charset *recordio.Charset
+}
+
+type compiledField struct {
+	desc    protoreflect.FieldDescriptor
+	tc      *flatfile_pb.Field
+	rawDesc protoreflect.FieldDescriptor
+	read    fieldReadFunc
+	write   fieldWriteFunc
+
+	// pattern is the compiled pattern of string fields which have one.
+	pattern *regexp.Regexp
+}
+
+// Compile resolves the flatfile annotations of the message into a parser,
+// with the defaults set by SetDefaults. Errors in the annotations which would
+// fail every record, such as an unsupported field type, a field past the
+// record_length, or options this build doesn't understand, are returned here.
+func Compile(desc protoreflect.MessageDescriptor) (*MessageParser, error) {
+	return defaults().Compile(desc)
+}
+
+// Compile resolves the flatfile annotations of the message into a parser, as
+// the package level Compile does, with the defaults of the config in place of
+// those set by SetDefaults.
+func (c *Config) Compile(desc protoreflect.MessageDescriptor) (*MessageParser, error) {
+	if err := checkSupported(desc); err != nil {
+		return nil, err
+	}
+
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+
+	parser := &MessageParser{
+		desc:    desc,
+		ext:     ext,
+		index:   map[protoreflect.Name]int{},
+		charset: c.GetCharset(),
+	}
+
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		field, err := compileField(fieldDesc, c.fieldOptions(fieldDesc))
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", fieldDesc.FullName(), err)
+		}
+		if field != nil {
+			parser.index[fieldDesc.Name()] = len(parser.fields)
+			parser.fields = append(parser.fields, field)
+		}
+	}
+
+	if len(ext.GetRules()) > 0 {
+		rules, err := compileRules(desc, ext.Rules)
+		if err != nil {
+			return nil, fmt.Errorf("message %s: %w", desc.FullName(), err)
+		}
+		parser.rules = rules
+	}
+	parser.width = recordWidth(ext, parser.fields)
+	parser.spans = layoutSpans(desc, ext)
+	parser.end = layoutEnd(parser.spans)
+	if ext.GetTreatTrailingAs() == flatfile_pb.TrailingIs_TRAILING_IS_CAPTURED {
+		if err := validateStringSibling(desc, ext.TrailingField); err != nil {
+			return nil, fmt.Errorf("message %s: trailing_field: %w", desc.FullName(), err)
+		}
+		parser.trailing = desc.Fields().ByName(protoreflect.Name(ext.TrailingField))
+	}
+	if err := checkBeforeStart(parser.spans); err != nil {
+		return nil, fmt.Errorf("message %s: %w", desc.FullName(), err)
+	}
+	if err := checkPastEnd(ext, parser.spans); err != nil {
+		return nil, fmt.Errorf("message %s: %w", desc.FullName(), err)
+	}
+
+	return parser, nil
+}
+
+// parserCache holds the parser of each message descriptor used with
+// ParseMessage and the other package level functions, so that callers which
+// don't hold a MessageParser still only compile each message once.
+// Descriptors are compared by identity, so messages built from the same
+// source into separate descriptors are compiled separately. SetDefaults
+// clears it.
+var parserCache sync.Map // protoreflect.MessageDescriptor -> *MessageParser
+
+func cachedParser(desc protoreflect.MessageDescriptor) (*MessageParser, error) {
+	if cached, ok := parserCache.Load(desc); ok {
+		return cached.(*MessageParser), nil
+	}
+	parser, err := Compile(desc)
+	if err != nil {
+		return nil, err
+	}
+	cached, _ := parserCache.LoadOrStore(desc, parser)
+	return cached.(*MessageParser), nil
+}
+
+func compileField(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) (*compiledField, error) {
+	if tc == nil || tc.FixedWidth == nil {
+		return nil, nil
+	}
+
+	if err := validateShortPolicy(tc); err != nil {
+		return nil, err
+	}
+	read, err := fieldReader(fieldDesc, tc)
+	if err != nil {
+		return nil, err
+	}
+	write, err := fieldWriter(fieldDesc, tc)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, err := compilePattern(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	field := &compiledField{
+		desc:    fieldDesc,
+		tc:      tc,
+		read:    read,
+		write:   write,
+		pattern: pattern,
+	}
+
+	if tc.RawField != "" {
+		rawDesc := fieldDesc.ContainingMessage().Fields().ByName(protoreflect.Name(tc.RawField))
+		if rawDesc == nil {
+			return nil, fmt.Errorf("raw field %q not found", tc.RawField)
+		}
+		if rawDesc.Kind() != protoreflect.StringKind || rawDesc.Cardinality() == protoreflect.Repeated {
+			return nil, fmt.Errorf("raw field %q must be a string", tc.RawField)
+		}
+		field.rawDesc = rawDesc
+	}
+
+	return field, nil
+}
+
+// compilePattern compiles the pattern of the string options of the field, or
+// returns nil when there is none.
+func compilePattern(tc *flatfile_pb.Field) (*regexp.Regexp, error) {
+	pattern := tc.GetString_().GetPattern()
+	if pattern == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
+
+// Descriptor returns the message type the parser was compiled for.
+func (p *MessageParser) Descriptor() protoreflect.MessageDescriptor {
+	return p.desc
+}
+
+// Parse parses the record into msg, as ParseMessage does.
+func (p *MessageParser) Parse(msg proto.Message, data []byte) error {
+	_, err := p.parse(msg.ProtoReflect(), data, parseOptions{})
+	return err
+}
+
+// ParseReflect parses the record into m, as Parse does.
+func (p *MessageParser) ParseReflect(m protoreflect.Message, data []byte) error {
+	_, err := p.parse(m, data, parseOptions{})
+	return err
+}
+
+// ParseWithWarnings parses the record into msg, as ParseMessageWithWarnings
+// does.
+func (p *MessageParser) ParseWithWarnings(msg proto.Message, data []byte) ([]*FieldError, error) {
+	return p.parse(msg.ProtoReflect(), data, parseOptions{})
+}
+
+// ParsePartial parses the record into msg, as ParseMessagePartial does.
+func (p *MessageParser) ParsePartial(msg proto.Message, data []byte) ([]*FieldError, error) {
+	return p.parse(msg.ProtoReflect(), data, parseOptions{partial: true})
+}
+
+func (p *MessageParser) parse(refl protoreflect.Message, data []byte, options parseOptions) ([]*FieldError, error) {
+	parser, data, err := p.withOptions(data, options)
+	if err != nil {
+		return nil, err
+	}
+	return parser.parseWith(NewReader(data, parser.ext.GetOneBased()), refl, options)
+}
+
+// parseWith parses the record held by rr, so that callers parsing many
+// records can reuse one Reader.
+func (p *MessageParser) parseWith(rr *Reader, refl protoreflect.Message, options parseOptions) ([]*FieldError, error) {
+	partial := options.partial
+	data := rr.Record
+	if refl.Descriptor().FullName() != p.desc.FullName() {
+		return nil, fmt.Errorf("parser for %s cannot parse %s", p.desc.FullName(), refl.Descriptor().FullName())
+	}
+
+	var errs []error
+
+	if p.ext != nil {
+		if err := checkRecordLength(p.ext, len(data)); err != nil {
+			if !partial {
+				return nil, err
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	var warnings []*FieldError
+
+	for _, field := range p.fields {
+		// The raw text is set first so that it is kept even when the value
+		// itself fails to parse. Failing to read the raw text fails the field
+		// as failing to read its value would.
+		var val protoreflect.Value
+		err := rr.setRawField(refl, field)
+		if err == nil {
+			val, err = rr.readField(field)
+		}
+		if err == nil && len(options.hooks) > 0 {
+			val, err = rr.runHooks(options.hooks, field, val)
+		}
+		if err != nil {
+			severity := field.tc.OnError
+			if options.severity != flatfile_pb.Severity_SEVERITY_UNSPECIFIED {
+				severity = options.severity
+			}
+			if severity == flatfile_pb.Severity_SEVERITY_WARNING {
+				warnings = append(warnings, rr.fieldError(field.desc, field.tc, err))
+				continue
+			}
+			if !partial {
+				return warnings, rr.fieldError(field.desc, field.tc, err)
+			}
+			errs = append(errs, rr.fieldError(field.desc, field.tc, err))
+			continue
+		}
+		if !val.IsValid() {
+			continue
+		}
+		refl.Set(field.desc, val)
+	}
+
+	if len(errs) > 0 {
+		return warnings, errors.Join(errs...)
+	}
+
+	recordWarnings, err := p.checkRecord(refl, data)
+	return append(warnings, recordWarnings...), err
+}
+
+// checkRecord runs the record level checks, for trailing data, coverage and
+// rules, once the fields are parsed.
+func (p *MessageParser) checkRecord(refl protoreflect.Message, data []byte) ([]*FieldError, error) {
+	if err := handleTrailing(refl, p.ext, p.trailing, p.end, data); err != nil {
+		return nil, err
+	}
+
+	var warnings []*FieldError
+	switch p.ext.GetCoverage() {
+	case flatfile_pb.Coverage_COVERAGE_UNSPECIFIED:
+	case flatfile_pb.Coverage_COVERAGE_ERROR:
+		if unmapped := unmappedData(p.spans, data); len(unmapped) > 0 {
+			return nil, unmapped[0]
+		}
+	case flatfile_pb.Coverage_COVERAGE_WARNING:
+		warnings = unmappedData(p.spans, data)
+	default:
+		return nil, fmt.Errorf("unknown coverage %d", p.ext.GetCoverage())
+	}
+
+	if err := checkRules(refl.Interface(), p.rules); err != nil {
+		return warnings, err
+	}
+
+	return warnings, nil
+}
+
+// ParseBatch parses each of records into the message at the same index of
+// out, for callers which frame records themselves. Every record is parsed even
+// when some fail. Failures are joined into the returned error as
+// *RecordError, with Record set to the one based position in the batch and
+// no Offset.
+func (p *MessageParser) ParseBatch(records [][]byte, out []proto.Message) error {
+	if len(records) != len(out) {
+		return fmt.Errorf("batch of %d records has %d messages", len(records), len(out))
+	}
+
+	rr := NewReader(nil, p.ext.GetOneBased())
+	var errs []error
+	for idx, record := range records {
+		rr.Record = p.decoded(record)
+		if _, err := p.parseWith(rr, out[idx].ProtoReflect(), parseOptions{}); err != nil {
+			errs = append(errs, &RecordError{
+				Record: idx + 1,
+				Raw:    bytes.Clone(record),
+				Err:    err,
+			})
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,224 @@
+package binfile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestCompile(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 }, raw_field: "code_raw" }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	  string code_raw = 3;
+	  string unmapped = 4;
+	`)
+
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, record := range []string{"AB001", "CD002"} {
+		got := dynamicpb.NewMessage(msgDesc)
+		if err := parser.Parse(got, []byte(record)); err != nil {
+			t.Fatalf("compiled parse of %q: %v", record, err)
+		}
+		want := dynamicpb.NewMessage(msgDesc)
+		if err := ParseMessage(want, []byte(record)); err != nil {
+			t.Fatalf("parse of %q: %v", record, err)
+		}
+		if !proto.Equal(want, got) {
+			t.Errorf("%q: compiled parse %v differs from %v", record, got, want)
+		}
+	}
+
+	other := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	`)
+	if err := parser.Parse(dynamicpb.NewMessage(other), []byte("AB")); err == nil {
+		t.Error("expected error parsing a different message type")
+	}
+}
+
+func TestParseBatch(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	`)
+
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := [][]byte{[]byte("AB001"), []byte("CDxx2"), []byte("EF003")}
+	out := []proto.Message{dynamicpb.NewMessage(msgDesc), dynamicpb.NewMessage(msgDesc), dynamicpb.NewMessage(msgDesc)}
+
+	err = parser.ParseBatch(records, out)
+	recordErr := &RecordError{}
+	if !errors.As(err, &recordErr) || recordErr.Record != 2 {
+		t.Fatalf("expected RecordError for record 2, got %v", err)
+	}
+
+	count := msgDesc.Fields().ByName("count")
+	if out[0].ProtoReflect().Get(count).Int() != 1 || out[2].ProtoReflect().Get(count).Int() != 3 {
+		t.Errorf("expected records 1 and 3 to parse, got %v and %v", out[0], out[2])
+	}
+
+	if err := parser.ParseBatch(records, out[:2]); err == nil {
+		t.Error("expected error for mismatched lengths")
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		fields  string
+		wantErr string
+	}{{
+		name:    "Unsupported Kind",
+		fields:  `double d = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];`,
+		wantErr: "unknown type/kind: double",
+	}, {
+		name:    "Missing Raw Field",
+		fields:  `string s = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 }, raw_field: "nope" }];`,
+		wantErr: `raw field "nope" not found`,
+	}, {
+		name:    "Padded Binary",
+		fields:  `uint32 n = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 }, number: { encoding: ENCODING_BINARY }, treat_short_as: SHORT_IS_PADDED }];`,
+		wantErr: "SHORT_IS_PADDED is not supported for ENCODING_BINARY",
+	}, {
+		name: "Past Record Length",
+		fields: `option (flatfile.v1.message) = { record_length: 4, filler: [{ offset: 2, length: 4 }] };
+		string s = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];`,
+		wantErr: "filler at offset 2 length 4 extends past record_length 4",
+	}, {
+		name: "Trailing Field Not String",
+		fields: `option (flatfile.v1.message) = { treat_trailing_as: TRAILING_IS_CAPTURED, trailing_field: "n" };
+		string s = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+		int32 n = 2;`,
+		wantErr: `trailing_field: field "n" must be a string`,
+	}, {
+		name: "Offset 0 One Based",
+		fields: `option (flatfile.v1.message) = { one_based: true };
+		string s = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];`,
+		wantErr: "s is at offset 0, so the layout is not one based",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Compile(singleMessage(t, tc.fields))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseOffsetBeforeStart(t *testing.T) {
+	// Every record fails, rather than reading before the start of it.
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { one_based: true };
+	  string s = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	`)
+	err := runErr(t, msgDesc, []string{"AB"})
+	if !strings.Contains(err.Error(), "not one based") {
+		t.Errorf("expected an offset base error, got %v", err)
+	}
+}
+
+func TestCompilePastMinimumLength(t *testing.T) {
+	// Records of a minimum length may be longer, so fields after it can
+	// still be read.
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { record_length: 2, record_length_mode: LENGTH_MODE_MINIMUM };
+	  string s = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];
+	`)
+	if _, err := Compile(msgDesc); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateMessageDescriptor(msgDesc); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParserCache(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	`)
+
+	if _, ok := parserCache.Load(msgDesc); ok {
+		t.Fatal("expected no cached parser before parsing")
+	}
+	if err := ParseMessage(dynamicpb.NewMessage(msgDesc), []byte("AB")); err != nil {
+		t.Fatal(err)
+	}
+	first, ok := parserCache.Load(msgDesc)
+	if !ok {
+		t.Fatal("expected a cached parser after parsing")
+	}
+	if err := ParseMessage(dynamicpb.NewMessage(msgDesc), []byte("CD")); err != nil {
+		t.Fatal(err)
+	}
+	if second, _ := parserCache.Load(msgDesc); second != first {
+		t.Error("expected the cached parser to be reused")
+	}
+}
+
+func TestMessageParserConcurrent(t *testing.T) {
+	msgDesc := singleMessage(t,
+		prototest.WithMessageImports("j5/types/date/v1/date.proto"),
+		`
+	  option (flatfile.v1.message) = { rules: [{ expression: "count > 0" }] };
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	  j5.types.date.v1.Date due = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 5, length: 8 }
+		date: { format: "YYYYMMDD" }
+	  }];
+	`)
+
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Run with -race to check that the shared parser is only read.
+	var wg sync.WaitGroup
+	for worker := range 8 {
+		wg.Go(func() {
+			record := fmt.Appendf(nil, "%02d%03d20240131", worker, worker+1)
+			for range 100 {
+				msg := dynamicpb.NewMessage(msgDesc)
+				if err := parser.Parse(msg, record); err != nil {
+					t.Error(err)
+					return
+				}
+				formatted, err := parser.AppendRecord(nil, msg)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if string(formatted) != string(record) {
+					t.Errorf("expected %q, got %q", record, formatted)
+					return
+				}
+				lazy, err := parser.Lazy(record)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := lazy.Get("count"); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+	wg.Wait()
+}
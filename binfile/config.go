@@ -0,0 +1,155 @@
+package binfile
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/flatfile/recordio"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Config holds the defaults applied to fields whose annotations omit them, so
+// that the conventions of an organization's files are set in one place rather
+// than repeated on every field. The annotations of a field always win over
+// the config. The zero value, and a nil Config, apply no defaults.
+type Config struct {
+	// Trim applies to string and enum fields whose options leave trim
+	// unspecified.
+	Trim flatfile_pb.Trim
+
+	// Bool replaces the built in true and false values, of T, Y and 1 and
+	// F, N and 0, for bool fields without bool options.
+	Bool *flatfile_pb.BoolField
+
+	// Charset decodes every record before it is parsed, as WithCharset does,
+	// which wins over the config for the call it is given to.
+	Charset *recordio.Charset
+
+	// CenturyPivot applies to dates with two digit years whose options leave
+	// century_pivot unset.
+	CenturyPivot *uint32
+}
+
+// GetCharset returns the charset of the config, or nil.
+func (c *Config) GetCharset() *recordio.Charset {
+	if c == nil {
+		return nil
+	}
+	return c.Charset
+}
+
+var defaultConfig atomic.Pointer[Config]
+
+// SetDefaults sets the config used by Compile and the package level parsing
+// functions, or clears it when nil. Parsers already compiled keep the
+// defaults they were compiled with, so it should be called at init, before
+// any record is parsed.
+func SetDefaults(config *Config) {
+	defaultConfig.Store(config)
+	parserCache.Clear()
+}
+
+func defaults() *Config {
+	return defaultConfig.Load()
+}
+
+// fieldOptions returns the annotations of the field with the defaults of the
+// config merged in, after those of its message.
+func (c *Config) fieldOptions(fieldDesc protoreflect.FieldDescriptor) *flatfile_pb.Field {
+	tc := fieldOptions(fieldDesc)
+	if c == nil {
+		return tc
+	}
+	return fieldDefaults{
+		trim:         c.Trim,
+		bool:         c.Bool,
+		centuryPivot: c.CenturyPivot,
+	}.apply(fieldDesc, tc)
+}
+
+// fieldDefaults are the options set on fields which omit them, by the
+// annotation of their message or by a Config.
+type fieldDefaults struct {
+	trim         flatfile_pb.Trim
+	dateFormat   string
+	bool         *flatfile_pb.BoolField
+	centuryPivot *uint32
+}
+
+// apply returns the options of the field with the defaults merged in. The
+// options are cloned before any default is set, so the options of the
+// descriptor are never modified.
+func (d fieldDefaults) apply(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) *flatfile_pb.Field {
+	if d == (fieldDefaults{}) || tc == nil || tc.FixedWidth == nil {
+		return tc
+	}
+
+	merged := proto.Clone(tc).(*flatfile_pb.Field)
+	changed := false
+	kind := fieldKind(fieldDesc)
+
+	if d.trim != flatfile_pb.Trim_TRIM_UNSPECIFIED {
+		switch {
+		case merged.FieldType == nil && kind == protoreflect.StringKind:
+			merged.FieldType = &flatfile_pb.Field_String_{String_: &flatfile_pb.StringField{Trim: d.trim}}
+			changed = true
+		case merged.FieldType == nil && kind == protoreflect.EnumKind:
+			merged.FieldType = &flatfile_pb.Field_Enum{Enum: &flatfile_pb.EnumField{Trim: d.trim}}
+			changed = true
+		case merged.GetString_() != nil && merged.GetString_().Trim == flatfile_pb.Trim_TRIM_UNSPECIFIED:
+			merged.GetString_().Trim = d.trim
+			changed = true
+		case merged.GetEnum() != nil && merged.GetEnum().Trim == flatfile_pb.Trim_TRIM_UNSPECIFIED:
+			merged.GetEnum().Trim = d.trim
+			changed = true
+		}
+	}
+
+	if d.bool != nil && merged.FieldType == nil && kind == protoreflect.BoolKind {
+		merged.FieldType = &flatfile_pb.Field_Bool{Bool: d.bool}
+		changed = true
+	}
+
+	if d.dateFormat != "" && kind == protoreflect.MessageKind && fieldDesc.Message().FullName() == "j5.types.date.v1.Date" {
+		switch {
+		case merged.FieldType == nil:
+			merged.FieldType = &flatfile_pb.Field_Date{Date: &flatfile_pb.DateField{Format: d.dateFormat}}
+			changed = true
+		case merged.GetDate() != nil && merged.GetDate().Format == "":
+			merged.GetDate().Format = d.dateFormat
+			changed = true
+		}
+	}
+
+	if date := merged.GetDate(); d.centuryPivot != nil && date != nil && date.CenturyPivot == nil && !hasFourDigitYear(date) {
+		date.CenturyPivot = proto.Uint32(*d.centuryPivot)
+		changed = true
+	}
+
+	if !changed {
+		return tc
+	}
+	return merged
+}
+
+// fieldKind returns the kind of the value the field holds, which for the
+// wrapper types is the kind of their value.
+func fieldKind(fieldDesc protoreflect.FieldDescriptor) protoreflect.Kind {
+	if fieldDesc.Kind() != protoreflect.MessageKind {
+		return fieldDesc.Kind()
+	}
+	switch fieldDesc.Message().FullName() {
+	case "google.protobuf.StringValue":
+		return protoreflect.StringKind
+	case "google.protobuf.BoolValue":
+		return protoreflect.BoolKind
+	default:
+		return protoreflect.MessageKind
+	}
+}
+
+func hasFourDigitYear(date *flatfile_pb.DateField) bool {
+	return strings.Contains(date.Format, "YYYY")
+}
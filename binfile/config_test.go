@@ -0,0 +1,214 @@
+package binfile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/flatfile/recordio"
+	"github.com/pentops/flowtest/prototest"
+	"github.com/pentops/j5/lib/j5codec"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestConfig(t *testing.T) {
+	msgDesc := singleMessage(t, prototest.WithMessageImports("j5/types/date/v1/date.proto"), `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];
+	  string name = 2 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 4 }, string: { trim: TRIM_RIGHT } }];
+	  bool active = 3 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 1 } }];
+	  bool flag = 4 [(flatfile.v1.field) = { fixed_width: { offset: 9, length: 1 }, bool: { true_values: ["1"], false_values: ["0"] } }];
+	  j5.types.date.v1.Date opened = 5 [(flatfile.v1.field) = { fixed_width: { offset: 10, length: 6 }, date: { format: "YYMMDD" } }];
+	  j5.types.date.v1.Date closed = 6 [(flatfile.v1.field) = { fixed_width: { offset: 16, length: 6 }, date: { format: "YYMMDD", century_pivot: 30 } }];
+	`)
+
+	parse := func(t *testing.T, config *Config, data []byte, wantJSON string) {
+		t.Helper()
+		parser, err := config.Compile(msgDesc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := dynamicpb.NewMessage(msgDesc)
+		if err := parser.Parse(msg, data); err != nil {
+			t.Fatal(err)
+		}
+		want := dynamicpb.NewMessage(msgDesc)
+		if err := j5codec.Global.JSONToProto([]byte(wantJSON), want); err != nil {
+			t.Fatal(err)
+		}
+		prototest.AssertEqualProto(t, want, msg)
+	}
+
+	t.Run("none", func(t *testing.T) {
+		parse(t, nil, []byte(" AB  CD N1490101290101"), `{
+			"code": " AB ",
+			"name": " CD",
+			"flag": true,
+			"opened": "2049-01-01",
+			"closed": "2029-01-01"
+		}`)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		// Fields which set their own options keep them.
+		config := &Config{
+			Trim:         flatfile_pb.Trim_TRIM_BOTH,
+			Bool:         &flatfile_pb.BoolField{TrueValues: []string{"J"}, FalseValues: []string{"N"}},
+			CenturyPivot: proto.Uint32(50),
+		}
+		parse(t, config, []byte(" AB  CD J1490101290101"), `{
+			"code": "AB",
+			"name": " CD",
+			"active": true,
+			"flag": true,
+			"opened": "2049-01-01",
+			"closed": "2029-01-01"
+		}`)
+		parse(t, config, []byte("AB  CD  N0500101300101"), `{
+			"code": "AB",
+			"name": "CD",
+			"opened": "1950-01-01",
+			"closed": "1930-01-01"
+		}`)
+
+		// The annotations of the descriptor are left as they were.
+		if tc := fieldOptions(msgDesc.Fields().ByName("code")); tc.FieldType != nil {
+			t.Errorf("field options modified: %v", tc)
+		}
+	})
+
+	t.Run("charset", func(t *testing.T) {
+		data := []byte("ABCDEFGHN0490101290101")
+		for idx, b := range data {
+			data[idx] = recordio.EBCDIC.EncodeByte(b)
+		}
+		parse(t, &Config{Charset: &recordio.EBCDIC}, data, `{
+			"code": "ABCD",
+			"name": "EFGH",
+			"opened": "2049-01-01",
+			"closed": "2029-01-01"
+		}`)
+
+		// Every way into the parser decodes the record.
+		parser, err := (&Config{Charset: &recordio.EBCDIC}).Compile(msgDesc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch := []proto.Message{dynamicpb.NewMessage(msgDesc)}
+		if err := parser.ParseBatch([][]byte{data}, batch); err != nil {
+			t.Fatal(err)
+		}
+		if code := batch[0].ProtoReflect().Get(msgDesc.Fields().ByName("code")).String(); code != "ABCD" {
+			t.Errorf("ParseBatch: got code %q", code)
+		}
+		lazy, err := parser.Lazy(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code, err := lazy.GetString("code"); err != nil || code != "ABCD" {
+			t.Errorf("Lazy: got code %q, %v", code, err)
+		}
+		msg := dynamicpb.NewMessage(msgDesc)
+		if err := lazy.Parse(msg); err != nil || !bytes.Equal(lazy.Raw(), data) {
+			t.Errorf("Lazy: got %v parsing, raw %q", err, lazy.Raw())
+		}
+		if inspected := parser.Inspect(data); string(inspected[0].Raw) != "ABCD" {
+			t.Errorf("Inspect: got raw %q", inspected[0].Raw)
+		}
+	})
+
+	t.Run("package charset", func(t *testing.T) {
+		SetDefaults(&Config{Charset: &recordio.EBCDIC})
+		t.Cleanup(func() { SetDefaults(nil) })
+
+		gp, err := NewGeneratedParser(msgDesc, "code")
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr, err := gp.Reader([]byte{0xc1, 0xc2, 0xc3, 0xc4})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code, err := gp.Read(rr, 0); err != nil || code.String() != "ABCD" {
+			t.Errorf("generated: got code %v, %v", code, err)
+		}
+	})
+
+	t.Run("package defaults", func(t *testing.T) {
+		SetDefaults(&Config{Trim: flatfile_pb.Trim_TRIM_BOTH})
+		t.Cleanup(func() { SetDefaults(nil) })
+
+		msg := dynamicpb.NewMessage(msgDesc)
+		if err := ParseMessage(msg, []byte(" AB  CD N1490101290101")); err != nil {
+			t.Fatal(err)
+		}
+		if code := msg.Get(msgDesc.Fields().ByName("code")).String(); code != "AB" {
+			t.Errorf("got code %q", code)
+		}
+	})
+}
+
+func TestCenturyPivot(t *testing.T) {
+	for _, tc := range []struct {
+		field   string
+		wantErr bool
+	}{{
+		field: `date: { format: "YYMMDD", century_pivot: 0 }`,
+	}, {
+		field: `date: { format: "DDMMYY", century_pivot: 100 }`,
+	}, {
+		field:   `date: { format: "YYYYMMDD", century_pivot: 50 }`,
+		wantErr: true,
+	}, {
+		field:   `date: { format: "YYMMDD", century_pivot: 101 }`,
+		wantErr: true,
+	}} {
+		msgDesc := singleMessage(t, prototest.WithMessageImports("j5/types/date/v1/date.proto"), `j5.types.date.v1.Date d = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 8 }, `+tc.field+` }];`)
+		err := ValidateMessageDescriptor(msgDesc)
+		if tc.wantErr != (err != nil) {
+			t.Errorf("%s: got %v", tc.field, err)
+		}
+	}
+
+	read := func(pivot uint32, data string) (protoreflect.Message, error) {
+		msgDesc := singleMessage(t, prototest.WithMessageImports("j5/types/date/v1/date.proto"), `j5.types.date.v1.Date d = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 6 }, date: { format: "YYMMDD" } }];`)
+		parser, err := (&Config{CenturyPivot: &pivot}).Compile(msgDesc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := dynamicpb.NewMessage(msgDesc)
+		if err := parser.Parse(msg, []byte(data)); err != nil {
+			return nil, err
+		}
+		return msg.Get(msgDesc.Fields().ByName("d")).Message(), nil
+	}
+
+	// A pivot of 0 reads every year in the 1900s, and of 100 in the 2000s.
+	for _, tc := range []struct {
+		pivot    uint32
+		data     string
+		wantYear int64
+	}{
+		{0, "000101", 1900},
+		{100, "990101", 2099},
+		{69, "680101", 2068},
+		{69, "690101", 1969},
+	} {
+		date, err := read(tc.pivot, tc.data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if year := date.Get(date.Descriptor().Fields().ByName("year")).Int(); year != tc.wantYear {
+			t.Errorf("pivot %d, %s: got year %d", tc.pivot, tc.data, year)
+		}
+	}
+
+	// 2000 is a leap year, 1900 is not.
+	if _, err := read(0, "000229"); err == nil {
+		t.Error("expected an error for 29 February 1900")
+	}
+	if _, err := read(100, "000229"); err != nil {
+		t.Error(err)
+	}
+}
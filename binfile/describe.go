@@ -0,0 +1,360 @@
+package binfile
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessageLayout describes the record layout of a message for tools which
+// work with layouts rather than records, such as documentation sites, UIs and
+// validators in other languages. It is built from the annotations alone, and
+// marshals to JSON.
+type MessageLayout struct {
+	Message string `json:"message"`
+	// Description is the leading comment of the message, when the descriptor
+	// has source info.
+	Description string `json:"description,omitempty"`
+
+	// OneBased is true when offsets count the first byte of the record as 1.
+	OneBased bool `json:"oneBased,omitempty"`
+	// RecordLength is 0 when the length of records is not checked.
+	RecordLength int `json:"recordLength,omitempty"`
+	// RecordLengthMode is exact, minimum or maximum.
+	RecordLengthMode string `json:"recordLengthMode,omitempty"`
+
+	// Fields holds the fixed width fields and filler, in offset order.
+	Fields []FieldLayout `json:"fields"`
+}
+
+// FieldLayout describes one fixed width field or filler range.
+type FieldLayout struct {
+	// Name is empty for filler.
+	Name   string `json:"name,omitempty"`
+	Filler bool   `json:"filler,omitempty"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+
+	// Type is string, integer, decimal, date, bool, enum or bytes, or the full
+	// name of other message types.
+	Type     string `json:"type,omitempty"`
+	Required bool   `json:"required,omitempty"`
+	// Trim is left, right, both or right_padding for strings, enums and
+	// bools which trim their values.
+	Trim string `json:"trim,omitempty"`
+
+	// Encoding is digits, packed_decimal, overpunch or binary for numbers.
+	Encoding  string `json:"encoding,omitempty"`
+	Scale     int    `json:"scale,omitempty"`
+	MaxDigits int    `json:"maxDigits,omitempty"`
+
+	// Codec is the name of the codec of fields with the custom option.
+	Codec string `json:"codec,omitempty"`
+
+	DateFormat string `json:"dateFormat,omitempty"`
+	// TrueValues and FalseValues are empty for bools which read the default
+	// values, Y/T/1 and N/F/0 either case.
+	TrueValues  []string  `json:"trueValues,omitempty"`
+	FalseValues []string  `json:"falseValues,omitempty"`
+	EnumKeys    []EnumKey `json:"enumKeys,omitempty"`
+
+	// Charset is numeric, alpha, alphanumeric or printable when strings are
+	// restricted to a class of characters.
+	Charset   string `json:"charset,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+	MinLength int    `json:"minLength,omitempty"`
+
+	// CheckDigit is luhn, aba or mod11.
+	CheckDigit string          `json:"checkDigit,omitempty"`
+	Checksum   *ChecksumLayout `json:"checksum,omitempty"`
+
+	// Format summarizes how the value is written, e.g. "packed decimal; 2
+	// implied decimal places", for people reading the layout.
+	Format string `json:"format,omitempty"`
+	// Description is the description option of the field, or else its
+	// leading comment when the descriptor has source info.
+	Description string `json:"description,omitempty"`
+	// Example is a value as written in the file.
+	Example string `json:"example,omitempty"`
+}
+
+// EnumKey is the value written in the file for an enum value.
+type EnumKey struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ChecksumLayout is the range of the record a checksum field covers.
+type ChecksumLayout struct {
+	// Algorithm is crc16, crc32 or sum.
+	Algorithm string `json:"algorithm"`
+	Offset    int    `json:"offset"`
+	Length    int    `json:"length"`
+	// Modulus is the modulus of sum checksums, 0 for the default of 256.
+	Modulus int `json:"modulus,omitempty"`
+	// Hex is true when the checksum is written as hex digits.
+	Hex bool `json:"hex,omitempty"`
+}
+
+// DescribeLayout describes the fixed width fields and filler of the message.
+// Offsets are as annotated, so one based when the message is.
+func DescribeLayout(desc protoreflect.MessageDescriptor) *MessageLayout {
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	layout := &MessageLayout{
+		Message:      string(desc.FullName()),
+		Description:  comments(desc),
+		OneBased:     ext.GetOneBased(),
+		RecordLength: int(ext.GetRecordLength()),
+		Fields:       []FieldLayout{},
+	}
+	if layout.RecordLength > 0 {
+		layout.RecordLengthMode = "exact"
+		switch ext.GetRecordLengthMode() {
+		case flatfile_pb.LengthMode_LENGTH_MODE_MINIMUM:
+			layout.RecordLengthMode = "minimum"
+		case flatfile_pb.LengthMode_LENGTH_MODE_MAXIMUM:
+			layout.RecordLengthMode = "maximum"
+		}
+	}
+
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		tc := fieldOptions(fieldDesc)
+		if tc.GetFixedWidth() == nil {
+			continue
+		}
+		layout.Fields = append(layout.Fields, describeField(fieldDesc, tc))
+	}
+	for _, filler := range ext.GetFiller() {
+		layout.Fields = append(layout.Fields, FieldLayout{
+			Filler: true,
+			Offset: int(filler.Offset),
+			Length: int(filler.Length),
+			Format: "spaces",
+		})
+	}
+	slices.SortStableFunc(layout.Fields, func(a, b FieldLayout) int {
+		return a.Offset - b.Offset
+	})
+	return layout
+}
+
+func describeField(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) FieldLayout {
+	field := FieldLayout{
+		Name:        string(fieldDesc.Name()),
+		Offset:      int(tc.FixedWidth.Offset),
+		Length:      int(tc.FixedWidth.Length),
+		Type:        layoutType(fieldDesc),
+		Required:    tc.Required,
+		Description: tc.Description,
+		Example:     tc.Example,
+	}
+	if field.Description == "" {
+		field.Description = comments(fieldDesc)
+	}
+
+	var trim flatfile_pb.Trim
+	switch {
+	case tc.GetString_() != nil:
+		trim = tc.GetString_().GetTrim()
+	case tc.GetEnum() != nil:
+		trim = tc.GetEnum().GetTrim()
+	case tc.GetBool() != nil:
+		trim = tc.GetBool().GetTrim()
+	}
+	if trim != flatfile_pb.Trim_TRIM_UNSPECIFIED {
+		field.Trim = strings.ToLower(strings.TrimPrefix(trim.String(), "TRIM_"))
+	}
+
+	// parts are the pieces of the Format summary.
+	var parts []string
+
+	// Fields with a custom codec are written however the codec writes them.
+	options := field.Type
+	if tc.GetCustom() != nil {
+		options = "custom"
+	}
+
+	switch options {
+	case "custom":
+		field.Codec = tc.GetCustom().GetCodec()
+		parts = append(parts, field.Codec+" codec")
+
+	case "integer", "decimal":
+		number := tc.GetNumber()
+		switch number.GetEncoding() {
+		case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL:
+			field.Encoding = "packed_decimal"
+			parts = append(parts, "packed decimal")
+		case flatfile_pb.Encoding_ENCODING_OVERPUNCH:
+			field.Encoding = "overpunch"
+			parts = append(parts, "signed overpunch")
+		case flatfile_pb.Encoding_ENCODING_BINARY:
+			field.Encoding = "binary"
+			parts = append(parts, "binary, big endian")
+		default:
+			field.Encoding = "digits"
+			parts = append(parts, "digits")
+		}
+		if scale := number.GetFixedScale(); scale > 0 {
+			field.Scale = int(scale)
+			parts = append(parts, fmt.Sprintf("%d implied decimal places", scale))
+		}
+		if digits := number.GetMaxDigits(); digits > 0 {
+			field.MaxDigits = int(digits)
+			parts = append(parts, fmt.Sprintf("at most %d digits", digits))
+		}
+
+	case "date":
+		if format := tc.GetDate().GetFormat(); format != "" {
+			field.DateFormat = format
+			parts = append(parts, format)
+		}
+
+	case "bool":
+		boolField := tc.GetBool()
+		if boolField == nil {
+			parts = append(parts, "Y/T/1 true, N/F/0 false")
+		} else {
+			field.TrueValues = boolField.TrueValues
+			field.FalseValues = boolField.FalseValues
+			parts = append(parts, fmt.Sprintf("%s true, %s false",
+				strings.Join(boolField.TrueValues, "/"),
+				strings.Join(boolField.FalseValues, "/")))
+		}
+
+	case "enum":
+		values := fieldDesc.Enum().Values()
+		var keys []string
+		for i := range values.Len() {
+			valueDesc := values.Get(i)
+			ext, _ := proto.GetExtension(valueDesc.Options(), flatfile_pb.E_Enum).(*flatfile_pb.Enum)
+			if ext == nil {
+				continue
+			}
+			field.EnumKeys = append(field.EnumKeys, EnumKey{Key: ext.Key, Value: string(valueDesc.Name())})
+			keys = append(keys, fmt.Sprintf("%s = %s", strconv.Quote(ext.Key), valueDesc.Name()))
+		}
+		parts = append(parts, strings.Join(keys, ", "))
+
+	case "string":
+		stringField := tc.GetString_()
+		switch stringField.GetCharsetClass() {
+		case flatfile_pb.CharsetClass_CHARSET_CLASS_NUMERIC:
+			parts = append(parts, "digits only")
+		case flatfile_pb.CharsetClass_CHARSET_CLASS_ALPHA:
+			parts = append(parts, "letters and spaces")
+		case flatfile_pb.CharsetClass_CHARSET_CLASS_ALPHANUMERIC:
+			parts = append(parts, "letters, digits and spaces")
+		case flatfile_pb.CharsetClass_CHARSET_CLASS_PRINTABLE:
+			parts = append(parts, "printable ASCII")
+		}
+		if class := stringField.GetCharsetClass(); class != flatfile_pb.CharsetClass_CHARSET_CLASS_UNSPECIFIED {
+			field.Charset = strings.ToLower(strings.TrimPrefix(class.String(), "CHARSET_CLASS_"))
+		}
+		if pattern := stringField.GetPattern(); pattern != "" {
+			field.Pattern = pattern
+			parts = append(parts, "matching "+pattern)
+		}
+		if minLength := stringField.GetMinLength(); minLength > 0 {
+			field.MinLength = int(minLength)
+			parts = append(parts, fmt.Sprintf("at least %d characters", minLength))
+		}
+	}
+
+	switch tc.CheckDigit {
+	case flatfile_pb.CheckDigit_CHECK_DIGIT_LUHN:
+		parts = append(parts, "Luhn check digit")
+	case flatfile_pb.CheckDigit_CHECK_DIGIT_ABA:
+		parts = append(parts, "ABA check digit")
+	case flatfile_pb.CheckDigit_CHECK_DIGIT_MOD11:
+		parts = append(parts, "mod 11 check digit")
+	}
+	if tc.CheckDigit != flatfile_pb.CheckDigit_CHECK_DIGIT_UNSPECIFIED {
+		field.CheckDigit = strings.ToLower(strings.TrimPrefix(tc.CheckDigit.String(), "CHECK_DIGIT_"))
+	}
+	if checksum := tc.GetChecksum(); checksum != nil {
+		algorithm := strings.TrimPrefix(checksum.Algorithm.String(), "CHECKSUM_ALGORITHM_")
+		field.Checksum = &ChecksumLayout{
+			Algorithm: strings.ToLower(algorithm),
+			Offset:    int(checksum.GetRange().GetOffset()),
+			Length:    int(checksum.GetRange().GetLength()),
+			Modulus:   int(checksum.Modulus),
+			Hex:       checksum.Hex,
+		}
+		parts = append(parts, fmt.Sprintf("%s checksum of positions %d-%d",
+			algorithm,
+			checksum.GetRange().GetOffset(),
+			checksum.GetRange().GetOffset()+checksum.GetRange().GetLength()-1))
+	}
+
+	field.Format = strings.Join(parts, "; ")
+	return field
+}
+
+// layoutType names the type of a field for people and other languages,
+// treating the wrapper and j5 types as the values they hold.
+func layoutType(fieldDesc protoreflect.FieldDescriptor) string {
+	switch fieldDesc.Kind() {
+	case protoreflect.MessageKind:
+		switch fieldDesc.Message().FullName() {
+		case "google.protobuf.StringValue":
+			return "string"
+		case "google.protobuf.BoolValue":
+			return "bool"
+		case "j5.types.decimal.v1.Decimal":
+			return "decimal"
+		case "j5.types.date.v1.Date":
+			return "date"
+		}
+		return string(fieldDesc.Message().FullName())
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return "integer"
+	default:
+		return fieldDesc.Kind().String()
+	}
+}
+
+// DecimalPrecision is the precision and scale of a decimal field with a
+// fixed scale, for schemas which give decimals a fixed size. The precision is
+// max_digits when set, and otherwise the digits the field's width and
+// encoding can hold. Fields without a fixed scale return 0, 0, as the scale
+// of each value may differ.
+func DecimalPrecision(tc *flatfile_pb.Field) (precision, scale int32) {
+	number := tc.GetNumber()
+	scale = number.GetFixedScale()
+	if scale == 0 {
+		return 0, 0
+	}
+	precision = int32(number.GetMaxDigits())
+	if precision == 0 {
+		length := int32(tc.GetFixedWidth().GetLength())
+		switch number.GetEncoding() {
+		case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL:
+			precision = 2*length - 1
+		case flatfile_pb.Encoding_ENCODING_BINARY:
+			precision = int32(math.Ceil(float64(length*8) * math.Log10(2)))
+		default:
+			precision = length
+		}
+	}
+	return max(precision, scale), scale
+}
+
+// comments returns the leading comment of the descriptor, or the trailing
+// comment when there is none, as one line.
+func comments(desc protoreflect.Descriptor) string {
+	loc := desc.ParentFile().SourceLocations().ByDescriptor(desc)
+	text := loc.LeadingComments
+	if strings.TrimSpace(text) == "" {
+		text = loc.TrailingComments
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,95 @@
+package binfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestDescribeLayout(t *testing.T) {
+	msgDesc := singleMessage(t,
+		prototest.WithMessageImports("j5/types/date/v1/date.proto", "j5/types/decimal/v1/decimal.proto"),
+		`
+	  option (flatfile.v1.message) = {
+		one_based: true
+		record_length: 40
+		record_length_mode: LENGTH_MODE_MINIMUM
+		filler: [{ offset: 30, length: 2 }]
+	  };
+	  string card = 1 [(flatfile.v1.field) = {
+		fixed_width: { offset: 1, length: 16 }
+		string: { charset_class: CHARSET_CLASS_NUMERIC }
+		check_digit: CHECK_DIGIT_LUHN
+		required: true
+		description: "The card number."
+		example: "4111111111111111"
+	  }];
+	  j5.types.decimal.v1.Decimal amount = 2 [(flatfile.v1.field) = {
+		fixed_width: { offset: 17, length: 5 }
+		number: { encoding: ENCODING_PACKED_DECIMAL, fixed_scale: 2 }
+	  }];
+	  j5.types.date.v1.Date posted = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 22, length: 8 }
+		date: { format: "YYYYMMDD" }
+	  }];
+	  bool active = 4 [(flatfile.v1.field) = { fixed_width: { offset: 32, length: 1 } }];
+	  string raw = 5;
+	`)
+
+	layout := DescribeLayout(msgDesc)
+	data, err := json.Marshal(layout)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+	  "message": "` + string(msgDesc.FullName()) + `",
+	  "oneBased": true,
+	  "recordLength": 40,
+	  "recordLengthMode": "minimum",
+	  "fields": [
+		{"name": "card", "offset": 1, "length": 16, "type": "string", "required": true,
+		 "charset": "numeric", "checkDigit": "luhn", "format": "digits only; Luhn check digit",
+		 "description": "The card number.", "example": "4111111111111111"},
+		{"name": "amount", "offset": 17, "length": 5, "type": "decimal", "encoding": "packed_decimal",
+		 "scale": 2, "format": "packed decimal; 2 implied decimal places"},
+		{"name": "posted", "offset": 22, "length": 8, "type": "date", "dateFormat": "YYYYMMDD", "format": "YYYYMMDD"},
+		{"filler": true, "offset": 30, "length": 2, "format": "spaces"},
+		{"name": "active", "offset": 32, "length": 1, "type": "bool", "format": "Y/T/1 true, N/F/0 false"}
+	  ]
+	}`
+	compact := &bytes.Buffer{}
+	if err := json.Compact(compact, []byte(want)); err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != compact.String() {
+		t.Errorf("got:\n%s\nwant:\n%s", data, compact)
+	}
+
+	// The described scale is the one parsing applies, so the packed digits
+	// 123456 are 1234.56.
+	record := []byte("4111111111111111" + "\x00\x01\x23\x45\x6C" + "20240131" + "  " + "Y" + strings.Repeat(" ", 8))
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, record); err != nil {
+		t.Fatal(err)
+	}
+	amount := msg.Get(msgDesc.Fields().ByName("amount")).Message()
+	value, err := decimal.NewFromString(amount.Get(amount.Descriptor().Fields().ByName("value")).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := decimal.New(123456, -int32(layout.Fields[1].Scale)); !value.Equal(want) {
+		t.Errorf("got amount %s, want %s", value, want)
+	}
+
+	// Messages without annotations still describe as an empty layout.
+	empty := DescribeLayout(singleMessage(t, `string a = 1;`))
+	if data, err := json.Marshal(empty); err != nil || !strings.Contains(string(data), `"fields":[]`) {
+		t.Errorf("got %s, %v", data, err)
+	}
+}
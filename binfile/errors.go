@@ -0,0 +1,104 @@
+package binfile
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/flatfile/recordio"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldError is returned by ParseMessage when a field fails to parse. It
+// locates the failure in the record for rejection reports, and wraps the
+// underlying cause so errors.Is and errors.As see through it.
+type FieldError struct {
+	// Field is the full name of the proto field, empty for errors which are
+	// not about a single field, e.g. unmapped data.
+	Field protoreflect.FullName
+
+	// Offset is the zero based byte offset of the field in the record,
+	// regardless of the message's one_based setting.
+	Offset int
+	Length int
+
+	// Raw is a copy of the field's bytes, truncated where the record is
+	// shorter than the field.
+	Raw []byte
+
+	// Context is a copy of the bytes around the field, starting at
+	// ContextOffset, set when the field is binary so that a text preview of
+	// Raw would be unreadable.
+	Context       []byte
+	ContextOffset int
+
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	var msg string
+	if e.Field == "" {
+		msg = fmt.Sprintf("error at offset %d, length %d: %s", e.Offset, e.Length, e.Err)
+	} else {
+		msg = fmt.Sprintf("error reading field %s (offset %d, length %d): %s", e.Field, e.Offset, e.Length, e.Err)
+	}
+	if len(e.Context) > 0 {
+		msg += fmt.Sprintf(" [bytes from offset %d: % x]", e.ContextOffset, e.Context)
+	}
+	return msg
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// HexDump formats Context as rows of 16 bytes labelled with their offsets in
+// the record.
+func (e *FieldError) HexDump() string {
+	return recordio.HexDump(e.Context, e.ContextOffset)
+}
+
+func (r *Reader) fieldError(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field, err error) *FieldError {
+	fe := &FieldError{
+		Field: fieldDesc.FullName(),
+		Err:   err,
+	}
+	if tc == nil || tc.FixedWidth == nil {
+		return fe
+	}
+
+	fe.Offset = int(tc.FixedWidth.Offset)
+	if r.OneBased {
+		fe.Offset--
+	}
+	fe.Length = int(tc.FixedWidth.Length)
+
+	if fe.Offset >= 0 && fe.Offset < len(r.Record) {
+		end := min(fe.Offset+fe.Length, len(r.Record))
+		fe.Raw = bytes.Clone(r.Record[fe.Offset:end])
+
+		if isBinaryField(tc, fe.Raw) {
+			fe.ContextOffset = max(fe.Offset-hexContextBytes, 0)
+			contextEnd := min(end+hexContextBytes, len(r.Record))
+			fe.Context = bytes.Clone(r.Record[fe.ContextOffset:contextEnd])
+		}
+	}
+	return fe
+}
+
+// hexContextBytes is how many bytes either side of a binary field are kept
+// in FieldError.Context.
+const hexContextBytes = 8
+
+func isBinaryField(tc *flatfile_pb.Field, raw []byte) bool {
+	switch tc.GetNumber().GetEncoding() {
+	case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL, flatfile_pb.Encoding_ENCODING_BINARY:
+		return true
+	}
+	for _, b := range raw {
+		if b < 0x20 || b > 0x7e {
+			return true
+		}
+	}
+	return false
+}
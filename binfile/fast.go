@@ -0,0 +1,191 @@
+package binfile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"golang.org/x/exp/mmap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// fastChunkRecords is the number of records each worker of ParseFileFast
+// parses at a time.
+const fastChunkRecords = 1024
+
+type fastChunk struct {
+	first   int // zero based index of the first record
+	count   int
+	results chan []ParallelResult
+}
+
+// ParseFileFast parses a file of fixed length records, for files too large to
+// read through a bufio.Scanner in reasonable time. The file is split into
+// chunks of records by position, each read into a buffer reused between
+// chunks, which are parsed across one worker per CPU. handle is called with
+// each result in the order of the file, from the calling goroutine. Only a
+// few chunks per worker are in memory at once, however large the file.
+//
+// The message must set record_length with the exact length mode. Records
+// may be separated by "\n" or "\r\n", detected from the first record, or
+// not separated at all.
+func ParseFileFast(ctx context.Context, path string, desc protoreflect.MessageDescriptor, handle func(ParallelResult) error) error {
+	parser, err := cachedParser(desc)
+	if err != nil {
+		return err
+	}
+	length := int(parser.ext.GetRecordLength())
+	switch parser.ext.GetRecordLengthMode() {
+	case flatfile_pb.LengthMode_LENGTH_MODE_UNSPECIFIED, flatfile_pb.LengthMode_LENGTH_MODE_EXACT:
+	default:
+		length = 0
+	}
+	if length == 0 {
+		return fmt.Errorf("%s has no exact record_length, which ParseFileFast needs to split the file", desc.FullName())
+	}
+
+	file, err := mmap.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	stride, count, err := fixedRecords(file, length)
+	if err != nil {
+		return err
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan *fastChunk, workers)
+	ordered := make(chan *fastChunk, workers*2)
+
+	go func() {
+		defer close(jobs)
+		defer close(ordered)
+		for first := 0; first < count; first += fastChunkRecords {
+			chunk := &fastChunk{
+				first:   first,
+				count:   min(fastChunkRecords, count-first),
+				results: make(chan []ParallelResult, 1),
+			}
+			select {
+			case ordered <- chunk:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- chunk:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	buffers := sync.Pool{
+		New: func() any {
+			buf := make([]byte, stride*fastChunkRecords)
+			return &buf
+		},
+	}
+
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Go(func() {
+			for chunk := range jobs {
+				buf := buffers.Get().(*[]byte)
+				chunk.results <- parseFastChunk(parser, file, *buf, stride, length, chunk)
+				buffers.Put(buf)
+			}
+		})
+	}
+	defer wg.Wait()
+
+	for chunk := range ordered {
+		var results []ParallelResult
+		select {
+		case results = <-chunk.results:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		for _, result := range results {
+			if err := handle(result); err != nil {
+				cancel()
+				for range ordered {
+				}
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// fixedRecords works out the distance between the starts of records from
+// the bytes after the first record, and the number of records in the file,
+// which may omit the line ending after the last record.
+func fixedRecords(file *mmap.ReaderAt, length int) (int, int, error) {
+	size := file.Len()
+	if size == 0 {
+		return length, 0, nil
+	}
+
+	stride := length
+	if size > length {
+		switch {
+		case file.At(length) == '\n':
+			stride = length + 1
+		case file.At(length) == '\r' && size > length+1 && file.At(length+1) == '\n':
+			stride = length + 2
+		}
+	}
+
+	count := size / stride
+	switch size % stride {
+	case 0:
+	case length:
+		count++
+	default:
+		return 0, 0, fmt.Errorf("%w: file of %d bytes is not made of %d byte records", ErrRecordLength, size, length)
+	}
+	return stride, count, nil
+}
+
+func parseFastChunk(parser *MessageParser, file *mmap.ReaderAt, buf []byte, stride, length int, chunk *fastChunk) []ParallelResult {
+	start := chunk.first * stride
+	end := min(start+chunk.count*stride, file.Len())
+	buf = buf[:end-start]
+
+	results := make([]ParallelResult, chunk.count)
+	if _, err := file.ReadAt(buf, int64(start)); err != nil {
+		for idx := range results {
+			results[idx] = ParallelResult{Record: chunk.first + idx + 1, Err: err}
+		}
+		return results
+	}
+
+	rr := NewReader(nil, parser.ext.GetOneBased())
+	for idx := range results {
+		record := buf[idx*stride : idx*stride+length]
+		msg := dynamicpb.NewMessage(parser.desc)
+		results[idx] = ParallelResult{
+			Record:  chunk.first + idx + 1,
+			Message: msg,
+		}
+		rr.Record = parser.decoded(record)
+		if _, err := parser.parseWith(rr, msg.ProtoReflect(), parseOptions{}); err != nil {
+			results[idx].Err = &RecordError{
+				Record: chunk.first + idx + 1,
+				Offset: int64((chunk.first + idx) * stride),
+				Raw:    bytes.Clone(record),
+				Err:    err,
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,125 @@
+package binfile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/recordio"
+)
+
+func TestParseFileFast(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { record_length: 6 };
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 4 }, number: {} }];
+	`)
+
+	const records = 3000
+	lines := make([]string, records)
+	for idx := range lines {
+		lines[idx] = fmt.Sprintf("AB%04d", idx+1)
+	}
+	lines[1499] = "ABxxxx"
+
+	count := msgDesc.Fields().ByName("count")
+	for name, content := range map[string]string{
+		"newline":        strings.Join(lines, "\n") + "\n",
+		"crlf":           strings.Join(lines, "\r\n"),
+		"no line ending": strings.Join(lines, ""),
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "records.txt")
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			next := 1
+			err := ParseFileFast(context.Background(), path, msgDesc, func(result ParallelResult) error {
+				if result.Record != next {
+					return fmt.Errorf("expected record %d, got %d", next, result.Record)
+				}
+				next++
+				if result.Record == 1500 {
+					if !errors.Is(result.Err, ErrInvalidNumber) {
+						return fmt.Errorf("record 1500: expected ErrInvalidNumber, got %v", result.Err)
+					}
+					return nil
+				}
+				if result.Err != nil {
+					return result.Err
+				}
+				if got := result.Message.ProtoReflect().Get(count).Int(); got != int64(result.Record) {
+					return fmt.Errorf("record %d: got count %d", result.Record, got)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if next != records+1 {
+				t.Errorf("expected %d records, got %d", records, next-1)
+			}
+		})
+	}
+
+	t.Run("Ragged", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "records.txt")
+		if err := os.WriteFile(path, []byte("AB0001\nAB02\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		err := ParseFileFast(context.Background(), path, msgDesc, func(ParallelResult) error { return nil })
+		if !errors.Is(err, ErrRecordLength) {
+			t.Errorf("expected ErrRecordLength, got %v", err)
+		}
+	})
+
+	t.Run("Handler Error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "records.txt")
+		if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		stop := errors.New("stop")
+		err := ParseFileFast(context.Background(), path, msgDesc, func(result ParallelResult) error {
+			if result.Record == 10 {
+				return stop
+			}
+			return nil
+		})
+		if err != stop {
+			t.Errorf("expected the handler's error, got %v", err)
+		}
+	})
+}
+
+func TestParseFileFastCharset(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { record_length: 4 };
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];
+	`)
+	SetDefaults(&Config{Charset: &recordio.EBCDIC})
+	t.Cleanup(func() { SetDefaults(nil) })
+
+	path := filepath.Join(t.TempDir(), "records.ebc")
+	if err := os.WriteFile(path, []byte{0xc1, 0xc2, 0xc3, 0xc4, 0xe6, 0xe7, 0xe8, 0xe9}, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	codes := []string{}
+	err := ParseFileFast(context.Background(), path, msgDesc, func(result ParallelResult) error {
+		if result.Err != nil {
+			return result.Err
+		}
+		codes = append(codes, result.Message.ProtoReflect().Get(msgDesc.Fields().ByName("code")).String())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(codes, ",") != "ABCD,WXYZ" {
+		t.Errorf("got codes %v", codes)
+	}
+}
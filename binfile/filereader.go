@@ -0,0 +1,539 @@
+package binfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ValidateFunc checks a parsed message, returning an error when it is invalid.
+type ValidateFunc func(msg proto.Message) error
+
+// RecordError is returned by FileReader when a record fails to parse or
+// validate. It wraps the underlying cause.
+type RecordError struct {
+	// Record is the one based number of the record in the file.
+	Record int
+
+	// Offset is the byte offset of the start of the record in the file.
+	Offset int64
+
+	// Raw is a copy of the record, without the line ending.
+	Raw []byte
+
+	// Fields locates the fields involved in a validation failure, where the
+	// validator reports them.
+	Fields []*FieldError
+
+	Err error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("record %d (offset %d): %s", e.Record, e.Offset, e.Err)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// QuarantineFunc receives records which FileReader rejects. Returning an error
+// stops reading.
+type QuarantineFunc func(rejected *RecordError) error
+
+// FileReader reads newline delimited fixed width records, parsing each into a
+// message.
+type FileReader struct {
+	scanner    *bufio.Scanner
+	validate   ValidateFunc
+	quarantine QuarantineFunc
+	parseOpts  []ParseOption
+
+	record     int
+	offset     int64
+	nextOffset int64
+	warnings   []error
+
+	// newline is set when the last record read ended with a line ending,
+	// which the last record of a file may not.
+	newline bool
+
+	line        []byte
+	copyRecords bool
+	quarantined int
+
+	// maxRecord is the longest record accepted, 0 for the bufio.Scanner
+	// default, where a longer line stops reading. skipped counts the bytes
+	// of an oversized record discarded so far.
+	maxRecord int
+	skipped   int64
+
+	// keys maps the key of each record read to its record number, for
+	// messages which declare key_fields.
+	keys map[string]int
+
+	statsMu sync.Mutex
+	stats   ParseStats
+}
+
+// ParseStats counts the work done by a FileReader, for capacity planning and
+// feed quality dashboards.
+type ParseStats struct {
+	// Records counts every record read, including rejected records.
+	Records  int
+	Rejected int
+	Warnings int
+
+	// Bytes is the number of bytes consumed, including line endings.
+	Bytes int64
+
+	// FieldErrors counts errors and warnings by field, as Report.ByField
+	// does. Errors which are not about a single field are counted under "".
+	FieldErrors map[protoreflect.FullName]int
+
+	// Duration is the time spent in Next, including reading the underlying
+	// reader and any validation and quarantine.
+	Duration time.Duration
+}
+
+// DuplicateKeyError reports a record with the same key_fields values as an
+// earlier record in the file.
+type DuplicateKeyError struct {
+	Key    string
+	Record int
+	First  int
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("%s: record %d has key %s, first seen in record %d", ErrDuplicateKey, e.Record, e.Key, e.First)
+}
+
+func (e *DuplicateKeyError) Unwrap() error {
+	return ErrDuplicateKey
+}
+
+type FileReaderOption func(*FileReader)
+
+// WithValidator runs the validator on each parsed message.
+func WithValidator(validate ValidateFunc) FileReaderOption {
+	return func(fr *FileReader) {
+		fr.validate = validate
+	}
+}
+
+// WithProtoValidate runs buf protovalidate on each parsed message. Violations
+// of fixed width fields are located in the record on the RecordError.
+func WithProtoValidate(validator protovalidate.Validator) FileReaderOption {
+	return WithValidator(func(msg proto.Message) error {
+		return validator.Validate(msg)
+	})
+}
+
+// WithParseOptions parses each record with the options, as ParseMessage
+// does, e.g. to run a FieldHook on every field of the file.
+func WithParseOptions(opts ...ParseOption) FileReaderOption {
+	return func(fr *FileReader) {
+		fr.parseOpts = append(fr.parseOpts, opts...)
+	}
+}
+
+// WithQuarantine passes rejected records to quarantine and continues with the
+// next record, rather than returning the error from Next.
+func WithQuarantine(quarantine QuarantineFunc) FileReaderOption {
+	return func(fr *FileReader) {
+		fr.quarantine = quarantine
+	}
+}
+
+// BufferMode sets whether the record returned by FileReader.Raw is reused.
+type BufferMode int
+
+const (
+	// BufferReuse returns the reader's internal buffer from Raw, which is only
+	// valid until the next call to Next. This is the default.
+	BufferReuse BufferMode = iota
+
+	// BufferCopy copies each record into a new slice, so that Raw stays
+	// valid after later calls to Next.
+	BufferCopy
+)
+
+// WithBufferMode sets whether records are copied before being returned by
+// Raw.
+func WithBufferMode(mode BufferMode) FileReaderOption {
+	return func(fr *FileReader) {
+		fr.copyRecords = mode == BufferCopy
+	}
+}
+
+// WithMaxRecordSize allocates the reader's buffer for records of up to size
+// bytes once, rather than growing it as longer records are read. Longer
+// records are discarded without being buffered and returned as a
+// *RecordError wrapping ErrRecordTooLong, after which reading can continue
+// with the next record, so that a corrupt file without newlines does not
+// exhaust memory.
+func WithMaxRecordSize(size int) FileReaderOption {
+	return func(fr *FileReader) {
+		fr.maxRecord = size
+	}
+}
+
+type quarantineFieldJSON struct {
+	Field  string `json:"field,omitempty"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+	Error  string `json:"error"`
+}
+
+type quarantineJSON struct {
+	Record int                   `json:"record"`
+	Offset int64                 `json:"offset"`
+	Raw    string                `json:"raw"`
+	Error  string                `json:"error"`
+	Fields []quarantineFieldJSON `json:"fields,omitempty"`
+}
+
+// QuarantineWriter writes each rejected record to w as a line of JSON with
+// the raw record, its position and the errors.
+func QuarantineWriter(w io.Writer) QuarantineFunc {
+	enc := json.NewEncoder(w)
+	return func(rejected *RecordError) error {
+		entry := quarantineJSON{
+			Record: rejected.Record,
+			Offset: rejected.Offset,
+			Raw:    string(rejected.Raw),
+			Error:  rejected.Err.Error(),
+		}
+		fieldErrs := rejected.Fields
+		fieldErr := &FieldError{}
+		if len(fieldErrs) == 0 && errors.As(rejected.Err, &fieldErr) {
+			fieldErrs = []*FieldError{fieldErr}
+		}
+		for _, fieldErr := range fieldErrs {
+			entry.Fields = append(entry.Fields, quarantineFieldJSON{
+				Field:  string(fieldErr.Field),
+				Offset: fieldErr.Offset,
+				Length: fieldErr.Length,
+				Error:  fieldErr.Err.Error(),
+			})
+		}
+		return enc.Encode(entry)
+	}
+}
+
+func NewFileReader(r io.Reader, opts ...FileReaderOption) *FileReader {
+	fr := &FileReader{
+		scanner: bufio.NewScanner(r),
+		keys:    map[string]int{},
+	}
+	fr.scanner.Split(fr.scanLine)
+	for _, opt := range opts {
+		opt(fr)
+	}
+	if fr.maxRecord > 0 {
+		// Room for the line ending, so that the scanner never fills its
+		// buffer with a record which is not too long.
+		fr.scanner.Buffer(make([]byte, 0, fr.maxRecord+2), fr.maxRecord+2)
+		fr.scanner.Split(fr.scanBoundedRecords)
+	}
+	return fr
+}
+
+// Next parses the next record into msg, returning io.EOF after the last
+// record. Parse and validation failures are returned as a *RecordError, after
+// which reading can continue with the next record, unless a quarantine is
+// set, in which case rejected records are quarantined and skipped.
+func (fr *FileReader) Next(msg proto.Message) error {
+	start := time.Now()
+	defer func() {
+		fr.statsMu.Lock()
+		fr.stats.Duration += time.Since(start)
+		fr.statsMu.Unlock()
+	}()
+
+	for {
+		err := fr.next(msg)
+		recordErr := &RecordError{}
+		isRecordErr := errors.As(err, &recordErr)
+		if err == nil || isRecordErr {
+			fr.countRecord(recordErr, isRecordErr)
+		}
+		if fr.quarantine == nil || !isRecordErr {
+			return err
+		}
+		if err := fr.quarantine(recordErr); err != nil {
+			return fmt.Errorf("quarantine record %d: %w", recordErr.Record, err)
+		}
+		fr.quarantined++
+	}
+}
+
+func (fr *FileReader) countRecord(recordErr *RecordError, rejected bool) {
+	fr.statsMu.Lock()
+	defer fr.statsMu.Unlock()
+
+	if fr.stats.FieldErrors == nil {
+		fr.stats.FieldErrors = map[protoreflect.FullName]int{}
+	}
+	fr.stats.Records++
+	fr.stats.Bytes = fr.nextOffset
+	fr.stats.Warnings += len(fr.warnings)
+	for _, warning := range fr.warnings {
+		fr.stats.FieldErrors[errorField(warning)]++
+	}
+	if !rejected {
+		return
+	}
+	fr.stats.Rejected++
+	if len(recordErr.Fields) == 0 {
+		fr.stats.FieldErrors[errorField(recordErr)]++
+	}
+	for _, fieldErr := range recordErr.Fields {
+		fr.stats.FieldErrors[fieldErr.Field]++
+	}
+}
+
+// errorField is the field an error is about, or "" when it is not about a
+// single field.
+func errorField(err error) protoreflect.FullName {
+	fieldErr := &FieldError{}
+	if errors.As(err, &fieldErr) {
+		return fieldErr.Field
+	}
+	return ""
+}
+
+// Stats returns the counts so far. It is safe to call while another goroutine
+// is reading.
+func (fr *FileReader) Stats() ParseStats {
+	fr.statsMu.Lock()
+	defer fr.statsMu.Unlock()
+
+	stats := fr.stats
+	stats.FieldErrors = maps.Clone(fr.stats.FieldErrors)
+	return stats
+}
+
+// Quarantined returns the number of records passed to the quarantine.
+func (fr *FileReader) Quarantined() int {
+	return fr.quarantined
+}
+
+func (fr *FileReader) next(msg proto.Message) error {
+	if !fr.scanner.Scan() {
+		if err := fr.scanner.Err(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+
+	// Fields which this record leaves unset must not keep the values of the
+	// last record read into msg.
+	proto.Reset(msg)
+
+	line := fr.scanner.Bytes()
+	fr.record++
+	fr.offset = fr.nextOffset
+	fr.nextOffset += int64(len(line))
+	if fr.newline {
+		fr.nextOffset++
+	}
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	if fr.skipped > 0 || (fr.maxRecord > 0 && len(line) > fr.maxRecord) {
+		length := fr.skipped + int64(len(line))
+		fr.nextOffset += fr.skipped
+		fr.skipped = 0
+		fr.warnings = nil
+		fr.line = nil
+		return fr.recordError(fmt.Errorf("%w: at least %d bytes, the maximum is %d", ErrRecordTooLong, length, fr.maxRecord))
+	}
+	if fr.copyRecords {
+		line = bytes.Clone(line)
+	}
+	fr.warnings = nil
+	fr.line = line
+
+	fieldWarnings, err := ParseMessageWithWarnings(msg, line, fr.parseOpts...)
+	for _, warning := range fieldWarnings {
+		fr.warnings = append(fr.warnings, warning)
+	}
+	if err != nil {
+		return fr.recordError(err)
+	}
+
+	if fr.validate != nil {
+		if err := fr.validate(msg); err != nil {
+			recordErr := fr.recordError(err)
+			recordErr.Fields = locateViolations(msg.ProtoReflect().Descriptor(), line, err)
+			return recordErr
+		}
+	}
+
+	return fr.checkDuplicateKey(msg)
+}
+
+// Warnings returns the warnings of the last record read, such as fields with
+// on_error set to warning and duplicate keys.
+func (fr *FileReader) Warnings() []error {
+	return fr.warnings
+}
+
+func (fr *FileReader) checkDuplicateKey(msg proto.Message) error {
+	refl := msg.ProtoReflect()
+	ext, _ := proto.GetExtension(refl.Descriptor().Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	if len(ext.GetKeyFields()) == 0 {
+		return nil
+	}
+
+	key, err := recordKey(refl, ext.KeyFields)
+	if err != nil {
+		return fr.recordError(err)
+	}
+
+	first, ok := fr.keys[key]
+	if !ok {
+		fr.keys[key] = fr.record
+		return nil
+	}
+
+	dupErr := &DuplicateKeyError{
+		Key:    key,
+		Record: fr.record,
+		First:  first,
+	}
+	if ext.OnDuplicateKey == flatfile_pb.Severity_SEVERITY_WARNING {
+		fr.warnings = append(fr.warnings, dupErr)
+		return nil
+	}
+	return fr.recordError(dupErr)
+}
+
+// recordKey joins the values of the key fields into a printable key.
+func recordKey(refl protoreflect.Message, names []string) (string, error) {
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		fieldDesc := refl.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fieldDesc == nil {
+			return "", fmt.Errorf("key field %q not found", name)
+		}
+		val := refl.Get(fieldDesc)
+		if fieldDesc.Message() != nil {
+			raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(val.Message().Interface())
+			if err != nil {
+				return "", fmt.Errorf("key field %q: %w", name, err)
+			}
+			parts = append(parts, fmt.Sprintf("%x", raw))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%q", val.String()))
+	}
+	return strings.Join(parts, "/"), nil
+}
+
+// scanRecords splits on newlines like bufio.ScanLines, but keeps any carriage
+// return so that record offsets can be counted.
+func scanRecords(data []byte, atEOF bool) (int, []byte, error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// scanLine is scanRecords for FileReader, noting whether the record ended with
+// a line ending so that the bytes read are counted exactly.
+func (fr *FileReader) scanLine(data []byte, atEOF bool) (int, []byte, error) {
+	advance, token, err := scanRecords(data, atEOF)
+	if token != nil {
+		fr.newline = advance > len(token)
+	}
+	return advance, token, err
+}
+
+// Raw returns the last record read, without the line ending. Unless the
+// reader was created with BufferCopy, the slice is only valid until the next
+// call to Next.
+func (fr *FileReader) Raw() []byte {
+	return fr.line
+}
+
+// scanBoundedRecords is scanRecords for readers with a maximum record size.
+// When the buffer fills without a newline, the bytes are discarded and
+// counted in skipped, and the end of the record is returned as an empty
+// token for next to report.
+func (fr *FileReader) scanBoundedRecords(data []byte, atEOF bool) (int, []byte, error) {
+	if fr.skipped > 0 && atEOF && len(data) == 0 {
+		fr.newline = false
+		return 0, []byte{}, bufio.ErrFinalToken
+	}
+	advance, token, err := fr.scanLine(data, atEOF)
+	if advance > 0 || token != nil || err != nil {
+		return advance, token, err
+	}
+	if len(data) >= fr.maxRecord+2 {
+		fr.skipped += int64(len(data))
+		return len(data), nil, nil
+	}
+	return 0, nil, nil
+}
+
+// Record returns the one based number of the last record read.
+func (fr *FileReader) Record() int {
+	return fr.record
+}
+
+func (fr *FileReader) recordError(err error) *RecordError {
+	return &RecordError{
+		Record: fr.record,
+		Offset: fr.offset,
+		Raw:    bytes.Clone(fr.line),
+		Err:    err,
+	}
+}
+
+// locateViolations maps protovalidate violations on top level fixed width
+// fields to their position in the record.
+func locateViolations(desc protoreflect.MessageDescriptor, record []byte, err error) []*FieldError {
+	validationErr := &protovalidate.ValidationError{}
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	rr := NewReader(record, ext.GetOneBased())
+
+	fieldErrs := []*FieldError{}
+	for _, violation := range validationErr.Violations {
+		elements := violation.Proto.GetField().GetElements()
+		if len(elements) == 0 {
+			continue
+		}
+		fieldDesc := desc.Fields().ByNumber(protoreflect.FieldNumber(elements[0].GetFieldNumber()))
+		if fieldDesc == nil {
+			continue
+		}
+		tc := fieldOptions(fieldDesc)
+		if tc == nil || tc.FixedWidth == nil {
+			continue
+		}
+		cause := fmt.Errorf("%s: %s", violation.Proto.GetRuleId(), violation.Proto.GetMessage())
+		fieldErrs = append(fieldErrs, rr.fieldError(fieldDesc, tc, cause))
+	}
+	return fieldErrs
+}
@@ -0,0 +1,336 @@
+package binfile
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"buf.build/go/protovalidate"
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestFileReader(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = {
+		fixed_width: { offset: 0, length: 2 }
+	  }];
+	  int32 count = 2 [(flatfile.v1.field) = {
+		fixed_width: { offset: 2, length: 3 }
+		number: {}
+	  }];
+	`)
+
+	fr := NewFileReader(strings.NewReader("AB001\r\nCDxx2\nEF003\n"))
+
+	record := dynamicpb.NewMessage(msgDesc)
+	if err := fr.Next(record); err != nil {
+		t.Fatalf("record 1: %v", err)
+	}
+	if got := record.Get(msgDesc.Fields().ByName("count")).Int(); got != 1 {
+		t.Errorf("record 1: expected count 1, got %d", got)
+	}
+
+	err := fr.Next(dynamicpb.NewMessage(msgDesc))
+	recordErr := &RecordError{}
+	if !errors.As(err, &recordErr) {
+		t.Fatalf("record 2: expected RecordError, got %v", err)
+	}
+	if recordErr.Record != 2 || recordErr.Offset != 7 {
+		t.Errorf("record 2: expected record 2 at offset 7, got %d at %d", recordErr.Record, recordErr.Offset)
+	}
+	fieldErr := &FieldError{}
+	if !errors.As(err, &fieldErr) || fieldErr.Field.Name() != "count" {
+		t.Errorf("record 2: expected FieldError for count, got %v", err)
+	}
+
+	if err := fr.Next(dynamicpb.NewMessage(msgDesc)); err != nil {
+		t.Fatalf("record 3: %v", err)
+	}
+	if err := fr.Next(dynamicpb.NewMessage(msgDesc)); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestFileReaderReuse(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = {
+		fixed_width: { offset: 0, length: 2 }
+		string: { trim: TRIM_RIGHT }
+	  }];
+	  int32 count = 2 [(flatfile.v1.field) = {
+		fixed_width: { offset: 2, length: 3 }
+		number: {}
+	  }];
+	`)
+
+	fr := NewFileReader(strings.NewReader("AB001\n     \n"))
+	record := dynamicpb.NewMessage(msgDesc)
+	if err := fr.Next(record); err != nil {
+		t.Fatalf("record 1: %v", err)
+	}
+	if err := fr.Next(record); err != nil {
+		t.Fatalf("record 2: %v", err)
+	}
+	// Both fields of the blank record are unset, rather than kept from the
+	// record before.
+	if fields := msgDesc.Fields(); record.Has(fields.ByName("code")) || record.Has(fields.ByName("count")) {
+		t.Errorf("record 2: expected no fields set, got %v", record)
+	}
+}
+
+func TestFileReaderProtoValidate(t *testing.T) {
+	msgDesc := singleMessage(t,
+		prototest.WithMessageImports("buf/validate/validate.proto"),
+		`
+	  string code = 1 [
+		(buf.validate.field).string.in = "AB",
+		(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }
+	  ];
+	  int32 count = 2 [
+		(buf.validate.field).int32.lt = 10,
+		(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }
+	  ];
+	`)
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fr := NewFileReader(strings.NewReader("AB001\nAB012\n"), WithProtoValidate(validator))
+
+	if err := fr.Next(dynamicpb.NewMessage(msgDesc)); err != nil {
+		t.Fatalf("record 1: %v", err)
+	}
+
+	err = fr.Next(dynamicpb.NewMessage(msgDesc))
+	recordErr := &RecordError{}
+	if !errors.As(err, &recordErr) {
+		t.Fatalf("record 2: expected RecordError, got %v", err)
+	}
+	validationErr := &protovalidate.ValidationError{}
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("record 2: expected ValidationError, got %v", err)
+	}
+	if len(recordErr.Fields) != 1 {
+		t.Fatalf("record 2: expected 1 located field, got %v", recordErr.Fields)
+	}
+	located := recordErr.Fields[0]
+	if located.Field.Name() != "count" || located.Offset != 2 || string(located.Raw) != "012" {
+		t.Errorf("record 2: expected count at offset 2, got %v", located)
+	}
+}
+
+func TestFileReaderDuplicateKeys(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { key_fields: ["account", "seq"] };
+	  string account = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 3 } }];
+	  int32 seq = 2 [(flatfile.v1.field) = { fixed_width: { offset: 3, length: 2 }, number: {} }];
+	`)
+
+	fr := NewFileReader(strings.NewReader("AAA01\nAAA02\nBBB01\nAAA01\n"))
+	for range 3 {
+		if err := fr.Next(dynamicpb.NewMessage(msgDesc)); err != nil {
+			t.Fatalf("record %d: %v", fr.Record(), err)
+		}
+	}
+
+	err := fr.Next(dynamicpb.NewMessage(msgDesc))
+	dupErr := &DuplicateKeyError{}
+	if !errors.As(err, &dupErr) {
+		t.Fatalf("expected DuplicateKeyError, got %v", err)
+	}
+	if dupErr.Record != 4 || dupErr.First != 1 {
+		t.Errorf("expected record 4 to duplicate record 1, got %d and %d", dupErr.Record, dupErr.First)
+	}
+
+	msgDesc = singleMessage(t, `
+	  option (flatfile.v1.message) = { key_fields: ["account"], on_duplicate_key: SEVERITY_WARNING };
+	  string account = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 3 } }];
+	`)
+
+	fr = NewFileReader(strings.NewReader("AAA\nAAA\n"))
+	for range 2 {
+		if err := fr.Next(dynamicpb.NewMessage(msgDesc)); err != nil {
+			t.Fatalf("record %d: %v", fr.Record(), err)
+		}
+	}
+	warnings := fr.Warnings()
+	if len(warnings) != 1 || !errors.Is(warnings[0], ErrDuplicateKey) {
+		t.Errorf("expected a duplicate key warning, got %v", warnings)
+	}
+}
+
+func TestFileReaderQuarantine(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	`)
+
+	quarantine := &strings.Builder{}
+	fr := NewFileReader(strings.NewReader("AB001\nCDxx2\nEF003\n"), WithQuarantine(QuarantineWriter(quarantine)))
+
+	codes := []string{}
+	for {
+		record := dynamicpb.NewMessage(msgDesc)
+		err := fr.Next(record)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		codes = append(codes, record.Get(msgDesc.Fields().ByName("code")).String())
+	}
+
+	if strings.Join(codes, ",") != "AB,EF" {
+		t.Errorf("expected AB and EF, got %v", codes)
+	}
+	if fr.Quarantined() != 1 {
+		t.Errorf("expected 1 quarantined record, got %d", fr.Quarantined())
+	}
+
+	got := quarantine.String()
+	for _, want := range []string{`"record":2`, `"offset":6`, `"raw":"CDxx2"`, `"offset":2,"length":3`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected quarantine to contain %s, got %s", want, got)
+		}
+	}
+}
+
+func TestFileReaderBufferCopy(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = {
+		fixed_width: { offset: 0, length: 2 }
+	  }];
+	`)
+
+	// OneByteReader makes the scanner move data within its buffer, which
+	// would overwrite records kept from earlier calls without BufferCopy.
+	input := iotest.OneByteReader(strings.NewReader(strings.Repeat("AB\nCD\nEF\n", 2000)))
+	fr := NewFileReader(input, WithBufferMode(BufferCopy))
+
+	var records [][]byte
+	for {
+		err := fr.Next(dynamicpb.NewMessage(msgDesc))
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, fr.Raw())
+	}
+
+	if len(records) != 6000 {
+		t.Fatalf("expected 6000 records, got %d", len(records))
+	}
+	for idx, record := range records {
+		want := []string{"AB", "CD", "EF"}[idx%3]
+		if string(record) != want {
+			t.Fatalf("record %d: expected %q, got %q", idx+1, want, record)
+		}
+	}
+}
+
+func TestFileReaderMaxRecordSize(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = {
+		fixed_width: { offset: 0, length: 2 }
+	  }];
+	`)
+
+	long := strings.Repeat("X", 100)
+	input := iotest.OneByteReader(strings.NewReader("AB\r\n" + long + "\nCDEFGH\nIJ\n" + long))
+	fr := NewFileReader(input, WithMaxRecordSize(6))
+
+	for _, want := range []struct {
+		code    string
+		tooLong bool
+		offset  int64
+	}{
+		{code: "AB"},
+		{tooLong: true, offset: 4},
+		{code: "CD"},
+		{code: "IJ"},
+		{tooLong: true, offset: 115},
+	} {
+		msg := dynamicpb.NewMessage(msgDesc)
+		err := fr.Next(msg)
+		if want.tooLong {
+			recordErr := &RecordError{}
+			if !errors.As(err, &recordErr) || !errors.Is(err, ErrRecordTooLong) {
+				t.Fatalf("record %d: expected ErrRecordTooLong, got %v", fr.Record(), err)
+			}
+			if recordErr.Offset != want.offset {
+				t.Errorf("record %d: expected offset %d, got %d", fr.Record(), want.offset, recordErr.Offset)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("record %d: %v", fr.Record(), err)
+		}
+		if got := msg.Get(msgDesc.Fields().ByName("code")).String(); got != want.code {
+			t.Errorf("record %d: expected %q, got %q", fr.Record(), want.code, got)
+		}
+	}
+
+	if err := fr.Next(dynamicpb.NewMessage(msgDesc)); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestFileReaderStats(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = {
+		fixed_width: { offset: 0, length: 2 }
+	  }];
+	  int32 count = 2 [(flatfile.v1.field) = {
+		fixed_width: { offset: 2, length: 3 }
+		number: {}
+	  }];
+	  int32 extra = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 5, length: 1 }
+		number: {}
+		on_error: SEVERITY_WARNING
+	  }];
+	`)
+
+	fr := NewFileReader(strings.NewReader("AB0010\nCDxx20\nEF003x\nGH\n"), WithQuarantine(func(*RecordError) error {
+		return nil
+	}))
+	for {
+		err := fr.Next(dynamicpb.NewMessage(msgDesc))
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats := fr.Stats()
+	if stats.Records != 4 || stats.Rejected != 2 || stats.Warnings != 1 || stats.Bytes != 24 {
+		t.Errorf("expected 4 records, 2 rejected, 1 warning and 24 bytes, got %+v", stats)
+	}
+	// A last record without a line ending is counted without one.
+	fr = NewFileReader(strings.NewReader("AB0010\nCD0020"))
+	for fr.Next(dynamicpb.NewMessage(msgDesc)) == nil {
+	}
+	if got := fr.Stats().Bytes; got != 13 {
+		t.Errorf("expected 13 bytes without a final line ending, got %d", got)
+	}
+
+	if stats.Duration <= 0 {
+		t.Errorf("expected a duration, got %v", stats.Duration)
+	}
+
+	count := msgDesc.Fields().ByName("count").FullName()
+	extra := msgDesc.Fields().ByName("extra").FullName()
+	if len(stats.FieldErrors) != 2 || stats.FieldErrors[count] != 2 || stats.FieldErrors[extra] != 1 {
+		t.Errorf("unexpected field errors %v", stats.FieldErrors)
+	}
+}
@@ -0,0 +1,90 @@
+package binfile
+
+import (
+	"bufio"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// FileWriter writes messages as newline delimited fixed width records, the
+// reverse of FileReader. Each record is formatted into one scratch buffer
+// which is reused for the next, and records are flushed to the underlying
+// writer in chunks, so writing a file does not allocate per record.
+//
+// Flush must be called after the last record.
+type FileWriter struct {
+	w          *bufio.Writer
+	scratch    []byte
+	lineEnding string
+	flushSize  int
+
+	record int
+	offset int64
+}
+
+type FileWriterOption func(*FileWriter)
+
+// WithFlushSize sets the size of the chunks written to the underlying writer,
+// 64KiB by default.
+func WithFlushSize(size int) FileWriterOption {
+	return func(fw *FileWriter) {
+		fw.flushSize = size
+	}
+}
+
+// WithLineEnding sets the bytes written after each record, "\n" by default.
+func WithLineEnding(ending string) FileWriterOption {
+	return func(fw *FileWriter) {
+		fw.lineEnding = ending
+	}
+}
+
+const defaultFlushSize = 64 * 1024
+
+func NewFileWriter(w io.Writer, opts ...FileWriterOption) *FileWriter {
+	fw := &FileWriter{
+		lineEnding: "\n",
+		flushSize:  defaultFlushSize,
+	}
+	for _, opt := range opts {
+		opt(fw)
+	}
+	fw.w = bufio.NewWriterSize(w, fw.flushSize)
+	return fw
+}
+
+// Write formats msg and appends it to the file. Formatting failures are
+// returned as a *RecordError, after which writing can continue with the next
+// record.
+func (fw *FileWriter) Write(msg proto.Message) error {
+	parser, err := cachedParser(msg.ProtoReflect().Descriptor())
+	if err != nil {
+		return err
+	}
+
+	fw.record++
+	fw.scratch, err = parser.AppendRecord(fw.scratch[:0], msg)
+	if err != nil {
+		return &RecordError{
+			Record: fw.record,
+			Offset: fw.offset,
+			Err:    err,
+		}
+	}
+	fw.scratch = append(fw.scratch, fw.lineEnding...)
+
+	n, err := fw.w.Write(fw.scratch)
+	fw.offset += int64(n)
+	return err
+}
+
+// Flush writes any buffered records to the underlying writer.
+func (fw *FileWriter) Flush() error {
+	return fw.w.Flush()
+}
+
+// Record returns the one based number of the last record written.
+func (fw *FileWriter) Record() int {
+	return fw.record
+}
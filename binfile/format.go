@@ -0,0 +1,500 @@
+package binfile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/flatfile/recordio"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldWriteFunc formats val into dst, which is the field's span of the
+// record, already filled with spaces.
+type fieldWriteFunc func(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error
+
+// MarshalRecord formats msg as a fixed width record, the reverse of
+// ParseMessage.
+func MarshalRecord(msg proto.Message) ([]byte, error) {
+	parser, err := cachedParser(msg.ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, err
+	}
+	return parser.AppendRecord(nil, msg)
+}
+
+// AppendRecord formats msg as a fixed width record appended to buf, so that
+// callers writing many records can reuse one buffer.
+//
+// Bytes not covered by a field are spaces. Unset fields are left blank, other
+// than bools, which are written as the first of the false values. Checksum
+// fields are calculated from the formatted record. Raw and trailing fields
+// are not written.
+func (p *MessageParser) AppendRecord(buf []byte, msg proto.Message) ([]byte, error) {
+	refl := msg.ProtoReflect()
+	if refl.Descriptor().FullName() != p.desc.FullName() {
+		return buf, fmt.Errorf("parser for %s cannot format %s", p.desc.FullName(), refl.Descriptor().FullName())
+	}
+
+	start := len(buf)
+	for range p.width {
+		buf = append(buf, ' ')
+	}
+	record := buf[start:]
+	oneBased := p.ext.GetOneBased()
+
+	for _, field := range p.fields {
+		if field.tc.Checksum != nil {
+			continue
+		}
+		if !refl.Has(field.desc) && field.desc.Kind() != protoreflect.BoolKind {
+			continue
+		}
+		offset, length := zeroBased(field.tc.FixedWidth, oneBased)
+		if err := field.write(record[offset:offset+length], field.tc, refl.Get(field.desc)); err != nil {
+			return buf[:start], formatError(field, offset, length, err)
+		}
+	}
+
+	// Checksums are written last so that they cover the other fields.
+	if err := p.WriteChecksums(record); err != nil {
+		return buf[:start], err
+	}
+
+	return buf, nil
+}
+
+// WriteField formats the named field of msg over its span of an existing
+// record, leaving the other bytes of the record as they are. The span is
+// blanked for unset fields. Checksums are not recalculated, see
+// WriteChecksums.
+func (p *MessageParser) WriteField(record []byte, msg proto.Message, name protoreflect.Name) error {
+	idx, ok := p.index[name]
+	if !ok {
+		return fmt.Errorf("field %s is not a flatfile field of %s", name, p.desc.FullName())
+	}
+	field := p.fields[idx]
+	offset, length := zeroBased(field.tc.FixedWidth, p.ext.GetOneBased())
+	if offset+length > len(record) {
+		return formatError(field, offset, length, ErrShortRecord)
+	}
+
+	// The field is formatted aside, so that the record is unchanged when it
+	// can't be.
+	formatted := bytes.Repeat([]byte{' '}, length)
+	refl := msg.ProtoReflect()
+	if refl.Has(field.desc) || field.desc.Kind() == protoreflect.BoolKind {
+		if err := field.write(formatted, field.tc, refl.Get(field.desc)); err != nil {
+			return formatError(field, offset, length, err)
+		}
+	}
+	copy(record[offset:], formatted)
+	return nil
+}
+
+// WriteChecksums calculates the checksum fields of the record in place, for
+// records changed since their checksums were written.
+func (p *MessageParser) WriteChecksums(record []byte) error {
+	oneBased := p.ext.GetOneBased()
+	for _, field := range p.fields {
+		if field.tc.Checksum == nil {
+			continue
+		}
+		offset, length := zeroBased(field.tc.FixedWidth, oneBased)
+		if offset+length > len(record) {
+			return formatError(field, offset, length, ErrShortRecord)
+		}
+		if err := writeChecksum(record, record[offset:offset+length], field.tc, oneBased); err != nil {
+			return formatError(field, offset, length, err)
+		}
+	}
+	return nil
+}
+
+// ZeroFill formats zero into the number fields of msg which AppendRecord left
+// blank as unset, for formats which require every number to be written,
+// including those which are zero.
+func ZeroFill(record []byte, msg proto.Message) error {
+	parser, err := cachedParser(msg.ProtoReflect().Descriptor())
+	if err != nil {
+		return err
+	}
+	return parser.ZeroFill(record, msg)
+}
+
+// ZeroFill formats zero into the number fields of msg which AppendRecord left
+// blank as unset, as the package level ZeroFill does.
+func (p *MessageParser) ZeroFill(record []byte, msg proto.Message) error {
+	refl := msg.ProtoReflect()
+	if refl.Descriptor().FullName() != p.desc.FullName() {
+		return fmt.Errorf("parser for %s cannot format %s", p.desc.FullName(), refl.Descriptor().FullName())
+	}
+	for _, field := range p.fields {
+		if field.tc.GetNumber() == nil || field.tc.Checksum != nil || refl.Has(field.desc) {
+			continue
+		}
+		offset, length := zeroBased(field.tc.FixedWidth, p.ext.GetOneBased())
+		if offset+length > len(record) {
+			return formatError(field, offset, length, ErrShortRecord)
+		}
+		dst := record[offset : offset+length]
+		var err error
+		if numberFormat(field.tc) == flatfile_pb.Encoding_ENCODING_BINARY {
+			err = writeBinary(dst, 0)
+		} else {
+			err = writeDigits(dst, field.tc, []byte("0"), false)
+		}
+		if err != nil {
+			return formatError(field, offset, length, err)
+		}
+	}
+	return nil
+}
+
+// EncodeRecord encodes a record formatted by AppendRecord into the charset in
+// place, leaving the bytes of its packed decimal and binary fields as they
+// are, the reverse of parsing it with WithCharset.
+func EncodeRecord(record []byte, desc protoreflect.MessageDescriptor, charset recordio.Charset) error {
+	parser, err := cachedParser(desc)
+	if err != nil {
+		return err
+	}
+	parser.EncodeRecord(record, charset)
+	return nil
+}
+
+// EncodeRecord encodes the record into the charset in place, as the package
+// level EncodeRecord does.
+func (p *MessageParser) EncodeRecord(record []byte, charset recordio.Charset) {
+	formatted := bytes.Clone(record)
+	charset.Encode(record)
+	p.copyBinary(record, formatted)
+}
+
+func formatError(field *compiledField, offset, length int, err error) *FieldError {
+	return &FieldError{
+		Field:  field.desc.FullName(),
+		Offset: offset,
+		Length: length,
+		Err:    err,
+	}
+}
+
+// zeroBased returns the zero based offset and the length of the range.
+func zeroBased(fw *flatfile_pb.FixedWidth, oneBased bool) (int, int) {
+	offset := int(fw.Offset)
+	if oneBased {
+		offset--
+	}
+	return offset, int(fw.Length)
+}
+
+// recordWidth is the length of the records AppendRecord writes: the end of the
+// last field or filler, or the record_length where records must be at least
+// that long.
+func recordWidth(ext *flatfile_pb.Message, fields []*compiledField) int {
+	width := 0
+	for _, field := range fields {
+		offset, length := zeroBased(field.tc.FixedWidth, ext.GetOneBased())
+		width = max(width, offset+length)
+	}
+	for _, filler := range ext.GetFiller() {
+		offset, length := zeroBased(filler, ext.GetOneBased())
+		width = max(width, offset+length)
+	}
+	switch ext.GetRecordLengthMode() {
+	case flatfile_pb.LengthMode_LENGTH_MODE_UNSPECIFIED, flatfile_pb.LengthMode_LENGTH_MODE_EXACT, flatfile_pb.LengthMode_LENGTH_MODE_MINIMUM:
+		width = max(width, int(ext.GetRecordLength()))
+	}
+	return width
+}
+
+func fieldWriter(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) (fieldWriteFunc, error) {
+	if codec, err := customCodec(tc); codec != nil || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		return codecWriter(fieldDesc, codec), nil
+	}
+
+	switch fieldDesc.Kind() {
+	case protoreflect.MessageKind:
+		switch fieldDesc.Message().FullName() {
+		case "google.protobuf.StringValue":
+			return wrappedWriter(writeString), nil
+		case "google.protobuf.BoolValue":
+			return wrappedWriter(writeBool), nil
+		case "j5.types.decimal.v1.Decimal":
+			return writeDecimal, nil
+		case "j5.types.date.v1.Date":
+			return dateWriter(tc), nil
+		default:
+			if codec, ok := lookupMessageCodec(fieldDesc.Message().FullName()); ok {
+				return codecWriter(fieldDesc, codec), nil
+			}
+			return nil, fmt.Errorf("unknown struct type %s", fieldDesc.Message().FullName())
+		}
+
+	case protoreflect.StringKind:
+		return writeString, nil
+
+	case protoreflect.BoolKind:
+		return writeBool, nil
+
+	case protoreflect.EnumKind:
+		enum := fieldDesc.Enum()
+		return func(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+			return writeEnum(dst, enum, val)
+		}, nil
+
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return func(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+			return writeInteger(dst, tc, val.Uint(), false)
+		}, nil
+
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return func(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+			n := val.Int()
+			if n < 0 {
+				return writeInteger(dst, tc, uint64(-(n+1))+1, true)
+			}
+			return writeInteger(dst, tc, uint64(n), false)
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown type/kind: %s", fieldDesc.Kind())
+	}
+}
+
+// wrappedWriter writes the value field of a wrapper message.
+func wrappedWriter(write fieldWriteFunc) fieldWriteFunc {
+	return func(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+		msg := val.Message()
+		return write(dst, tc, msg.Get(msg.Descriptor().Fields().ByName("value")))
+	}
+}
+
+func writeString(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+	str := val.String()
+	if len(str) > len(dst) {
+		return fmt.Errorf("%w: %q is longer than %d bytes", ErrInvalidString, str, len(dst))
+	}
+	copy(dst, str)
+	return nil
+}
+
+func writeBool(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+	boolField := tc.GetBool()
+	if boolField == nil {
+		boolField = defaultBoolField
+	}
+	values := boolField.FalseValues
+	if val.Bool() {
+		values = boolField.TrueValues
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("no values to write %v", val.Bool())
+	}
+	if len(values[0]) > len(dst) {
+		return fmt.Errorf("bool value %q is longer than %d bytes", values[0], len(dst))
+	}
+	copy(dst, values[0])
+	return nil
+}
+
+func writeEnum(dst []byte, enum protoreflect.EnumDescriptor, val protoreflect.Value) error {
+	valueDesc := enum.Values().ByNumber(val.Enum())
+	if valueDesc == nil {
+		return fmt.Errorf("%w: %d is not a value of %s", ErrInvalidEnum, val.Enum(), enum.FullName())
+	}
+	ext, _ := proto.GetExtension(valueDesc.Options(), flatfile_pb.E_Enum).(*flatfile_pb.Enum)
+	if ext == nil {
+		if val.Enum() == 0 {
+			return nil
+		}
+		return fmt.Errorf("%w: %s has no key", ErrInvalidEnum, valueDesc.Name())
+	}
+	key := strings.TrimSpace(ext.Key)
+	if len(key) > len(dst) {
+		return fmt.Errorf("%w: key %q is longer than %d bytes", ErrInvalidEnum, key, len(dst))
+	}
+	copy(dst, key)
+	return nil
+}
+
+// writeInteger writes the magnitude of an integer in the field's encoding.
+func writeInteger(dst []byte, tc *flatfile_pb.Field, magnitude uint64, negative bool) error {
+	if numberFormat(tc) == flatfile_pb.Encoding_ENCODING_BINARY {
+		if negative {
+			return fmt.Errorf("%w: binary fields are unsigned", ErrOverflow)
+		}
+		return writeBinary(dst, magnitude)
+	}
+	var digits [20]byte
+	return writeDigits(dst, tc, strconv.AppendUint(digits[:0], magnitude, 10), negative)
+}
+
+func writeBinary(dst []byte, val uint64) error {
+	var full [8]byte
+	binary.BigEndian.PutUint64(full[:], val)
+	if len(dst) < 8 {
+		for _, b := range full[:8-len(dst)] {
+			if b != 0 {
+				return fmt.Errorf("%w: %d does not fit in %d bytes", ErrOverflow, val, len(dst))
+			}
+		}
+		copy(dst, full[8-len(dst):])
+		return nil
+	}
+	clear(dst)
+	copy(dst[len(dst)-8:], full[:])
+	return nil
+}
+
+func writeDecimal(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+	msg := val.Message()
+	str := msg.Get(msg.Descriptor().Fields().ByName("value")).String()
+	if scale := tc.GetNumber().GetFixedScale(); scale != 0 && str != "" {
+		// The decimal point is implied, so 123.45 with scale 2 is written as
+		// 12345.
+		dec, err := decimal.NewFromString(str)
+		if err != nil {
+			return fmt.Errorf("%w: invalid decimal value %q", ErrInvalidNumber, str)
+		}
+		unscaled := dec.Shift(scale)
+		if !unscaled.IsInteger() {
+			return fmt.Errorf("%w: %q has more than %d decimal places", ErrInvalidNumber, str, scale)
+		}
+		str = unscaled.String()
+	}
+	negative := strings.HasPrefix(str, "-")
+	digits := strings.TrimLeft(str, "+-")
+	if digits == "" {
+		return nil
+	}
+	if numberFormat(tc) != flatfile_pb.Encoding_ENCODING_UNSPECIFIED && strings.Contains(digits, ".") {
+		return fmt.Errorf("%w: %q has a decimal point, which %s cannot hold", ErrInvalidNumber, str, numberFormat(tc))
+	}
+	return writeDigits(dst, tc, []byte(digits), negative)
+}
+
+// writeDigits writes a number given as its digits and sign, right aligned and
+// zero padded for display numerics.
+func writeDigits(dst []byte, tc *flatfile_pb.Field, digits []byte, negative bool) error {
+	switch numberFormat(tc) {
+	case flatfile_pb.Encoding_ENCODING_UNSPECIFIED:
+		width := len(digits)
+		if negative {
+			width++
+		}
+		if width > len(dst) {
+			return fmt.Errorf("%w: %s does not fit in %d bytes", ErrOverflow, string(digits), len(dst))
+		}
+		for idx := range dst {
+			dst[idx] = '0'
+		}
+		if negative {
+			dst[0] = '-'
+		}
+		copy(dst[len(dst)-len(digits):], digits)
+		return nil
+
+	case flatfile_pb.Encoding_ENCODING_OVERPUNCH:
+		if len(digits) > len(dst) {
+			return fmt.Errorf("%w: %s does not fit in %d bytes", ErrOverflow, string(digits), len(dst))
+		}
+		for idx := range dst {
+			dst[idx] = '0'
+		}
+		copy(dst[len(dst)-len(digits):], digits)
+		last := int(dst[len(dst)-1] - '0')
+		if negative {
+			last += 10
+		}
+		dst[len(dst)-1] = overpunchVals[last]
+		return nil
+
+	case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL:
+		// Two digits per byte, with the sign in the last nibble.
+		if len(digits) > len(dst)*2-1 {
+			return fmt.Errorf("%w: %s does not fit in %d packed bytes", ErrOverflow, string(digits), len(dst))
+		}
+		clear(dst)
+		sign := byte(0x0C)
+		if negative {
+			sign = 0x0D
+		}
+		nibble := len(dst)*2 - 1
+		dst[len(dst)-1] = sign
+		for idx := len(digits) - 1; idx >= 0; idx-- {
+			nibble--
+			digit := digits[idx] - '0'
+			if nibble%2 == 0 {
+				dst[nibble/2] |= digit << 4
+			} else {
+				dst[nibble/2] |= digit
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown number encoding %d", numberFormat(tc))
+	}
+}
+
+func dateWriter(tc *flatfile_pb.Field) fieldWriteFunc {
+	layout, err := newDateLayout(tc.GetDate())
+	if err != nil {
+		return func([]byte, *flatfile_pb.Field, protoreflect.Value) error {
+			return err
+		}
+	}
+	return func(dst []byte, tc *flatfile_pb.Field, val protoreflect.Value) error {
+		msg := val.Message()
+		fields := msg.Descriptor().Fields()
+		date := time.Date(
+			int(msg.Get(fields.ByName("year")).Int()),
+			time.Month(msg.Get(fields.ByName("month")).Int()),
+			int(msg.Get(fields.ByName("day")).Int()),
+			0, 0, 0, 0, time.UTC)
+		var scratch [32]byte
+		formatted := date.AppendFormat(scratch[:0], layout.layout)
+		if len(formatted) > len(dst) {
+			return fmt.Errorf("%w: %s is longer than %d bytes", ErrInvalidDate, string(formatted), len(dst))
+		}
+		copy(dst, formatted)
+		return nil
+	}
+}
+
+func writeChecksum(record, dst []byte, tc *flatfile_pb.Field, oneBased bool) error {
+	cs := tc.Checksum
+	if cs.Range == nil {
+		return fmt.Errorf("checksum has no range")
+	}
+	offset, length := zeroBased(cs.Range, oneBased)
+	if offset < 0 || offset+length > len(record) {
+		return fmt.Errorf("%w: range %d-%d is outside the record", ErrChecksum, offset, offset+length)
+	}
+	sum, err := ComputeChecksum(cs, record[offset:offset+length])
+	if err != nil {
+		return err
+	}
+	if numberFormat(tc) == flatfile_pb.Encoding_ENCODING_BINARY {
+		return writeBinary(dst, sum)
+	}
+	var digits [20]byte
+	formatted := digits[:0]
+	if cs.Hex {
+		formatted = strconv.AppendUint(formatted, sum, 16)
+	} else {
+		formatted = strconv.AppendUint(formatted, sum, 10)
+	}
+	return writeDigits(dst, nil, formatted, false)
+}
@@ -0,0 +1,315 @@
+package binfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/pentops/flatfile/recordio"
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestMarshalRecord(t *testing.T) {
+	msgDesc := singleMessage(t,
+		prototest.WithMessageImports("j5/types/date/v1/date.proto", "j5/types/decimal/v1/decimal.proto"),
+		`
+	  option (flatfile.v1.message) = { record_length: 50, filler: [{ offset: 48, length: 2 }] };
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 6 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 6, length: 5 }, number: {} }];
+	  int64 packed = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 11, length: 3 }
+		number: { encoding: ENCODING_PACKED_DECIMAL }
+	  }];
+	  int64 overpunch = 4 [(flatfile.v1.field) = {
+		fixed_width: { offset: 14, length: 4 }
+		number: { encoding: ENCODING_OVERPUNCH }
+	  }];
+	  j5.types.decimal.v1.Decimal amount = 5 [(flatfile.v1.field) = {
+		fixed_width: { offset: 18, length: 8 }
+		number: {}
+	  }];
+	  j5.types.date.v1.Date due = 6 [(flatfile.v1.field) = {
+		fixed_width: { offset: 26, length: 8 }
+		date: { format: "YYYYMMDD" }
+	  }];
+	  bool active = 7 [(flatfile.v1.field) = { fixed_width: { offset: 34, length: 1 } }];
+	  uint32 unset = 8 [(flatfile.v1.field) = { fixed_width: { offset: 35, length: 3 }, number: {} }];
+	  string sum = 9 [(flatfile.v1.field) = {
+		fixed_width: { offset: 38, length: 10 }
+		checksum: { algorithm: CHECKSUM_ALGORITHM_CRC32, range: { offset: 0, length: 38 } }
+	  }];
+	`)
+
+	record := []byte("ABC   -0012" + "\x12\x34\x5D" + "012J" + "00012.75" + "20240131" + "T" + "   " + "0000000000" + "  ")
+	sum, err := ComputeChecksum(fieldOptions(msgDesc.Fields().ByName("sum")).Checksum, record[:38])
+	if err != nil {
+		t.Fatal(err)
+	}
+	copy(record[38:48], fmt.Sprintf("%010d", sum))
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, record); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := MarshalRecord(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, record) {
+		t.Fatalf("expected\n%q, got\n%q", record, got)
+	}
+
+	reparsed := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(reparsed, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(msg, reparsed) {
+		t.Errorf("expected %v, got %v", msg, reparsed)
+	}
+
+	// AppendRecord leaves what is already in the buffer.
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appended, err := parser.AppendRecord([]byte("prefix"), msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(appended) != "prefix"+string(record) {
+		t.Errorf("expected the record after the prefix, got %q", appended)
+	}
+
+	msg.Set(msgDesc.Fields().ByName("name"), protoreflect.ValueOfString("TOO LONG"))
+	_, err = MarshalRecord(msg)
+	fieldErr := &FieldError{}
+	if !errors.As(err, &fieldErr) || fieldErr.Field.Name() != "name" || !errors.Is(err, ErrInvalidString) {
+		t.Errorf("expected FieldError for name, got %v", err)
+	}
+}
+
+func TestMarshalRecordFixedScale(t *testing.T) {
+	msgDesc := singleMessage(t,
+		prototest.WithMessageImports("j5/types/decimal/v1/decimal.proto"),
+		`
+	  j5.types.decimal.v1.Decimal amount = 1 [(flatfile.v1.field) = {
+		fixed_width: { offset: 0, length: 6 }
+		number: { fixed_scale: 2 }
+	  }];
+	  j5.types.decimal.v1.Decimal packed = 2 [(flatfile.v1.field) = {
+		fixed_width: { offset: 6, length: 3 }
+		number: { encoding: ENCODING_PACKED_DECIMAL, fixed_scale: 2 }
+	  }];
+	`)
+
+	record := []byte("001234" + "\x01\x23\x4D")
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, record); err != nil {
+		t.Fatal(err)
+	}
+	got, err := MarshalRecord(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, record) {
+		t.Fatalf("expected\n%q, got\n%q", record, got)
+	}
+
+	// The scale leaves no room for further decimal places.
+	amount := msg.Get(msgDesc.Fields().ByName("amount")).Message()
+	amount.Set(amount.Descriptor().Fields().ByName("value"), protoreflect.ValueOfString("12.345"))
+	if _, err := MarshalRecord(msg); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("expected ErrInvalidNumber, got %v", err)
+	}
+}
+
+func TestWriteField(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 6 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 6, length: 4 }, number: {} }];
+	  string sum = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 10, length: 3 }
+		checksum: { algorithm: CHECKSUM_ALGORITHM_SUM, range: { offset: 0, length: 10 } }
+	  }];
+	`)
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	msg.Set(msgDesc.Fields().ByName("count"), protoreflect.ValueOfInt32(42))
+	record := []byte("ABC   0001000x")
+	if err := parser.WriteField(record, msg, "count"); err != nil {
+		t.Fatal(err)
+	}
+	if err := parser.WriteChecksums(record); err != nil {
+		t.Fatal(err)
+	}
+	// The name, and the byte after the layout, are left as they were.
+	if want := "ABC   0042" + "236" + "x"; string(record) != want {
+		t.Errorf("expected %q, got %q", want, record)
+	}
+
+	msg.Set(msgDesc.Fields().ByName("count"), protoreflect.ValueOfInt32(12345))
+	if err := parser.WriteField(record, msg, "count"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", err)
+	}
+	if string(record[6:10]) != "0042" {
+		t.Errorf("a failed write changed the record: %q", record)
+	}
+	if err := parser.WriteField(record, msg, "missing"); err == nil {
+		t.Error("expected an error for a missing field")
+	}
+}
+
+func TestZeroFill(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 3 } }];
+	  uint32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 3, length: 4 }, number: {} }];
+	  int64 packed = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 7, length: 2 }
+		number: { encoding: ENCODING_PACKED_DECIMAL }
+	  }];
+	  uint32 binary = 4 [(flatfile.v1.field) = {
+		fixed_width: { offset: 9, length: 2 }
+		number: { encoding: ENCODING_BINARY }
+	  }];
+	  uint32 set = 5 [(flatfile.v1.field) = { fixed_width: { offset: 11, length: 2 }, number: {} }];
+	`)
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	msg.Set(msgDesc.Fields().ByName("set"), protoreflect.ValueOfUint32(7))
+	record, err := MarshalRecord(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ZeroFill(record, msg); err != nil {
+		t.Fatal(err)
+	}
+	// Unset numbers are written as zero in their own encoding, strings are
+	// left blank.
+	if want := "   " + "0000" + "\x00\x0c" + "\x00\x00" + "07"; string(record) != want {
+		t.Errorf("expected %q, got %q", want, record)
+	}
+
+	if err := ZeroFill(record[:5], msg); !errors.Is(err, ErrShortRecord) {
+		t.Errorf("expected ErrShortRecord, got %v", err)
+	}
+}
+
+func TestEncodeRecord(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 3 } }];
+	  int64 packed = 2 [(flatfile.v1.field) = {
+		fixed_width: { offset: 3, length: 2 }
+		number: { encoding: ENCODING_PACKED_DECIMAL }
+	  }];
+	`)
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	msg.Set(msgDesc.Fields().ByName("name"), protoreflect.ValueOfString("ABC"))
+	msg.Set(msgDesc.Fields().ByName("packed"), protoreflect.ValueOfInt64(125))
+	record, err := MarshalRecord(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := EncodeRecord(record, msgDesc, recordio.EBCDIC); err != nil {
+		t.Fatal(err)
+	}
+	// The text is EBCDIC, the packed digits are left as they were.
+	if want := "\xc1\xc2\xc3" + "\x12\x5c"; string(record) != want {
+		t.Errorf("expected %q, got %q", want, record)
+	}
+
+	parsed := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(parsed, record, WithCharset(recordio.EBCDIC)); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(parsed, msg) {
+		t.Errorf("expected %v, got %v", msg, parsed)
+	}
+}
+
+func TestMarshalRecordBinary(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  uint32 short = 1 [(flatfile.v1.field) = {
+		fixed_width: { offset: 0, length: 2 }
+		number: { encoding: ENCODING_BINARY }
+	  }];
+	  int32 word = 2 [(flatfile.v1.field) = {
+		fixed_width: { offset: 2, length: 4 }
+		number: { encoding: ENCODING_BINARY }
+	  }];
+	  uint64 wide = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 6, length: 4 }
+		number: { encoding: ENCODING_BINARY }
+	  }];
+	`)
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	fields := msgDesc.Fields()
+	msg.Set(fields.ByName("short"), protoreflect.ValueOfUint32(0x1234))
+	msg.Set(fields.ByName("word"), protoreflect.ValueOfInt32(0x01020304))
+	msg.Set(fields.ByName("wide"), protoreflect.ValueOfUint64(0xfffffffe))
+
+	got, err := MarshalRecord(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte("\x12\x34" + "\x01\x02\x03\x04" + "\xff\xff\xff\xfe")
+	if !bytes.Equal(got, want) {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	reparsed := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(reparsed, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(msg, reparsed) {
+		t.Errorf("expected %v, got %v", msg, reparsed)
+	}
+}
+
+func TestFileWriter(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	`)
+
+	out := &bytes.Buffer{}
+	fw := NewFileWriter(out, WithFlushSize(16), WithLineEnding("\r\n"))
+	for _, line := range []string{"AB001", "CD002", "EF003", "GH004"} {
+		msg := dynamicpb.NewMessage(msgDesc)
+		if err := ParseMessage(msg, []byte(line)); err != nil {
+			t.Fatal(err)
+		}
+		if err := fw.Write(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only whole chunks are written before Flush.
+	if out.Len() == 0 || out.Len() >= 28 {
+		t.Errorf("expected part of the file before flushing, got %d bytes", out.Len())
+	}
+	if err := fw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if got := out.String(); got != "AB001\r\nCD002\r\nEF003\r\nGH004\r\n" {
+		t.Errorf("unexpected file %q", got)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	msg.Set(msgDesc.Fields().ByName("count"), protoreflect.ValueOfInt32(1000))
+	err := fw.Write(msg)
+	recordErr := &RecordError{}
+	if !errors.As(err, &recordErr) || recordErr.Record != 5 || recordErr.Offset != 28 || !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow RecordError for record 5 at offset 28, got %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package binfile
+
+import (
+	"fmt"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// GeneratedParser supports the parsers generated by protoc-gen-go-flatfile,
+// which read each field into the concrete Go struct rather than setting it
+// through protoreflect. It is not intended to be used directly.
+type GeneratedParser struct {
+	parser *MessageParser
+	fields []*compiledField
+}
+
+// NewGeneratedParser compiles the message, indexing the named fields in the
+// order the generated code reads them.
+func NewGeneratedParser(desc protoreflect.MessageDescriptor, names ...protoreflect.Name) (*GeneratedParser, error) {
+	parser, err := Compile(desc)
+	if err != nil {
+		return nil, err
+	}
+
+	gp := &GeneratedParser{
+		parser: parser,
+		fields: make([]*compiledField, len(names)),
+	}
+	for idx, name := range names {
+		fieldIdx, ok := parser.index[name]
+		if !ok {
+			return nil, fmt.Errorf("field %s is not a flatfile field of %s", name, desc.FullName())
+		}
+		gp.fields[idx] = parser.fields[fieldIdx]
+	}
+	return gp, nil
+}
+
+// Reader checks the record length and returns a Reader for the fields, of the
+// record decoded from the charset of the config the parser was compiled with.
+func (gp *GeneratedParser) Reader(data []byte) (*Reader, error) {
+	if ext := gp.parser.ext; ext != nil {
+		if err := checkRecordLength(ext, len(data)); err != nil {
+			return nil, err
+		}
+	}
+	return NewReader(gp.parser.decoded(data), gp.parser.ext.GetOneBased()), nil
+}
+
+// Read reads the field at idx, returning an invalid value when the field is
+// to be left unset, including fields which fail with SEVERITY_WARNING. The
+// errors of those fields are kept on r for FinishWithWarnings. As in
+// ParseMessage, failing to read the raw text of a field with a raw_field
+// fails the field.
+func (gp *GeneratedParser) Read(r *Reader, idx int) (protoreflect.Value, error) {
+	field := gp.fields[idx]
+	var val protoreflect.Value
+	_, _, err := r.rawText(field)
+	if err == nil {
+		val, err = r.readField(field)
+	}
+	if err != nil {
+		if field.tc.OnError == flatfile_pb.Severity_SEVERITY_WARNING {
+			r.warnings = append(r.warnings, r.fieldError(field.desc, field.tc, err))
+			return protoreflect.Value{}, nil
+		}
+		return protoreflect.Value{}, r.fieldError(field.desc, field.tc, err)
+	}
+	return val, nil
+}
+
+// Finish sets any raw fields and runs the record level checks once the
+// generated code has read every field.
+func (gp *GeneratedParser) Finish(msg proto.Message, r *Reader) error {
+	_, err := gp.FinishWithWarnings(msg, r)
+	return err
+}
+
+// FinishWithWarnings finishes the record as Finish does, also returning the
+// warnings of the fields read from r and of the record level checks, as
+// ParseMessageWithWarnings does.
+func (gp *GeneratedParser) FinishWithWarnings(msg proto.Message, r *Reader) ([]*FieldError, error) {
+	refl := msg.ProtoReflect()
+	for _, field := range gp.parser.fields {
+		if err := r.setRawField(refl, field); err != nil {
+			// Read has already kept the warning of the field.
+			if field.tc.OnError == flatfile_pb.Severity_SEVERITY_WARNING {
+				continue
+			}
+			return r.warnings, r.fieldError(field.desc, field.tc, err)
+		}
+	}
+	warnings, err := gp.parser.checkRecord(refl, r.Record)
+	return append(r.warnings, warnings...), err
+}
+
+// Append appends a blank record to buf for the generated AppendRecord
+// methods, returning the extended buffer and the record within it.
+func (gp *GeneratedParser) Append(buf []byte) ([]byte, []byte) {
+	start := len(buf)
+	for range gp.parser.width {
+		buf = append(buf, ' ')
+	}
+	return buf, buf[start:]
+}
+
+// Write formats val into the record as the field at idx. Checksum fields
+// are skipped, they are written by WriteChecksums.
+func (gp *GeneratedParser) Write(record []byte, idx int, val protoreflect.Value) error {
+	field := gp.fields[idx]
+	if field.tc.Checksum != nil {
+		return nil
+	}
+	offset, length := zeroBased(field.tc.FixedWidth, gp.parser.ext.GetOneBased())
+	if err := field.write(record[offset:offset+length], field.tc, val); err != nil {
+		return formatError(field, offset, length, err)
+	}
+	return nil
+}
+
+// WriteChecksums calculates the checksum fields of the record once the
+// generated code has written every other field.
+func (gp *GeneratedParser) WriteChecksums(record []byte) error {
+	oneBased := gp.parser.ext.GetOneBased()
+	for _, field := range gp.parser.fields {
+		if field.tc.Checksum == nil {
+			continue
+		}
+		offset, length := zeroBased(field.tc.FixedWidth, oneBased)
+		if err := writeChecksum(record, record[offset:offset+length], field.tc, oneBased); err != nil {
+			return formatError(field, offset, length, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package binfile
+
+import (
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestGeneratedParserWrite(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { record_length: 20, one_based: true };
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 8 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 9, length: 4 }, number: {} }];
+	  string check = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 13, length: 8 }
+		checksum: { algorithm: CHECKSUM_ALGORITHM_CRC32, range: { offset: 1, length: 12 }, hex: true }
+	  }];
+	`)
+
+	gp, err := NewGeneratedParser(msgDesc, "count", "name", "check")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf, record := gp.Append([]byte("prefix"))
+	if len(record) != 20 || string(buf[:6]) != "prefix" {
+		t.Fatalf("got buffer %q, record %q", buf, record)
+	}
+	if err := gp.Write(record, 0, protoreflect.ValueOfInt32(42)); err != nil {
+		t.Fatal(err)
+	}
+	if err := gp.Write(record, 1, protoreflect.ValueOfString("WIDGET")); err != nil {
+		t.Fatal(err)
+	}
+	if err := gp.WriteChecksums(record); err != nil {
+		t.Fatal(err)
+	}
+
+	// The record must parse back under the same annotations, checksum
+	// included.
+	r, err := gp.Reader(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx, want := range []string{"42", "WIDGET  "} {
+		val, err := gp.Read(r, idx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := val.String(); got != want {
+			t.Errorf("field %d: got %q, want %q", idx, got, want)
+		}
+	}
+	if err := ParseMessage(dynamicpb.NewMessage(msgDesc), record); err != nil {
+		t.Errorf("parsing the written record: %s", err)
+	}
+
+	if err := gp.Write(record, 0, protoreflect.ValueOfInt32(100000)); err == nil {
+		t.Error("expected an error writing a value wider than the field")
+	}
+}
+
+func TestGeneratedParserWarnings(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { record_length: 8 };
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 4 }, number: {}, on_error: SEVERITY_WARNING }];
+	`)
+
+	gp, err := NewGeneratedParser(msgDesc, "name", "count")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := []byte("ABCDxxxx")
+	r, err := gp.Reader(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := dynamicpb.NewMessage(msgDesc)
+	for idx := range 2 {
+		val, err := gp.Read(r, idx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if idx == 1 && val.IsValid() {
+			t.Errorf("expected the failed count to be left unset, got %v", val)
+		}
+	}
+	warnings, err := gp.FinishWithWarnings(msg, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The warnings must match those of the reflection parser.
+	want, err := ParseMessageWithWarnings(dynamicpb.NewMessage(msgDesc), record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 || len(want) != 1 || warnings[0].Error() != want[0].Error() {
+		t.Errorf("got warnings %v, want %v", warnings, want)
+	}
+}
+
+func TestGeneratedParserRawFieldWarning(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];
+	  string code = 2 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 4 }, raw_field: "code_raw", on_error: SEVERITY_WARNING }];
+	  string code_raw = 3;
+	`)
+
+	gp, err := NewGeneratedParser(msgDesc, "name", "code")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The record ends before the code, so its raw text cannot be read.
+	record := []byte("ABCD")
+	r, err := gp.Reader(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := dynamicpb.NewMessage(msgDesc)
+	for idx := range 2 {
+		val, err := gp.Read(r, idx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if val.IsValid() {
+			msg.Set(msgDesc.Fields().Get(idx), val)
+		}
+	}
+	warnings, err := gp.FinishWithWarnings(msg, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := dynamicpb.NewMessage(msgDesc)
+	wantWarnings, err := ParseMessageWithWarnings(want, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 || len(wantWarnings) != 1 || warnings[0].Error() != wantWarnings[0].Error() {
+		t.Errorf("got warnings %v, want %v", warnings, wantWarnings)
+	}
+	prototest.AssertEqualProto(t, want, msg)
+}
@@ -0,0 +1,46 @@
+package binfile
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldHook is called for each field after it is read and before it is set,
+// with the bytes of the record the field spans and the value read from them,
+// which is invalid when the field would be left unset, such as a blank
+// number or a field missing from a short record. It returns the value
+// to set, which may be changed, e.g. to normalize or hash it, or an invalid
+// Value to leave the field unset. An error fails the field as a parse error
+// does, following its on_error severity.
+//
+// Fields which fail to parse are not passed to hooks. raw is part of the
+// record, so must not be modified, or kept after the hook returns.
+type FieldHook func(fieldDesc protoreflect.FieldDescriptor, raw []byte, val protoreflect.Value) (protoreflect.Value, error)
+
+// WithFieldHook calls the hook for every field parsed. Hooks are called in
+// the order they are given, each with the value returned by the one before.
+func WithFieldHook(hook FieldHook) ParseOption {
+	return func(o *parseOptions) {
+		o.hooks = append(o.hooks, hook)
+	}
+}
+
+// runHooks passes the value read for the field through the hooks.
+func (r *Reader) runHooks(hooks []FieldHook, field *compiledField, val protoreflect.Value) (protoreflect.Value, error) {
+	offset, length := r.span(field.tc)
+	start, end := min(max(offset, 0), len(r.Record)), min(offset+length, len(r.Record))
+	raw := r.Record[start:max(start, end)]
+
+	for _, hook := range hooks {
+		var err error
+		val, err = hook(field.desc, raw, val)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		if val.IsValid() && !valueFits(field.desc, val) {
+			return protoreflect.Value{}, fmt.Errorf("hook returned %T for a %s field", val.Interface(), fieldTypeName(field.desc))
+		}
+	}
+	return val, nil
+}
@@ -0,0 +1,87 @@
+package binfile
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestFieldHook(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];
+	  string ssn = 2 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 9 } }];
+	  int32 count = 3 [(flatfile.v1.field) = { fixed_width: { offset: 13, length: 3 }, number: {} }];
+	`)
+
+	blanks := map[protoreflect.Name]int{}
+	normalize := func(fieldDesc protoreflect.FieldDescriptor, raw []byte, val protoreflect.Value) (protoreflect.Value, error) {
+		if !val.IsValid() {
+			blanks[fieldDesc.Name()]++
+			return val, nil
+		}
+		if fieldDesc.Name() == "name" {
+			return protoreflect.ValueOfString(strings.ToUpper(val.String())), nil
+		}
+		return val, nil
+	}
+	hashPII := func(fieldDesc protoreflect.FieldDescriptor, raw []byte, val protoreflect.Value) (protoreflect.Value, error) {
+		if fieldDesc.Name() != "ssn" || !val.IsValid() {
+			return val, nil
+		}
+		return protoreflect.ValueOfString(fmt.Sprintf("%x", sha256.Sum256(raw))[:8]), nil
+	}
+	errTooMany := errors.New("too many")
+	veto := func(fieldDesc protoreflect.FieldDescriptor, raw []byte, val protoreflect.Value) (protoreflect.Value, error) {
+		if fieldDesc.Name() == "count" && val.IsValid() && val.Int() > 100 {
+			return protoreflect.Value{}, errTooMany
+		}
+		return val, nil
+	}
+	hooks := []ParseOption{WithFieldHook(normalize), WithFieldHook(hashPII), WithFieldHook(veto)}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, []byte("acme123456789007"), hooks...); err != nil {
+		t.Fatal(err)
+	}
+	fields := msgDesc.Fields()
+	wantSSN := fmt.Sprintf("%x", sha256.Sum256([]byte("123456789")))[:8]
+	if name, ssn := msg.Get(fields.ByName("name")).String(), msg.Get(fields.ByName("ssn")).String(); name != "ACME" || ssn != wantSSN {
+		t.Errorf("got name %q, ssn %q", name, ssn)
+	}
+
+	err := ParseMessage(dynamicpb.NewMessage(msgDesc), []byte("acme123456789101"), hooks...)
+	fieldErr := &FieldError{}
+	if !errors.As(err, &fieldErr) || fieldErr.Field.Name() != "count" || !errors.Is(err, errTooMany) {
+		t.Errorf("expected count to be vetoed, got %v", err)
+	}
+
+	wrongType := WithFieldHook(func(fieldDesc protoreflect.FieldDescriptor, raw []byte, val protoreflect.Value) (protoreflect.Value, error) {
+		return protoreflect.ValueOfString("x"), nil
+	})
+	if err := ParseMessage(dynamicpb.NewMessage(msgDesc), []byte("acme123456789007"), wrongType); err == nil {
+		t.Error("expected an error for a string value of the int32 field")
+	}
+
+	// Hooks run on every record of a file, and see blank fields as invalid
+	// values.
+	clear(blanks)
+	reader := NewFileReader(strings.NewReader("acme123456789007\nacme123456789   \n"), WithParseOptions(hooks...))
+	for {
+		err := reader.Next(dynamicpb.NewMessage(msgDesc))
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if blanks["count"] != 1 || len(blanks) != 1 {
+		t.Errorf("got blanks %v", blanks)
+	}
+}
@@ -0,0 +1,60 @@
+package binfile
+
+import (
+	"slices"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldInspection is the result of reading one field of a record on its own.
+type FieldInspection struct {
+	Field protoreflect.FieldDescriptor
+
+	// Offset is zero based regardless of the message's one_based setting.
+	Offset int
+	Length int
+
+	// Raw is the part of the field within the record, shorter than Length
+	// when the record ends early. It shares memory with the record, unless
+	// the parser's config decodes it from a charset.
+	Raw []byte
+
+	// Value is invalid when the field is unset or fails to read.
+	Value protoreflect.Value
+
+	// Err is the failure reading the field, including failures which
+	// on_error reports only as warnings.
+	Err *FieldError
+}
+
+// Inspect reads every field of the record independently, in order of offset,
+// for tools which show a record field by field. Unlike Parse it does not stop
+// at the first failure, and it skips the record length, coverage and rule
+// checks, so that each field of a malformed record can still be seen.
+func (p *MessageParser) Inspect(data []byte) []FieldInspection {
+	data = p.decoded(data)
+	rr := NewReader(data, p.ext.GetOneBased())
+	out := make([]FieldInspection, 0, len(p.fields))
+	for _, field := range p.fields {
+		offset, length := rr.span(field.tc)
+		inspection := FieldInspection{
+			Field:  field.desc,
+			Offset: offset,
+			Length: length,
+		}
+		if offset >= 0 && offset < len(data) {
+			inspection.Raw = data[offset:min(offset+length, len(data))]
+		}
+		val, err := rr.readField(field)
+		if err != nil {
+			inspection.Err = rr.fieldError(field.desc, field.tc, err)
+		} else {
+			inspection.Value = val
+		}
+		out = append(out, inspection)
+	}
+	slices.SortStableFunc(out, func(a, b FieldInspection) int {
+		return a.Offset - b.Offset
+	})
+	return out
+}
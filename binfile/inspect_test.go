@@ -0,0 +1,59 @@
+package binfile
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { record_length: 10 };
+	  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 6 } }];
+	  string type = 2 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+	  int32 count = 3 [(flatfile.v1.field) = {
+		fixed_width: { offset: 1, length: 3 }
+		number: {}
+		on_error: SEVERITY_WARNING
+	  }];
+	`)
+
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The record is short, which Parse would reject before reading anything.
+	fields := parser.Inspect([]byte("Dx12AB"))
+	if len(fields) != 3 {
+		t.Fatalf("expected 3 fields, got %d", len(fields))
+	}
+
+	for idx, want := range []string{"type", "count", "name"} {
+		if got := string(fields[idx].Field.Name()); got != want {
+			t.Errorf("field %d: expected %s, got %s", idx, want, got)
+		}
+	}
+
+	if got := fields[0].Value.String(); got != "D" {
+		t.Errorf("expected type D, got %q", got)
+	}
+
+	count := fields[1]
+	if !errors.Is(count.Err, ErrInvalidNumber) {
+		t.Errorf("expected ErrInvalidNumber for the warning field, got %v", count.Err)
+	}
+	if count.Value.IsValid() {
+		t.Errorf("expected no value for the failed field, got %v", count.Value)
+	}
+	if string(count.Raw) != "x12" {
+		t.Errorf("expected raw x12, got %q", count.Raw)
+	}
+
+	name := fields[2]
+	if name.Offset != 4 || name.Length != 6 || string(name.Raw) != "AB" {
+		t.Errorf("unexpected span for the short field: %d %d %q", name.Offset, name.Length, name.Raw)
+	}
+	if !errors.Is(name.Err, ErrShortRecord) {
+		t.Errorf("expected ErrShortRecord for the short field, got %v", name.Err)
+	}
+}
@@ -0,0 +1,258 @@
+package binfile
+
+import (
+	"strings"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// JSONSchemaDialect is the JSON Schema version of the schemas from
+// JSONSchemaFor.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is a JSON Schema document, or a subschema of one, with the
+// keywords JSONSchemaFor uses. It marshals to JSON.
+type JSONSchema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// Type is a type name, or a list of them, such as ["string", "null"].
+	Type any `json:"type,omitempty"`
+
+	Format    string   `json:"format,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+	MinLength int      `json:"minLength,omitempty"`
+	MaxLength int      `json:"maxLength,omitempty"`
+	Enum      []string `json:"enum,omitempty"`
+
+	// AllOf holds the patterns of strings which have more than one.
+	AllOf []*JSONSchema `json:"allOf,omitempty"`
+
+	Properties map[string]*JSONSchema `json:"properties,omitempty"`
+	Required   []string               `json:"required,omitempty"`
+	// AdditionalProperties is false for messages, and the schema of the
+	// values of map fields.
+	AdditionalProperties any         `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema `json:"items,omitempty"`
+
+	// Defs holds the messages of message fields, by full name.
+	Defs map[string]*JSONSchema `json:"$defs,omitempty"`
+}
+
+// JSONSchemaFor describes the JSON of parsed records of the message, as
+// j5codec writes them, for consumers which validate what is ingested:
+//
+//   - properties are the JSON names of the fields, and unset fields are
+//     left out, so only fields which the annotations require to be set,
+//     such as required strings, are required;
+//   - decimals are strings of digits, as they are not rounded to floats;
+//   - dates are strings in the "date" format, YYYY-MM-DD, whatever the
+//     format of the file;
+//   - 64 bit integers are strings of digits, and enums are the names of
+//     their values without the prefix of the enum;
+//   - wrapper fields, such as google.protobuf.StringValue, which tell a blank
+//     field from an empty value, may also be null.
+//
+// Fields of other message types refer to definitions in $defs.
+func JSONSchemaFor(desc protoreflect.MessageDescriptor) *JSONSchema {
+	b := &jsonSchemaBuilder{
+		root: desc.FullName(),
+		defs: map[string]*JSONSchema{},
+	}
+	schema := b.object(desc)
+	schema.Schema = JSONSchemaDialect
+	schema.Title = string(desc.FullName())
+	if len(b.defs) > 0 {
+		schema.Defs = b.defs
+	}
+	return schema
+}
+
+type jsonSchemaBuilder struct {
+	// root is referred to as "#" by the fields of messages which hold it.
+	root protoreflect.FullName
+	defs map[string]*JSONSchema
+}
+
+func (b *jsonSchemaBuilder) object(desc protoreflect.MessageDescriptor) *JSONSchema {
+	schema := &JSONSchema{
+		Description:          comments(desc),
+		Type:                 "object",
+		Properties:           map[string]*JSONSchema{},
+		AdditionalProperties: false,
+	}
+	// Defined before the fields, so that recursive messages refer to it.
+	if desc.FullName() != b.root {
+		b.defs[string(desc.FullName())] = schema
+	}
+
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		tc := fieldOptions(fieldDesc)
+
+		var property *JSONSchema
+		switch {
+		case fieldDesc.IsMap():
+			property = &JSONSchema{
+				Type:                 "object",
+				AdditionalProperties: b.value(fieldDesc.MapValue(), nil),
+			}
+		case fieldDesc.IsList():
+			property = &JSONSchema{
+				Type:  "array",
+				Items: b.value(fieldDesc, nil),
+			}
+		default:
+			property = b.value(fieldDesc, tc)
+		}
+
+		property.Description = tc.GetDescription()
+		if property.Description == "" {
+			property.Description = comments(fieldDesc)
+		}
+		schema.Properties[fieldDesc.JSONName()] = property
+		if alwaysSet(fieldDesc, tc) {
+			schema.Required = append(schema.Required, fieldDesc.JSONName())
+		}
+	}
+	return schema
+}
+
+// value describes one value of the field, with the constraints of its
+// annotation when it has one.
+func (b *jsonSchemaBuilder) value(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) *JSONSchema {
+	switch fieldDesc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msgDesc := fieldDesc.Message()
+		switch msgDesc.FullName() {
+		case "j5.types.decimal.v1.Decimal":
+			return &JSONSchema{Type: "string", Pattern: `^-?[0-9]+(\.[0-9]+)?$`}
+		case "j5.types.date.v1.Date":
+			return &JSONSchema{Type: "string", Format: "date"}
+		case "google.protobuf.StringValue":
+			schema := stringSchema(fieldDesc, tc)
+			schema.Type = []string{"string", "null"}
+			return schema
+		case "google.protobuf.BoolValue":
+			return &JSONSchema{Type: []string{"boolean", "null"}}
+		}
+		if msgDesc.FullName() == b.root {
+			return &JSONSchema{Ref: "#"}
+		}
+		name := string(msgDesc.FullName())
+		if _, ok := b.defs[name]; !ok {
+			b.object(msgDesc)
+		}
+		return &JSONSchema{Ref: "#/$defs/" + name}
+
+	case protoreflect.StringKind:
+		return stringSchema(fieldDesc, tc)
+
+	case protoreflect.BoolKind:
+		return &JSONSchema{Type: "boolean"}
+
+	case protoreflect.EnumKind:
+		values := fieldDesc.Enum().Values()
+		prefix := ""
+		if name := string(values.Get(0).Name()); strings.HasSuffix(name, "UNSPECIFIED") {
+			prefix = strings.TrimSuffix(name, "UNSPECIFIED")
+		}
+		schema := &JSONSchema{Type: "string"}
+		// The zero value is unset, so is never written.
+		for i := 1; i < values.Len(); i++ {
+			schema.Enum = append(schema.Enum, strings.TrimPrefix(string(values.Get(i).Name()), prefix))
+		}
+		return schema
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &JSONSchema{Type: "integer"}
+
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return &JSONSchema{Type: "string", Pattern: "^-?[0-9]+$"}
+
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &JSONSchema{Type: "string", Pattern: "^[0-9]+$"}
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return &JSONSchema{Type: "number"}
+
+	case protoreflect.BytesKind:
+		return &JSONSchema{Type: "string", Format: "byte"}
+
+	default:
+		return &JSONSchema{}
+	}
+}
+
+// stringSchema describes a string field, which is no longer than its fixed
+// width, and obeys the length, charset and pattern of its string options.
+func stringSchema(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) *JSONSchema {
+	schema := &JSONSchema{Type: "string"}
+	if fieldDesc.Kind() == protoreflect.StringKind && isUUIDKey(fieldDesc) {
+		schema.Format = "uuid"
+		return schema
+	}
+	if tc.GetFixedWidth() != nil {
+		schema.MaxLength = int(tc.FixedWidth.Length)
+	}
+
+	stringField := tc.GetString_()
+	if maxLength := int(stringField.GetMaxLength()); maxLength > 0 && (schema.MaxLength == 0 || maxLength < schema.MaxLength) {
+		schema.MaxLength = maxLength
+	}
+	schema.MinLength = int(stringField.GetMinLength())
+
+	var patterns []string
+	switch stringField.GetCharsetClass() {
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_NUMERIC:
+		patterns = append(patterns, "^[0-9]*$")
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_ALPHA:
+		patterns = append(patterns, "^[A-Za-z ]*$")
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_ALPHANUMERIC:
+		patterns = append(patterns, "^[A-Za-z0-9 ]*$")
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_PRINTABLE:
+		patterns = append(patterns, "^[\\x20-\\x7e]*$")
+	}
+	if pattern := stringField.GetPattern(); pattern != "" {
+		patterns = append(patterns, pattern)
+	}
+	switch len(patterns) {
+	case 0:
+	case 1:
+		schema.Pattern = patterns[0]
+	default:
+		for _, pattern := range patterns {
+			schema.AllOf = append(schema.AllOf, &JSONSchema{Pattern: pattern})
+		}
+	}
+	return schema
+}
+
+// alwaysSet reports whether every record which parses sets the field, so
+// that it is always in the JSON. Required fields are never blank, but
+// numbers of only zeros, false bools and enums are still left out as the
+// zero values of proto3, dates of only zeros are unset, and bool wrappers
+// may have null values.
+func alwaysSet(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) bool {
+	if !tc.GetRequired() || tc.OnError == flatfile_pb.Severity_SEVERITY_WARNING || fieldDesc.IsList() || fieldDesc.IsMap() {
+		return false
+	}
+	switch fieldDesc.Kind() {
+	case protoreflect.StringKind:
+		// Trimming characters other than spaces may leave nothing.
+		return tc.GetString_().GetTrimChars() == ""
+	case protoreflect.MessageKind:
+		switch fieldDesc.Message().FullName() {
+		case "google.protobuf.StringValue":
+			return tc.GetString_().GetTrimChars() == ""
+		case "j5.types.decimal.v1.Decimal":
+			return true
+		}
+	}
+	return false
+}
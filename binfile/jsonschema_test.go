@@ -0,0 +1,133 @@
+package binfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"github.com/pentops/j5/lib/j5codec"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestJSONSchemaFor(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package schemas.v1;
+
+		import "flatfile/v1/annotations.proto";
+		import "google/protobuf/wrappers.proto";
+		import "j5/types/date/v1/date.proto";
+		import "j5/types/decimal/v1/decimal.proto";
+
+		message Payment {
+		  string account_id = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 10 }
+			string: { trim: TRIM_RIGHT, charset_class: CHARSET_CLASS_ALPHANUMERIC, pattern: "^AC" }
+			required: true
+			description: "The payer's account."
+		  }];
+		  j5.types.decimal.v1.Decimal amount = 2 [(flatfile.v1.field) = { fixed_width: { offset: 10, length: 8 }, required: true }];
+		  j5.types.date.v1.Date posted = 3 [(flatfile.v1.field) = { fixed_width: { offset: 18, length: 8 }, date: { format: "YYYYMMDD" }, required: true }];
+		  Status status = 4 [(flatfile.v1.field) = { fixed_width: { offset: 26, length: 1 } }];
+		  int32 count = 5 [(flatfile.v1.field) = { fixed_width: { offset: 27, length: 3 }, number: {} }];
+		  int64 sequence = 6 [(flatfile.v1.field) = { fixed_width: { offset: 30, length: 6 }, number: {} }];
+		  string raw_status = 7;
+		  Payment reversal = 8;
+		  repeated Note notes = 9;
+		}
+
+		// j5codec does not write wrappers, so they have a message of their own.
+		message Memo {
+		  google.protobuf.StringValue text = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 }, string: { trim: TRIM_RIGHT }, required: true }];
+		  google.protobuf.BoolValue flagged = 2 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 1 } }];
+		}
+
+		message Note {
+		  string text = 1;
+		}
+
+		enum Status {
+		  STATUS_UNSPECIFIED = 0;
+		  STATUS_POSTED = 1 [(flatfile.v1.enum).key = "P"];
+		  STATUS_RETURNED = 2 [(flatfile.v1.enum).key = "R"];
+		}`})
+	msgDesc := fileDesc.MessageByName(t, "schemas.v1.Payment")
+
+	schema := JSONSchemaFor(msgDesc)
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+	  "$schema": "https://json-schema.org/draft/2020-12/schema",
+	  "title": "schemas.v1.Payment",
+	  "type": "object",
+	  "properties": {
+		"accountId": {"description": "The payer's account.", "type": "string", "maxLength": 10,
+		  "allOf": [{"pattern": "^[A-Za-z0-9 ]*$"}, {"pattern": "^AC"}]},
+		"amount": {"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]+)?$"},
+		"count": {"type": "integer"},
+		"notes": {"type": "array", "items": {"$ref": "#/$defs/schemas.v1.Note"}},
+		"posted": {"type": "string", "format": "date"},
+		"rawStatus": {"type": "string"},
+		"reversal": {"$ref": "#"},
+		"sequence": {"type": "string", "pattern": "^-?[0-9]+$"},
+		"status": {"type": "string", "enum": ["POSTED", "RETURNED"]}
+	  },
+	  "required": ["accountId", "amount"],
+	  "additionalProperties": false,
+	  "$defs": {
+		"schemas.v1.Note": {"type": "object", "properties": {"text": {"type": "string"}}, "additionalProperties": false}
+	  }
+	}`
+	compact := &bytes.Buffer{}
+	if err := json.Compact(compact, []byte(want)); err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != compact.String() {
+		t.Errorf("got:\n%s\nwant:\n%s", data, compact)
+	}
+
+	// The JSON of a parsed record has the properties of the schema.
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := ParseMessage(msg, []byte("ACME01    00012.50"+"20240131"+"P"+"007"+"000042")); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err = j5codec.Global.ProtoToJSON(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]any{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	for name := range got {
+		if _, ok := schema.Properties[name]; !ok {
+			t.Errorf("%s is not a property of the schema", name)
+		}
+	}
+	for _, name := range schema.Required {
+		if _, ok := got[name]; !ok {
+			t.Errorf("required %s is not in %s", name, data)
+		}
+	}
+	if got["sequence"] != "42" || got["status"] != "POSTED" || got["posted"] != "2024-01-31" {
+		t.Errorf("got %s", data)
+	}
+
+	// Wrappers may be null, as blank fields are, and required string
+	// wrappers are always set.
+	memo := JSONSchemaFor(fileDesc.MessageByName(t, "schemas.v1.Memo"))
+	data, err = json.Marshal(memo.Properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"flagged":{"type":["boolean","null"]},"text":{"type":["string","null"],"maxLength":4}}`; string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+	if len(memo.Required) != 1 || memo.Required[0] != "text" {
+		t.Errorf("got required %v", memo.Required)
+	}
+}
@@ -0,0 +1,337 @@
+package binfile
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type LayoutIssueKind int
+
+const (
+	// LayoutOverlap is two fields sharing bytes of the record.
+	LayoutOverlap LayoutIssueKind = iota + 1
+
+	// LayoutGap is a range of bytes not read by any field.
+	LayoutGap
+
+	// LayoutZeroLength is a field with no length.
+	LayoutZeroLength
+
+	// LayoutOffsetBase is an offset which doesn't fit the message's
+	// one_based setting.
+	LayoutOffsetBase
+
+	// LayoutPastEnd is a field which extends past the declared record_length.
+	LayoutPastEnd
+)
+
+func (k LayoutIssueKind) String() string {
+	switch k {
+	case LayoutOverlap:
+		return "overlap"
+	case LayoutGap:
+		return "gap"
+	case LayoutZeroLength:
+		return "zero length"
+	case LayoutOffsetBase:
+		return "offset base"
+	case LayoutPastEnd:
+		return "past end"
+	default:
+		return fmt.Sprintf("LayoutIssueKind(%d)", int(k))
+	}
+}
+
+// LayoutIssue is a problem with the fixed width annotations of a message.
+// Offsets are zero based regardless of the message's one_based setting.
+type LayoutIssue struct {
+	Kind LayoutIssueKind
+
+	// Field is the field the issue was found on, empty for gaps and filler.
+	Field protoreflect.FullName
+
+	// Other is the second field of an overlap.
+	Other protoreflect.FullName
+
+	Offset int
+	Length int
+}
+
+func (i LayoutIssue) String() string {
+	field := spanName(i.Field)
+	switch i.Kind {
+	case LayoutOverlap:
+		return fmt.Sprintf("%s overlaps %s for %d bytes at offset %d", field, spanName(i.Other), i.Length, i.Offset)
+	case LayoutGap:
+		return fmt.Sprintf("%d unmapped bytes at offset %d", i.Length, i.Offset)
+	case LayoutZeroLength:
+		return fmt.Sprintf("%s has zero length", field)
+	case LayoutOffsetBase:
+		return fmt.Sprintf("%s offset %d doesn't fit the one_based setting", field, i.Offset)
+	case LayoutPastEnd:
+		return fmt.Sprintf("%s at offset %d length %d extends past the record length", field, i.Offset, i.Length)
+	default:
+		return fmt.Sprintf("%s: %s at offset %d", i.Kind, field, i.Offset)
+	}
+}
+
+func spanName(name protoreflect.FullName) string {
+	if name == "" {
+		return "filler"
+	}
+	return string(name)
+}
+
+type fieldSpan struct {
+	field  protoreflect.FullName // empty for filler
+	offset int
+	length int
+}
+
+func layoutSpans(desc protoreflect.MessageDescriptor, ext *flatfile_pb.Message) []fieldSpan {
+	spans := []fieldSpan{}
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		tc := fieldOptions(fieldDesc)
+		if tc == nil || tc.FixedWidth == nil {
+			continue
+		}
+		offset := int(tc.FixedWidth.Offset)
+		if ext.GetOneBased() {
+			offset--
+		}
+		spans = append(spans, fieldSpan{
+			field:  fieldDesc.FullName(),
+			offset: offset,
+			length: int(tc.FixedWidth.Length),
+		})
+	}
+
+	for _, filler := range ext.GetFiller() {
+		offset := int(filler.Offset)
+		if ext.GetOneBased() {
+			offset--
+		}
+		spans = append(spans, fieldSpan{
+			offset: offset,
+			length: int(filler.Length),
+		})
+	}
+
+	slices.SortStableFunc(spans, func(a, b fieldSpan) int {
+		return a.offset - b.offset
+	})
+	return spans
+}
+
+// ValidateLayout walks the fixed width annotations of the message and reports
+// overlapping fields, gaps between fields, zero length fields and offsets
+// which don't fit the one_based setting. When the message declares an exact
+// record_length it also reports fields extending past it, and a gap at the
+// end when the fields and filler don't reach it. A clean layout returns no
+// issues.
+func ValidateLayout(desc protoreflect.MessageDescriptor) []LayoutIssue {
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	spans := layoutSpans(desc, ext)
+	issues := []LayoutIssue{}
+
+	for _, span := range spans {
+		if span.length == 0 {
+			issues = append(issues, LayoutIssue{
+				Kind:   LayoutZeroLength,
+				Field:  span.field,
+				Offset: span.offset,
+			})
+		}
+		if span.offset < 0 {
+			issues = append(issues, LayoutIssue{
+				Kind:   LayoutOffsetBase,
+				Field:  span.field,
+				Offset: span.offset,
+				Length: span.length,
+			})
+		}
+	}
+
+	if len(spans) == 0 {
+		return issues
+	}
+
+	// A zero based layout which starts at 1 was most likely written against a
+	// one based spec.
+	if !ext.GetOneBased() && spans[0].offset == 1 {
+		issues = append(issues, LayoutIssue{
+			Kind:   LayoutOffsetBase,
+			Field:  spans[0].field,
+			Offset: spans[0].offset,
+			Length: spans[0].length,
+		})
+	} else if spans[0].offset > 0 {
+		issues = append(issues, LayoutIssue{
+			Kind:   LayoutGap,
+			Offset: 0,
+			Length: spans[0].offset,
+		})
+	}
+
+	last := spans[0]
+	for _, span := range spans[1:] {
+		lastEnd := last.offset + last.length
+		switch {
+		case span.offset < lastEnd:
+			issues = append(issues, LayoutIssue{
+				Kind:   LayoutOverlap,
+				Field:  last.field,
+				Other:  span.field,
+				Offset: span.offset,
+				Length: min(lastEnd, span.offset+span.length) - span.offset,
+			})
+		case span.offset > lastEnd:
+			issues = append(issues, LayoutIssue{
+				Kind:   LayoutGap,
+				Offset: lastEnd,
+				Length: span.offset - lastEnd,
+			})
+		}
+		if span.offset+span.length > lastEnd {
+			last = span
+		}
+	}
+
+	// Records of a minimum length may be longer, with fields past it, so
+	// only an exact length is checked, as in Compile.
+	recordLength := int(ext.GetRecordLength())
+	if recordLength == 0 || ext.GetRecordLengthMode() == flatfile_pb.LengthMode_LENGTH_MODE_MINIMUM {
+		return issues
+	}
+
+	for _, span := range spans {
+		if span.offset+span.length > recordLength {
+			issues = append(issues, LayoutIssue{
+				Kind:   LayoutPastEnd,
+				Field:  span.field,
+				Offset: span.offset,
+				Length: span.length,
+			})
+		}
+	}
+
+	if lastEnd := last.offset + last.length; lastEnd < recordLength {
+		issues = append(issues, LayoutIssue{
+			Kind:   LayoutGap,
+			Offset: lastEnd,
+			Length: recordLength - lastEnd,
+		})
+	}
+
+	return issues
+}
+
+// checkPastEnd returns an error for the first of the spans, as sorted by
+// layoutSpans, which extends past the declared record_length. Spans past a
+// minimum length are read from the longer records, so are not checked.
+func checkPastEnd(ext *flatfile_pb.Message, spans []fieldSpan) error {
+	recordLength := int(ext.GetRecordLength())
+	if recordLength == 0 || ext.GetRecordLengthMode() == flatfile_pb.LengthMode_LENGTH_MODE_MINIMUM {
+		return nil
+	}
+	for _, span := range spans {
+		if span.offset+span.length > recordLength {
+			return fmt.Errorf("%s at offset %d length %d extends past record_length %d", spanName(span.field), span.offset, span.length, recordLength)
+		}
+	}
+	return nil
+}
+
+// checkBeforeStart returns an error for the first of the spans, as sorted by
+// layoutSpans, which starts before the record, as offset 0 does in a one
+// based layout.
+func checkBeforeStart(spans []fieldSpan) error {
+	if len(spans) > 0 && spans[0].offset < 0 {
+		return fmt.Errorf("%s is at offset 0, so the layout is not one based", spanName(spans[0].field))
+	}
+	return nil
+}
+
+// unmappedData returns an error for each range between the spans, as sorted
+// by layoutSpans, which holds anything other than spaces and NULs.
+func unmappedData(spans []fieldSpan, record []byte) []*FieldError {
+	errs := []*FieldError{}
+
+	checkRange := func(start, end int) {
+		end = min(end, len(record))
+		if start >= end {
+			return
+		}
+		raw := record[start:end]
+		if len(bytes.Trim(raw, " \x00")) == 0 {
+			return
+		}
+		errs = append(errs, &FieldError{
+			Offset: start,
+			Length: end - start,
+			Raw:    bytes.Clone(raw),
+			Err:    ErrUnmappedData,
+		})
+	}
+
+	covered := 0
+	for _, span := range spans {
+		if span.offset > covered {
+			checkRange(covered, span.offset)
+		}
+		covered = max(covered, span.offset+span.length)
+	}
+
+	return errs
+}
+
+// layoutEnd is the zero based offset after the last of the spans.
+func layoutEnd(spans []fieldSpan) int {
+	end := 0
+	for _, span := range spans {
+		end = max(end, span.offset+span.length)
+	}
+	return end
+}
+
+// handleTrailing applies the treat_trailing_as setting to the bytes of the
+// record after end, the layoutEnd of the message, capturing them in trailing
+// when the message does.
+func handleTrailing(refl protoreflect.Message, ext *flatfile_pb.Message, trailing protoreflect.FieldDescriptor, end int, record []byte) error {
+	switch ext.GetTreatTrailingAs() {
+	case flatfile_pb.TrailingIs_TRAILING_IS_UNSPECIFIED, flatfile_pb.TrailingIs_TRAILING_IS_IGNORED:
+		return nil
+
+	case flatfile_pb.TrailingIs_TRAILING_IS_ERROR:
+		if end >= len(record) {
+			return nil
+		}
+		raw := record[end:]
+		if len(bytes.Trim(raw, " \x00")) == 0 {
+			return nil
+		}
+		return &FieldError{
+			Offset: end,
+			Length: len(raw),
+			Raw:    bytes.Clone(raw),
+			Err:    ErrTrailingData,
+		}
+
+	case flatfile_pb.TrailingIs_TRAILING_IS_CAPTURED:
+		if end >= len(record) {
+			return nil
+		}
+		refl.Set(trailing, protoreflect.ValueOfString(string(record[end:])))
+		return nil
+
+	default:
+		return fmt.Errorf("unknown treat_trailing_as %d", ext.GetTreatTrailingAs())
+	}
+}
@@ -0,0 +1,188 @@
+package binfile
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestValidateLayout(t *testing.T) {
+
+	t.Run("Clean", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+		  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 } }];
+		  string raw = 3;
+		`)
+
+		assertIssues(t, ValidateLayout(msgDesc))
+	})
+
+	t.Run("Clean One Based", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  option (flatfile.v1.message).one_based = true;
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 2 } }];
+		  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 3, length: 3 } }];
+		`)
+
+		assertIssues(t, ValidateLayout(msgDesc))
+	})
+
+	t.Run("Overlap Gap Zero", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];
+		  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 4 } }];
+		  string c = 3 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 0 } }];
+		  string d = 4 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 1 } }];
+		`)
+
+		assertIssues(t, ValidateLayout(msgDesc),
+			LayoutZeroLength,
+			LayoutOverlap,
+			LayoutGap,
+		)
+	})
+
+	t.Run("Offset Base", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 2 } }];
+		  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 3, length: 3 } }];
+		`)
+		assertIssues(t, ValidateLayout(msgDesc), LayoutOffsetBase)
+
+		msgDesc = singleMessage(t, `
+		  option (flatfile.v1.message).one_based = true;
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+		  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 } }];
+		`)
+		assertIssues(t, ValidateLayout(msgDesc), LayoutOffsetBase)
+	})
+}
+
+func TestValidateLayoutRecordLength(t *testing.T) {
+
+	t.Run("Covered With Filler", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  option (flatfile.v1.message) = {
+			record_length: 10
+			filler: [{ offset: 2, length: 3 }, { offset: 8, length: 2 }]
+		  };
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+		  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 5, length: 3 } }];
+		`)
+
+		assertIssues(t, ValidateLayout(msgDesc))
+	})
+
+	t.Run("Past End and Short", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  option (flatfile.v1.message) = { record_length: 6 };
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+		  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 6 } }];
+		`)
+		assertIssues(t, ValidateLayout(msgDesc), LayoutPastEnd)
+
+		msgDesc = singleMessage(t, `
+		  option (flatfile.v1.message) = { record_length: 6, filler: [{ offset: 2, length: 1 }] };
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+		`)
+		assertIssues(t, ValidateLayout(msgDesc), LayoutGap)
+	})
+
+	t.Run("Minimum Length", func(t *testing.T) {
+		// Fields past a minimum length are read from longer records, and
+		// shorter layouts leave the rest of the record unchecked.
+		msgDesc := singleMessage(t, `
+		  option (flatfile.v1.message) = { record_length: 6, record_length_mode: LENGTH_MODE_MINIMUM };
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 6 } }];
+		  string opt = 2 [(flatfile.v1.field) = { fixed_width: { offset: 6, length: 4 } }];
+		`)
+		assertIssues(t, ValidateLayout(msgDesc))
+
+		msgDesc = singleMessage(t, `
+		  option (flatfile.v1.message) = { record_length: 6, record_length_mode: LENGTH_MODE_MINIMUM };
+		  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+		`)
+		assertIssues(t, ValidateLayout(msgDesc))
+	})
+}
+
+func assertIssues(t testing.TB, got []LayoutIssue, want ...LayoutIssueKind) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d issues, got %d: %v", len(want), len(got), got)
+	}
+	for idx, issue := range got {
+		if issue.Kind != want[idx] {
+			t.Errorf("issue %d: expected %s, got %s", idx, want[idx], issue)
+		}
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = {
+		coverage: COVERAGE_ERROR
+		filler: [{ offset: 2, length: 1 }]
+	  };
+	  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 5, length: 2 } }];
+	`)
+
+	runCmp(t, msgDesc, []string{"AB", "x", "  ", "CD", "trailing"}, `{ "a": "AB", "b": "CD" }`)
+	runCmp(t, msgDesc, []string{"AB", "x", "\x00 ", "CD"}, `{ "a": "AB", "b": "CD" }`)
+
+	err := runErr(t, msgDesc, []string{"AB", "x", " Z", "CD"})
+	if !errors.Is(err, ErrUnmappedData) {
+		t.Fatalf("expected ErrUnmappedData, got %v", err)
+	}
+	fieldErr := &FieldError{}
+	if !errors.As(err, &fieldErr) || fieldErr.Offset != 3 || string(fieldErr.Raw) != " Z" {
+		t.Fatalf("expected FieldError at offset 3, got %v", err)
+	}
+
+	msgDesc = singleMessage(t, `
+	  option (flatfile.v1.message).coverage = COVERAGE_WARNING;
+	  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  string b = 2 [(flatfile.v1.field) = { fixed_width: { offset: 3, length: 2 } }];
+	`)
+
+	record := dynamicpb.NewMessage(msgDesc)
+	warnings, err := ParseMessageWithWarnings(record, []byte("ABxCD"))
+	if err != nil {
+		t.Fatalf("error parsing record: %v", err)
+	}
+	if len(warnings) != 1 || !errors.Is(warnings[0], ErrUnmappedData) {
+		t.Fatalf("expected one unmapped data warning, got %v", warnings)
+	}
+}
+
+func TestTrailingData(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = {
+		treat_trailing_as: TRAILING_IS_ERROR
+		filler: [{ offset: 2, length: 2 }]
+	  };
+	  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	`)
+
+	runCmp(t, msgDesc, []string{"AB", "xx"}, `{ "a": "AB" }`)
+	runCmp(t, msgDesc, []string{"AB", "xx", "   "}, `{ "a": "AB" }`)
+	err := runErr(t, msgDesc, []string{"AB", "xx", " new"})
+	if !errors.Is(err, ErrTrailingData) {
+		t.Fatalf("expected ErrTrailingData, got %v", err)
+	}
+
+	msgDesc = singleMessage(t, `
+	  option (flatfile.v1.message) = {
+		treat_trailing_as: TRAILING_IS_CAPTURED
+		trailing_field: "rest"
+	  };
+	  string a = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  string rest = 2;
+	`)
+
+	runCmp(t, msgDesc, []string{"AB"}, `{ "a": "AB" }`)
+	runCmp(t, msgDesc, []string{"AB", " new"}, `{ "a": "AB", "rest": " new" }`)
+}
@@ -0,0 +1,102 @@
+package binfile
+
+import (
+	"fmt"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// LazyRecord holds a raw record and parses its fields only as they are
+// accessed, for callers which look at a discriminator or a few keys of most
+// records and need the full message for only some of them.
+//
+// The record is not copied, unless the parser's config decodes it from a
+// charset, so it must not be modified while the LazyRecord is in use. Unlike
+// the MessageParser it comes from, a LazyRecord caches the fields it has read
+// and is not safe for concurrent use.
+type LazyRecord struct {
+	parser *MessageParser
+	reader *Reader
+	raw    []byte
+
+	values []protoreflect.Value
+	errs   []error
+	read   []bool
+}
+
+// Lazy checks the length of the record and wraps it for lazy field access.
+func (p *MessageParser) Lazy(data []byte) (*LazyRecord, error) {
+	if p.ext != nil {
+		if err := checkRecordLength(p.ext, len(data)); err != nil {
+			return nil, err
+		}
+	}
+	return &LazyRecord{
+		parser: p,
+		reader: NewReader(p.decoded(data), p.ext.GetOneBased()),
+		raw:    data,
+		values: make([]protoreflect.Value, len(p.fields)),
+		errs:   make([]error, len(p.fields)),
+		read:   make([]bool, len(p.fields)),
+	}, nil
+}
+
+// Raw returns the record as given to Lazy.
+func (lr *LazyRecord) Raw() []byte {
+	return lr.raw
+}
+
+// Descriptor returns the message type of the record.
+func (lr *LazyRecord) Descriptor() protoreflect.MessageDescriptor {
+	return lr.parser.desc
+}
+
+// Get parses the named field, or returns the result of an earlier call. The
+// value is invalid when the field would be left unset by Parse, including
+// when it fails with on_error set to warning.
+func (lr *LazyRecord) Get(name protoreflect.Name) (protoreflect.Value, error) {
+	idx, ok := lr.parser.index[name]
+	if !ok {
+		return protoreflect.Value{}, fmt.Errorf("field %s is not a flatfile field of %s", name, lr.parser.desc.FullName())
+	}
+	if !lr.read[idx] {
+		lr.values[idx], lr.errs[idx] = lr.readField(lr.parser.fields[idx])
+		lr.read[idx] = true
+	}
+	return lr.values[idx], lr.errs[idx]
+}
+
+func (lr *LazyRecord) readField(field *compiledField) (protoreflect.Value, error) {
+	val, err := lr.reader.readField(field)
+	if err != nil {
+		if field.tc.OnError == flatfile_pb.Severity_SEVERITY_WARNING {
+			return protoreflect.Value{}, nil
+		}
+		return protoreflect.Value{}, lr.reader.fieldError(field.desc, field.tc, err)
+	}
+	return val, nil
+}
+
+// GetString returns the named field as a string, or "" when it is unset.
+// It is an error to call it for fields of other types.
+func (lr *LazyRecord) GetString(name protoreflect.Name) (string, error) {
+	val, err := lr.Get(name)
+	if err != nil {
+		return "", err
+	}
+	if !val.IsValid() {
+		return "", nil
+	}
+	str, ok := val.Interface().(string)
+	if !ok {
+		return "", fmt.Errorf("field %s is not a string", name)
+	}
+	return str, nil
+}
+
+// Parse parses the whole record into msg, as MessageParser.Parse does.
+func (lr *LazyRecord) Parse(msg proto.Message) error {
+	return lr.parser.Parse(msg, lr.raw)
+}
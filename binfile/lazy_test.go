@@ -0,0 +1,55 @@
+package binfile
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestLazyRecord(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { record_length: 8 };
+	  string type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 3 }, number: {} }];
+	  string name = 3 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 4 } }];
+	`)
+
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lazy, err := parser.Lazy([]byte("Dx12ABCD"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recordType, err := lazy.GetString("type")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recordType != "D" {
+		t.Errorf("expected type D, got %q", recordType)
+	}
+
+	// The bad count is only reported when it is accessed.
+	if _, err := lazy.Get("count"); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("expected ErrInvalidNumber, got %v", err)
+	}
+	if _, err := lazy.GetString("count"); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("expected the cached ErrInvalidNumber, got %v", err)
+	}
+
+	if _, err := lazy.Get("missing"); err == nil {
+		t.Error("expected error for an unknown field")
+	}
+
+	if err := lazy.Parse(dynamicpb.NewMessage(msgDesc)); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("expected ErrInvalidNumber from Parse, got %v", err)
+	}
+
+	if _, err := parser.Lazy([]byte("D")); !errors.Is(err, ErrRecordLength) {
+		t.Errorf("expected ErrRecordLength, got %v", err)
+	}
+}
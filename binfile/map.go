@@ -0,0 +1,109 @@
+package binfile
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// ParseMap parses the record as Parse does, and returns its fields as a map
+// of field name to value, for generic consumers such as rules engines and
+// templates which don't need typed messages.
+//
+// Fields the parser leaves unset, such as blank fields and numbers of only
+// zeros, are not in the map. Fields it reads as zero values, such as bools
+// written as N, are, though a message would not hold them.
+//
+// Values are string, bool, int64 or uint64, except that decimals are strings
+// such as "12.5", so as not to lose precision, dates are "YYYY-MM-DD"
+// strings, and enums are the names of their values.
+func (p *MessageParser) ParseMap(data []byte) (map[string]any, error) {
+	msg := dynamicpb.NewMessage(p.desc)
+	if err := p.Parse(msg, data); err != nil {
+		return nil, err
+	}
+	out := MessageMap(msg)
+
+	// Zero values are unset in the message, as proto3 scalars are, so the
+	// fields missing from it are read again to find them.
+	lazy, err := p.Lazy(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range p.fields {
+		name := field.desc.Name()
+		if _, ok := out[string(name)]; ok {
+			continue
+		}
+		val, err := lazy.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		if val.IsValid() {
+			out[string(name)] = mapValue(field.desc, val)
+		}
+	}
+	return out, nil
+}
+
+// MessageMap returns the set fields of a message as ParseMap does. Repeated
+// fields are []any, and map fields and other messages are nested maps.
+func MessageMap(msg proto.Message) map[string]any {
+	out := map[string]any{}
+	msg.ProtoReflect().Range(func(fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		if fieldDesc.IsMap() {
+			values := map[string]any{}
+			val.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+				values[key.String()] = mapValue(fieldDesc.MapValue(), val)
+				return true
+			})
+			out[string(fieldDesc.Name())] = values
+			return true
+		}
+		if fieldDesc.IsList() {
+			list := val.List()
+			values := make([]any, list.Len())
+			for idx := range values {
+				values[idx] = mapValue(fieldDesc, list.Get(idx))
+			}
+			out[string(fieldDesc.Name())] = values
+			return true
+		}
+		out[string(fieldDesc.Name())] = mapValue(fieldDesc, val)
+		return true
+	})
+	return out
+}
+
+func mapValue(fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) any {
+	switch fieldDesc.Kind() {
+	case protoreflect.MessageKind:
+		refl := val.Message()
+		fields := refl.Descriptor().Fields()
+		get := func(name protoreflect.Name) protoreflect.Value {
+			return refl.Get(fields.ByName(name))
+		}
+		switch refl.Descriptor().FullName() {
+		case "google.protobuf.StringValue", "j5.types.decimal.v1.Decimal":
+			return get("value").String()
+		case "google.protobuf.BoolValue":
+			return get("value").Bool()
+		case "j5.types.date.v1.Date":
+			return fmt.Sprintf("%04d-%02d-%02d", get("year").Int(), get("month").Int(), get("day").Int())
+		}
+		return MessageMap(refl.Interface())
+	case protoreflect.EnumKind:
+		if value := fieldDesc.Enum().Values().ByNumber(val.Enum()); value != nil {
+			return string(value.Name())
+		}
+		return int64(val.Enum())
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return val.Int()
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return val.Uint()
+	default:
+		return val.Interface()
+	}
+}
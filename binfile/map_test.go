@@ -0,0 +1,71 @@
+package binfile
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+)
+
+func TestParseMap(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package maps.v1;
+
+		import "flatfile/v1/annotations.proto";
+		import "j5/types/date/v1/date.proto";
+		import "j5/types/decimal/v1/decimal.proto";
+
+		message Record {
+		  option (flatfile.v1.message) = { record_length: 28 };
+		  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 6 }, string: { trim: TRIM_RIGHT }, raw_field: "raw_name" }];
+		  j5.types.decimal.v1.Decimal amount = 2 [(flatfile.v1.field) = { fixed_width: { offset: 6, length: 6 }, number: { fixed_scale: 2 } }];
+		  j5.types.date.v1.Date posted = 3 [(flatfile.v1.field) = { fixed_width: { offset: 12, length: 8 }, date: { format: "YYYYMMDD" } }];
+		  Status status = 4 [(flatfile.v1.field) = { fixed_width: { offset: 20, length: 1 } }];
+		  bool active = 5 [(flatfile.v1.field) = { fixed_width: { offset: 21, length: 1 } }];
+		  int32 count = 6 [(flatfile.v1.field) = { fixed_width: { offset: 22, length: 3 }, number: {} }];
+		  uint64 sequence = 7 [(flatfile.v1.field) = { fixed_width: { offset: 25, length: 3 }, number: {} }];
+		  string raw_name = 8;
+		}
+
+		enum Status {
+		  STATUS_UNSPECIFIED = 0;
+		  STATUS_OPEN = 1 [(flatfile.v1.enum).key = "O"];
+		}`})
+
+	parser, err := Compile(fileDesc.MessageByName(t, "maps.v1.Record"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := parser.ParseMap([]byte("ACME  012.50" + "20240131" + "OY" + "-12" + "007"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"name":     "ACME",
+		"raw_name": "ACME  ",
+		"amount":   "12.5",
+		"posted":   "2024-01-31",
+		"status":   "STATUS_OPEN",
+		"active":   true,
+		"count":    int64(-12),
+		"sequence": uint64(7),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	// Blank fields and zero numbers are left out, but false is kept. Records
+	// are checked as Parse checks them.
+	got, err = parser.ParseMap([]byte("ACME  " + "      " + "        " + "ON" + "000" + "   "))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got["amount"]; ok || got["active"] != false || len(got) != 4 {
+		t.Errorf("got %#v", got)
+	}
+	if _, err := parser.ParseMap([]byte("ACME")); !errors.Is(err, ErrRecordLength) {
+		t.Errorf("expected ErrRecordLength, got %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package binfile
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Select returns a parser which decodes only the named fields, for jobs which
+// need a few columns of a wide record. The other fields are left unset and
+// are not checked. The record length, trailing data and coverage checks still
+// apply, but rules are not evaluated, as they may refer to fields which are
+// not decoded.
+func (p *MessageParser) Select(names ...protoreflect.Name) (*MessageParser, error) {
+	selected := map[protoreflect.Name]bool{}
+	for _, name := range names {
+		if _, ok := p.index[name]; !ok {
+			return nil, fmt.Errorf("field %s is not a flatfile field of %s", name, p.desc.FullName())
+		}
+		selected[name] = true
+	}
+
+	sp := &MessageParser{
+		desc:    p.desc,
+		ext:     p.ext,
+		index:   map[protoreflect.Name]int{},
+		width:   p.width,
+		spans:   p.spans,
+		end:     p.end,
+		charset: p.charset,
+	}
+	for _, field := range p.fields {
+		if selected[field.desc.Name()] {
+			sp.index[field.desc.Name()] = len(sp.fields)
+			sp.fields = append(sp.fields, field)
+		}
+	}
+	return sp, nil
+}
+
+// SelectMask is Select for the paths of a field mask. Paths must name top
+// level fields.
+func (p *MessageParser) SelectMask(mask *fieldmaskpb.FieldMask) (*MessageParser, error) {
+	names := make([]protoreflect.Name, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		name := protoreflect.Name(path)
+		if !name.IsValid() {
+			return nil, fmt.Errorf("field mask path %q is not a top level field", path)
+		}
+		names = append(names, name)
+	}
+	return p.Select(names...)
+}
+
+// ParseMessageMask parses only the fields in the mask, as
+// MessageParser.SelectMask does.
+func ParseMessageMask(msg proto.Message, data []byte, mask *fieldmaskpb.FieldMask) error {
+	return ParseMessage(msg, data, WithFieldMask(mask))
+}
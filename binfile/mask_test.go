@@ -0,0 +1,40 @@
+package binfile
+
+import (
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"github.com/pentops/j5/lib/j5codec"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestParseMessageMask(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { rules: [{ expression: "count > 0" }] };
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 }, raw_field: "code_raw" }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	  string name = 3 [(flatfile.v1.field) = { fixed_width: { offset: 5, length: 4 } }];
+	  string code_raw = 4;
+	`)
+
+	// The bad count and the rule on it are not checked when count is not
+	// selected.
+	msg := dynamicpb.NewMessage(msgDesc)
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"code", "name"}}
+	if err := ParseMessageMask(msg, []byte("ABxxxNAME"), mask); err != nil {
+		t.Fatal(err)
+	}
+	want := dynamicpb.NewMessage(msgDesc)
+	if err := j5codec.Global.JSONToProto([]byte(`{"code":"AB","name":"NAME","codeRaw":"AB"}`), want); err != nil {
+		t.Fatal(err)
+	}
+	prototest.AssertEqualProto(t, want, msg)
+
+	for _, paths := range [][]string{{"code_raw"}, {"nope"}, {"code.inner"}} {
+		mask := &fieldmaskpb.FieldMask{Paths: paths}
+		if err := ParseMessageMask(dynamicpb.NewMessage(msgDesc), []byte("AB001NAME"), mask); err == nil {
+			t.Errorf("expected error for paths %v", paths)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package binfile
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/flatfile/recordio"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ParseOption changes how one call to ParseMessage parses its record, for
+// callers which need to differ from the annotations of the message.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	// oneBased overrides the one_based option of the message when set.
+	oneBased *bool
+	charset  *recordio.Charset
+	mask     *fieldmaskpb.FieldMask
+
+	// severity overrides the on_error option of every field when set.
+	severity flatfile_pb.Severity
+	partial  bool
+
+	hooks []FieldHook
+}
+
+func newParseOptions(opts []ParseOption) parseOptions {
+	options := parseOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// WithOneBased reads the offsets of the fields as one based, or zero based
+// when false, whatever the one_based option of the message says.
+func WithOneBased(oneBased bool) ParseOption {
+	return func(o *parseOptions) {
+		o.oneBased = &oneBased
+	}
+}
+
+// WithCharset decodes the record from the charset before parsing it, for
+// EBCDIC records which have not been translated when they were read. Packed
+// decimal and binary fields are left as they are.
+func WithCharset(charset recordio.Charset) ParseOption {
+	return func(o *parseOptions) {
+		o.charset = &charset
+	}
+}
+
+// WithStrict fails the record on the errors of fields annotated with
+// SEVERITY_WARNING, as it does for other fields.
+func WithStrict() ParseOption {
+	return func(o *parseOptions) {
+		o.severity = flatfile_pb.Severity_SEVERITY_ERROR
+	}
+}
+
+// WithLenient treats every field as if annotated with SEVERITY_WARNING, so
+// that fields which fail are left unset rather than failing the record. The
+// errors are returned as warnings by ParseMessageWithWarnings.
+func WithLenient() ParseOption {
+	return func(o *parseOptions) {
+		o.severity = flatfile_pb.Severity_SEVERITY_WARNING
+	}
+}
+
+// WithCollectErrors parses every field it can rather than stopping at the
+// first failure, as ParseMessagePartial does.
+func WithCollectErrors() ParseOption {
+	return func(o *parseOptions) {
+		o.partial = true
+	}
+}
+
+// WithFieldMask parses only the fields in the mask, as
+// MessageParser.SelectMask does.
+func WithFieldMask(mask *fieldmaskpb.FieldMask) ParseOption {
+	return func(o *parseOptions) {
+		o.mask = mask
+	}
+}
+
+// withOptions returns the parser the options describe, which is the receiver
+// unless they override the offsets or select fields, and the record decoded
+// from its charset.
+func (p *MessageParser) withOptions(data []byte, options parseOptions) (*MessageParser, []byte, error) {
+	parser := p
+	if options.oneBased != nil && *options.oneBased != p.ext.GetOneBased() {
+		ext := &flatfile_pb.Message{}
+		if p.ext != nil {
+			ext = proto.Clone(p.ext).(*flatfile_pb.Message)
+		}
+		ext.OneBased = *options.oneBased
+		// The layout checks and record width follow the offset base.
+		derived := *p
+		derived.ext = ext
+		derived.spans = layoutSpans(p.desc, ext)
+		derived.end = layoutEnd(derived.spans)
+		derived.width = recordWidth(ext, p.fields)
+		parser = &derived
+	}
+
+	if charset := cmp.Or(options.charset, p.charset); charset != nil {
+		data = parser.decodeRecord(data, *charset)
+	}
+
+	if options.mask != nil {
+		selected, err := parser.SelectMask(options.mask)
+		if err != nil {
+			return nil, nil, err
+		}
+		parser = selected
+	}
+
+	if options.oneBased != nil && *options.oneBased {
+		for _, field := range parser.fields {
+			if field.tc.FixedWidth.Offset == 0 {
+				return nil, nil, fmt.Errorf("field %s is at offset 0, so the layout is not one based", field.desc.FullName())
+			}
+		}
+	}
+	return parser, data, nil
+}
+
+// decoded returns the record decoded from the charset of the parser's config,
+// or the record itself without one.
+func (p *MessageParser) decoded(data []byte) []byte {
+	if p.charset == nil {
+		return data
+	}
+	return p.decodeRecord(data, *p.charset)
+}
+
+// decodeRecord returns a copy of the record decoded from the charset, with
+// the bytes of its packed decimal and binary fields copied as they are.
+func (p *MessageParser) decodeRecord(data []byte, charset recordio.Charset) []byte {
+	text := make([]byte, len(data))
+	for idx, b := range data {
+		text[idx] = charset.DecodeByte(b)
+	}
+	p.copyBinary(text, data)
+	return text
+}
+
+// copyBinary copies the bytes of the packed decimal and binary fields of src,
+// which are not text in any charset, over those of dst.
+func (p *MessageParser) copyBinary(dst, src []byte) {
+	rr := NewReader(src, p.ext.GetOneBased())
+	for _, field := range p.fields {
+		switch field.tc.GetNumber().GetEncoding() {
+		case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL, flatfile_pb.Encoding_ENCODING_BINARY:
+		default:
+			continue
+		}
+		offset, length := rr.span(field.tc)
+		start, end := max(offset, 0), min(offset+length, len(src), len(dst))
+		if start < end {
+			copy(dst[start:end], src[start:end])
+		}
+	}
+}
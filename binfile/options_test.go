@@ -0,0 +1,105 @@
+package binfile
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pentops/flatfile/recordio"
+	"github.com/pentops/flowtest/prototest"
+	"github.com/pentops/j5/lib/j5codec"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestParseOptions(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 2 }, number: { encoding: ENCODING_PACKED_DECIMAL } }];
+	  string name = 3 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 4 } }];
+	  int32 total = 4 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 3 }, number: {}, on_error: SEVERITY_WARNING }];
+	`)
+
+	parse := func(t *testing.T, data []byte, opts ...ParseOption) (*dynamicpb.Message, []*FieldError, error) {
+		t.Helper()
+		msg := dynamicpb.NewMessage(msgDesc)
+		warnings, err := ParseMessageWithWarnings(msg, data, opts...)
+		return msg, warnings, err
+	}
+	assertJSON := func(t *testing.T, msg *dynamicpb.Message, wantJSON string) {
+		t.Helper()
+		want := dynamicpb.NewMessage(msgDesc)
+		if err := j5codec.Global.JSONToProto([]byte(wantJSON), want); err != nil {
+			t.Fatal(err)
+		}
+		prototest.AssertEqualProto(t, want, msg)
+	}
+
+	t.Run("one based", func(t *testing.T) {
+		// Offsets of 1 onwards read one byte earlier.
+		msg, _, err := parse(t, []byte("AB\x01\x2cNAME007"), WithOneBased(true), WithFieldMask(&fieldmaskpb.FieldMask{Paths: []string{"name"}}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertJSON(t, msg, `{ "name": ",NAM" }`)
+
+		// The code at offset 0 can't be one based.
+		if _, _, err := parse(t, []byte("AB\x01\x2cNAME007"), WithOneBased(true)); err == nil {
+			t.Error("expected an error for a field at offset 0")
+		}
+	})
+
+	t.Run("one based trailing", func(t *testing.T) {
+		trailing := singleMessage(t, `
+		  option (flatfile.v1.message) = { treat_trailing_as: TRAILING_IS_ERROR };
+		  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 2 } }];
+		`)
+		// One based, the code is the first two bytes and the third trails.
+		err := ParseMessage(dynamicpb.NewMessage(trailing), []byte("XAB"), WithOneBased(true))
+		if !errors.Is(err, ErrTrailingData) {
+			t.Errorf("expected ErrTrailingData, got %v", err)
+		}
+		if err := ParseMessage(dynamicpb.NewMessage(trailing), []byte("XA"), WithOneBased(true)); err != nil {
+			t.Errorf("expected no trailing data, got %v", err)
+		}
+	})
+
+	t.Run("charset", func(t *testing.T) {
+		ebcdic := []byte{0xc1, 0xc2, 0x01, 0x2c, 0xd5, 0xc1, 0xd4, 0xc5, 0xf0, 0xf0, 0xf7}
+		msg, _, err := parse(t, ebcdic, WithCharset(recordio.EBCDIC))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertJSON(t, msg, `{ "code": "AB", "count": 12, "name": "NAME", "total": 7 }`)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		if _, warnings, err := parse(t, []byte("AB\x01\x2cNAMExx7")); err != nil || len(warnings) != 1 {
+			t.Fatalf("expected one warning, got %v, %v", warnings, err)
+		}
+		_, _, err := parse(t, []byte("AB\x01\x2cNAMExx7"), WithStrict())
+		fieldErr := &FieldError{}
+		if !errors.As(err, &fieldErr) || fieldErr.Field.Name() != "total" {
+			t.Errorf("expected total to fail, got %v", err)
+		}
+	})
+
+	t.Run("lenient", func(t *testing.T) {
+		msg, warnings, err := parse(t, []byte("AB\xa1\x2cNAMExx7"), WithLenient())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(warnings) != 2 || warnings[0].Field.Name() != "count" || warnings[1].Field.Name() != "total" {
+			t.Errorf("expected count and total warnings, got %v", warnings)
+		}
+		assertJSON(t, msg, `{ "code": "AB", "name": "NAME" }`)
+	})
+
+	t.Run("collect errors", func(t *testing.T) {
+		msg, _, err := parse(t, []byte("AB\xa1\x2cNAMExx7"), WithCollectErrors(), WithStrict())
+		joined, ok := err.(interface{ Unwrap() []error })
+		if !ok || len(joined.Unwrap()) != 2 {
+			t.Fatalf("expected two errors, got %v", err)
+		}
+		assertJSON(t, msg, `{ "code": "AB", "name": "NAME" }`)
+	})
+}
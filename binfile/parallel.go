@@ -0,0 +1,159 @@
+package binfile
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// ParallelResult is one parsed record from ParseParallel.
+type ParallelResult struct {
+	// Record is the one based number of the record in the file.
+	Record int
+
+	// Message is the parsed record, partially populated when Err is set.
+	Message proto.Message
+
+	// Err is a *RecordError when the record failed to parse.
+	Err error
+}
+
+// ParallelOptions tunes ParseParallel.
+type ParallelOptions struct {
+	// Workers is the number of goroutines parsing records, default 4.
+	Workers int
+
+	// Window is the most records read ahead of the one being handled, which
+	// bounds memory when some records are slow to parse, default 16 per
+	// worker.
+	Window int
+}
+
+type parallelJob struct {
+	record int
+	offset int64
+	data   []byte
+	result chan ParallelResult
+}
+
+// ParseParallel reads newline delimited records from r and parses them across
+// a pool of workers, calling handle with each result in the order of the
+// file. handle is called from the calling goroutine, so it needs no locking.
+// Reading stops at the first error from r or handle, or when ctx is done.
+func ParseParallel(ctx context.Context, r io.Reader, parser *MessageParser, opts ParallelOptions, handle func(ParallelResult) error) error {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = 4
+	}
+	window := opts.Window
+	if window <= 0 {
+		window = workers * 16
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan *parallelJob, window)
+	// ordered holds the jobs in file order, its capacity bounds the number of
+	// records in flight.
+	ordered := make(chan *parallelJob, window)
+
+	var readErr error
+	go func() {
+		defer close(jobs)
+		defer close(ordered)
+		readErr = readParallelJobs(ctx, r, jobs, ordered)
+	}()
+
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				job.result <- parseParallelJob(parser, job)
+			}
+		}()
+	}
+	defer wg.Wait()
+
+	for job := range ordered {
+		var result ParallelResult
+		select {
+		case result = <-job.result:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err := handle(result); err != nil {
+			cancel()
+			drainJobs(ordered)
+			return err
+		}
+	}
+
+	if readErr != nil {
+		return readErr
+	}
+	return ctx.Err()
+}
+
+func readParallelJobs(ctx context.Context, r io.Reader, jobs, ordered chan<- *parallelJob) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(scanRecords)
+
+	record := 0
+	offset := int64(0)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		record++
+		job := &parallelJob{
+			record: record,
+			offset: offset,
+			data:   bytes.Clone(bytes.TrimSuffix(line, []byte("\r"))),
+			result: make(chan ParallelResult, 1),
+		}
+		offset += int64(len(line)) + 1
+
+		// The job is queued for ordering first, so that the handler never
+		// waits on a job which no worker can take.
+		select {
+		case ordered <- job:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		select {
+		case jobs <- job:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return scanner.Err()
+}
+
+func parseParallelJob(parser *MessageParser, job *parallelJob) ParallelResult {
+	msg := dynamicpb.NewMessage(parser.Descriptor())
+	result := ParallelResult{
+		Record:  job.record,
+		Message: msg,
+	}
+	if err := parser.Parse(msg, job.data); err != nil {
+		result.Err = &RecordError{
+			Record: job.record,
+			Offset: job.offset,
+			Raw:    job.data,
+			Err:    err,
+		}
+	}
+	return result
+}
+
+// drainJobs discards the remaining queued jobs so the reader can exit.
+func drainJobs(ordered <-chan *parallelJob) {
+	for range ordered {
+	}
+}
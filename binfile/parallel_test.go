@@ -0,0 +1,72 @@
+package binfile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseParallel(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  int32 seq = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 }, number: {} }];
+	`)
+
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := make([]string, 500)
+	for idx := range lines {
+		lines[idx] = fmt.Sprintf("%04d", idx+1)
+	}
+	lines[99] = "bad!"
+
+	seq := msgDesc.Fields().ByName("seq")
+	next := 1
+	err = ParseParallel(context.Background(), strings.NewReader(strings.Join(lines, "\n")), parser, ParallelOptions{
+		Workers: 3,
+		Window:  8,
+	}, func(result ParallelResult) error {
+		if result.Record != next {
+			return fmt.Errorf("got record %d, expected %d", result.Record, next)
+		}
+		next++
+
+		if result.Record == 100 {
+			recordErr := &RecordError{}
+			if !errors.As(result.Err, &recordErr) || recordErr.Offset != 99*5 {
+				return fmt.Errorf("expected RecordError at offset %d, got %v", 99*5, result.Err)
+			}
+			return nil
+		}
+		if result.Err != nil {
+			return result.Err
+		}
+		if got := result.Message.ProtoReflect().Get(seq).Int(); got != int64(result.Record) {
+			return fmt.Errorf("record %d has seq %d", result.Record, got)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 501 {
+		t.Errorf("expected 500 records, got %d", next-1)
+	}
+
+	stop := errors.New("stop")
+	handled := 0
+	err = ParseParallel(context.Background(), strings.NewReader(strings.Join(lines, "\n")), parser, ParallelOptions{}, func(result ParallelResult) error {
+		handled++
+		if handled == 10 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || handled != 10 {
+		t.Errorf("expected to stop after 10 records, got %d and %v", handled, err)
+	}
+}
@@ -2,13 +2,16 @@ package binfile
 
 import (
 	"errors"
+	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/pentops/flowtest/prototest"
 	"github.com/pentops/j5/lib/j5codec"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -101,10 +104,218 @@ func TestMultiType(t *testing.T) {
 	})
 }
 
+func TestRecordLength(t *testing.T) {
+	for _, tc := range []struct {
+		mode   string
+		record string
+		valid  bool
+	}{
+		{"LENGTH_MODE_UNSPECIFIED", "abcd", true},
+		{"LENGTH_MODE_UNSPECIFIED", "abcde", false},
+		{"LENGTH_MODE_EXACT", "abc", false},
+		{"LENGTH_MODE_MINIMUM", "abcdef", true},
+		{"LENGTH_MODE_MINIMUM", "abc", false},
+		{"LENGTH_MODE_MAXIMUM", "ab", true},
+		{"LENGTH_MODE_MAXIMUM", "abcde", false},
+	} {
+		t.Run(tc.mode+" "+tc.record, func(t *testing.T) {
+			msgDesc := singleMessage(t, `
+			  option (flatfile.v1.message) = { record_length: 4, record_length_mode: `+tc.mode+` };
+			  string code = 1 [(flatfile.v1.field) = {
+				fixed_width: { offset: 0, length: 2 }
+			  }];
+			`)
+
+			record := dynamicpb.NewMessage(msgDesc)
+			err := ParseMessage(record, []byte(tc.record))
+			if tc.valid && err != nil {
+				t.Fatalf("expected valid record, got %v", err)
+			}
+			if !tc.valid && !errors.Is(err, ErrRecordLength) {
+				t.Fatalf("expected ErrRecordLength, got %v", err)
+			}
+		})
+	}
+}
+
+func TestErrorClasses(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package errclass.v1;
+
+		import "flatfile/v1/annotations.proto";
+		import "j5/types/date/v1/date.proto";
+
+		message Record {
+		  Status status = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+		  j5.types.date.v1.Date date = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 1, length: 8 }
+			date: { format: "YYYYMMDD" }
+		  }];
+		  int32 count = 3 [(flatfile.v1.field) = { fixed_width: { offset: 9, length: 11 }, number: {} }];
+		  string code = 4 [(flatfile.v1.field) = {
+			fixed_width: { offset: 20, length: 2 }
+			string: { charset_class: CHARSET_CLASS_ALPHA }
+		  }];
+		}
+
+		enum Status {
+		  STATUS_UNSPECIFIED = 0;
+		  STATUS_ACTIVE = 1 [(flatfile.v1.enum).key = "A"];
+		}`})
+
+	msgDesc := fileDesc.MessageByName(t, "errclass.v1.Record")
+
+	for _, tc := range []struct {
+		record  []string
+		wantErr error
+	}{
+		{[]string{"Z", "20240101", "00000000001", "AB"}, ErrInvalidEnum},
+		{[]string{"A", "20241301", "00000000001", "AB"}, ErrInvalidDate},
+		{[]string{"A", "20240101", "0000000000x", "AB"}, ErrInvalidNumber},
+		{[]string{"A", "20240101", "99999999999", "AB"}, ErrOverflow},
+		{[]string{"A", "20240101", "00000000001", "A1"}, ErrInvalidString},
+		{[]string{"A", "20240101", "00000000001", "A"}, ErrShortRecord},
+	} {
+		err := runErr(t, msgDesc, tc.record)
+		if !errors.Is(err, tc.wantErr) {
+			t.Errorf("%q: expected %v, got %v", tc.record, tc.wantErr, err)
+		}
+	}
+}
+
+func TestParseMessagePartial(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	  string name = 3 [(flatfile.v1.field) = { fixed_width: { offset: 5, length: 4 } }];
+	  int32 total = 4 [(flatfile.v1.field) = { fixed_width: { offset: 9, length: 3 }, number: {} }];
+	`)
+
+	record := dynamicpb.NewMessage(msgDesc)
+	_, err := ParseMessagePartial(record, []byte("ABxx1NAMEyy2"))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	failed := []string{}
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		fieldErr := &FieldError{}
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("expected FieldError, got %v", err)
+		}
+		failed = append(failed, string(fieldErr.Field.Name()))
+	}
+	if strings.Join(failed, ",") != "count,total" {
+		t.Errorf("expected count and total to fail, got %v", failed)
+	}
+
+	want := dynamicpb.NewMessage(msgDesc)
+	if err := j5codec.Global.JSONToProto([]byte(`{ "code": "AB", "name": "NAME" }`), want); err != nil {
+		t.Fatal(err)
+	}
+	prototest.AssertEqualProto(t, want, record)
+}
+
+func TestParseMessagePartialRawField(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = {
+		fixed_width: { offset: 2, length: 3 }
+		number: {}
+		raw_field: "count_raw"
+	  }];
+	  string count_raw = 3;
+	  string name = 4 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+	`)
+
+	// The short raw field is collected with the other errors, and the fields
+	// after it are still parsed.
+	record := dynamicpb.NewMessage(msgDesc)
+	_, err := ParseMessagePartial(record, []byte("AB12"))
+	fieldErr := &FieldError{}
+	if !errors.As(err, &fieldErr) || fieldErr.Field.Name() != "count" || !errors.Is(err, ErrShortRecord) {
+		t.Fatalf("expected a short record error for count, got %v", err)
+	}
+
+	want := dynamicpb.NewMessage(msgDesc)
+	if err := j5codec.Global.JSONToProto([]byte(`{ "code": "AB", "name": "A" }`), want); err != nil {
+		t.Fatal(err)
+	}
+	prototest.AssertEqualProto(t, want, record)
+}
+
+func TestParseReflect(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { rules: [{ expression: "count > 0" }] };
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	`)
+
+	var refl protoreflect.Message = dynamicpb.NewMessage(msgDesc)
+	if err := ParseReflect(refl, []byte("AB012")); err != nil {
+		t.Fatal(err)
+	}
+	want := dynamicpb.NewMessage(msgDesc)
+	if err := j5codec.Global.JSONToProto([]byte(`{ "code": "AB", "count": 12 }`), want); err != nil {
+		t.Fatal(err)
+	}
+	prototest.AssertEqualProto(t, want, refl.Interface())
+
+	// Options and the rules of the message apply as they do to ParseMessage.
+	if err := ParseReflect(dynamicpb.NewMessage(msgDesc), []byte("AB000")); !errors.Is(err, ErrRule) {
+		t.Errorf("expected ErrRule, got %v", err)
+	}
+	refl = dynamicpb.NewMessage(msgDesc)
+	if err := ParseReflect(refl, []byte("ABxxx"), WithFieldMask(&fieldmaskpb.FieldMask{Paths: []string{"code"}})); err != nil {
+		t.Fatal(err)
+	}
+	if got := refl.Get(msgDesc.Fields().ByName("code")).String(); got != "AB" {
+		t.Errorf("got code %q", got)
+	}
+
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refl = dynamicpb.NewMessage(msgDesc)
+	if err := parser.ParseReflect(refl, []byte("AB012")); err != nil {
+		t.Fatal(err)
+	}
+	prototest.AssertEqualProto(t, want, refl.Interface())
+}
+
+func TestMessageDefaults(t *testing.T) {
+	msgDesc := singleMessage(t, prototest.WithMessageImports("j5/types/date/v1/date.proto"), `
+	  option (flatfile.v1.message) = { default_date_format: "YYYYMMDD", default_trim: TRIM_BOTH };
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 4 } }];
+	  string name = 2 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 4 }, string: { trim: TRIM_LEFT } }];
+	  j5.types.date.v1.Date opened = 3 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 8 } }];
+	  j5.types.date.v1.Date closed = 4 [(flatfile.v1.field) = { fixed_width: { offset: 16, length: 6 }, date: { format: "DDMMYY" } }];
+	  j5.types.date.v1.Date updated = 5 [(flatfile.v1.field) = { fixed_width: { offset: 22, length: 8 }, date: { zero_vals: ["99999999"] } }];
+	`)
+
+	if err := ValidateMessageDescriptor(msgDesc); err != nil {
+		t.Fatal(err)
+	}
+
+	// Fields which set their own options keep them.
+	runCmp(t, msgDesc, []string{" AB ", " CD ", "20240131", "310124", "99999999"}, `{
+		"code": "AB",
+		"name": "CD ",
+		"opened": "2024-01-31",
+		"closed": "2024-01-31"
+	}`)
+
+	if layout := DescribeLayout(msgDesc); layout.Fields[2].DateFormat != "YYYYMMDD" {
+		t.Errorf("got layout %+v", layout.Fields[2])
+	}
+}
+
 func TestTypes(t *testing.T) {
 
 	t.Run("Invalid Bool", func(t *testing.T) {
-		msgDesc := prototest.SingleMessage(t, `
+		msgDesc := singleMessage(t, `
 		  bool flagged = 4 [(flatfile.v1.field) = {
 			fixed_width: {
 			  offset: 0
@@ -125,8 +336,211 @@ func TestTypes(t *testing.T) {
 		}
 	})
 
+	t.Run("Field Error", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  option (flatfile.v1.message).one_based = true;
+		  string name = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 1, length: 3 }
+		  }];
+		  int32 count = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 4, length: 4 }
+			number: {}
+		  }];
+		  `)
+
+		err := runErr(t, msgDesc, []string{"abc", "12x4"})
+
+		fieldErr := &FieldError{}
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("expected FieldError, got %T %v", err, err)
+		}
+		if fieldErr.Field.Name() != "count" {
+			t.Errorf("expected field count, got %s", fieldErr.Field)
+		}
+		if fieldErr.Offset != 3 || fieldErr.Length != 4 {
+			t.Errorf("expected offset 3 length 4, got %d %d", fieldErr.Offset, fieldErr.Length)
+		}
+		if string(fieldErr.Raw) != "12x4" {
+			t.Errorf("expected raw '12x4', got %q", fieldErr.Raw)
+		}
+
+		err = runErr(t, msgDesc, []string{"abc", "12"})
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("expected FieldError, got %T %v", err, err)
+		}
+		if string(fieldErr.Raw) != "12" {
+			t.Errorf("expected truncated raw '12', got %q", fieldErr.Raw)
+		}
+	})
+
+	t.Run("Binary Field Error Context", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string name = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 10 }
+		  }];
+		  int32 amount = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 10, length: 3 }
+			number: { encoding: ENCODING_PACKED_DECIMAL }
+		  }];
+		  string code = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 13, length: 2 }
+		  }];
+		  `)
+
+		err := runErr(t, msgDesc, []string{"0123456789", "\x12\xA3\x4C", "XY"})
+
+		fieldErr := &FieldError{}
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("expected FieldError, got %T %v", err, err)
+		}
+		if fieldErr.ContextOffset != 2 || string(fieldErr.Context) != "23456789\x12\xA3\x4CXY" {
+			t.Errorf("expected context from offset 2, got %d %q", fieldErr.ContextOffset, fieldErr.Context)
+		}
+		if !strings.Contains(err.Error(), "32 33 34 35 36 37 38 39 12 a3 4c 58 59") {
+			t.Errorf("expected hex bytes in error, got %v", err)
+		}
+		wantDump := "00000002  32 33 34 35 36 37 38 39 12 a3 4c 58 59           |23456789..LXY|\n"
+		if got := fieldErr.HexDump(); got != wantDump {
+			t.Errorf("unexpected hex dump:\n%s\nwant:\n%s", got, wantDump)
+		}
+	})
+
+	t.Run("Date Empty Values", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/types/date/v1/date.proto"),
+			`
+		  j5.types.date.v1.Date due = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 10 }
+			date: { format: "YYYY-MM-DD", zero_vals: ["9999-12-31"] }
+		  }];
+		  `)
+
+		for _, empty := range []string{"          ", "0000-00-00", "    -  -  ", "9999-12-31"} {
+			runCmp(t, msgDesc, []string{empty}, `{}`)
+		}
+		runCmp(t, msgDesc, []string{"2024-02-29"}, `{ "due": "2024-02-29" }`)
+		runErr(t, msgDesc, []string{"0000-00-01"})
+	})
+
+	t.Run("Warning Severity", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/types/date/v1/date.proto"),
+			`
+		  string name = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 3 }
+		  }];
+		  j5.types.date.v1.Date due = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 3, length: 8 }
+			date: { format: "YYYYMMDD" }
+			on_error: SEVERITY_WARNING
+		  }];
+		  int32 count = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 11, length: 2 }
+			number: {}
+		  }];
+		  `)
+
+		record := dynamicpb.NewMessage(msgDesc)
+		warnings, err := ParseMessageWithWarnings(record, []byte("abc2023134512"))
+		if err != nil {
+			t.Fatalf("error parsing record: %v", err)
+		}
+		if len(warnings) != 1 || warnings[0].Field.Name() != "due" {
+			t.Fatalf("expected one warning for due, got %v", warnings)
+		}
+		if record.Has(msgDesc.Fields().ByName("due")) {
+			t.Fatalf("expected due to be unset")
+		}
+
+		runCmp(t, msgDesc, []string{"abc", "20231345", "12"}, `{ "name": "abc", "count": 12 }`)
+		runErr(t, msgDesc, []string{"abc", "20231345", "1x"})
+	})
+
+	t.Run("Warning Severity Raw Field", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string code = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 2 }
+		  }];
+		  int32 count = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 2, length: 3 }
+			number: {}
+			raw_field: "count_raw"
+			on_error: SEVERITY_WARNING
+		  }];
+		  string count_raw = 3;
+		  `)
+
+		// The raw text of a short record fails as the value does, as a
+		// warning.
+		record := dynamicpb.NewMessage(msgDesc)
+		warnings, err := ParseMessageWithWarnings(record, []byte("AB1"))
+		if err != nil {
+			t.Fatalf("error parsing record: %v", err)
+		}
+		if len(warnings) != 1 || warnings[0].Field.Name() != "count" || !errors.Is(warnings[0], ErrShortRecord) {
+			t.Fatalf("expected a short record warning for count, got %v", warnings)
+		}
+		runCmp(t, msgDesc, []string{"AB", "012"}, `{ "code": "AB", "count": 12, "countRaw": "012" }`)
+	})
+
+	t.Run("Short Record Policy", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string code = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 2 }
+		  }];
+		  string note = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 2, length: 4 }
+			string: { trim: TRIM_RIGHT }
+			treat_short_as: SHORT_IS_PADDED
+		  }];
+		  int32 count = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 6, length: 3 }
+			number: {}
+			treat_short_as: SHORT_IS_UNSET
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"AB", "xyz ", "012"}, `{ "code": "AB", "note": "xyz", "count": 12 }`)
+		runCmp(t, msgDesc, []string{"AB", "xyz ", "01"}, `{ "code": "AB", "note": "xyz" }`)
+		runCmp(t, msgDesc, []string{"AB", "x"}, `{ "code": "AB", "note": "x" }`)
+		runCmp(t, msgDesc, []string{"AB"}, `{ "code": "AB", "note": "" }`)
+		runErr(t, msgDesc, []string{"A"})
+	})
+
+	t.Run("Required", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string code = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 2 }
+			required: true
+		  }];
+		  int32 count = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 2, length: 3 }
+			number: {}
+			required: true
+		  }];
+		  int32 flags = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 5, length: 1 }
+			number: { encoding: ENCODING_BINARY }
+			required: true
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"AB", "000", "\x00"}, `{ "code": "AB", "count": 0 }`)
+
+		for _, record := range [][]string{
+			{"  ", "001", "\x00"},
+			{"AB", "\x00\x00\x00", "\x00"},
+			{"AB", "001"},
+		} {
+			err := runErr(t, msgDesc, record)
+			if !errors.Is(err, ErrRequired) {
+				t.Errorf("expected ErrRequired for %q, got %v", record, err)
+			}
+		}
+	})
+
 	t.Run("Bool Missing Is False", func(t *testing.T) {
-		msgDesc := prototest.SingleMessage(t, `
+		msgDesc := singleMessage(t, `
 		  bool flagged = 4 [(flatfile.v1.field) = {
 			fixed_width: {
 			  offset: 0
@@ -145,8 +559,190 @@ func TestTypes(t *testing.T) {
 		runCmp(t, msgDesc, []string{"X"}, `{ "flagged": true }`)
 	})
 
+	t.Run("Bool Case Insensitive", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  bool flagged = 4 [(flatfile.v1.field) = {
+			fixed_width: {
+			  offset: 0
+			  length: 1
+			}
+			bool: {
+			  true_values: ["Y"]
+			  false_values: ["N"]
+			  treat_missing_as: MISSING_IS_ERROR
+			  case_insensitive: true
+			}
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"y"}, `{ "flagged": true }`)
+		runCmp(t, msgDesc, []string{"Y"}, `{ "flagged": true }`)
+		runCmp(t, msgDesc, []string{"n"}, `{ "flagged": false }`)
+		runErr(t, msgDesc, []string{"x"})
+	})
+
+	t.Run("Bool Trim", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  bool flagged = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 2 }
+			bool: {
+			  true_values: ["Y"]
+			  false_values: ["N", ""]
+			  treat_missing_as: MISSING_IS_ERROR
+			  trim: TRIM_BOTH
+			}
+		  }];
+		  bool defaulted = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 2, length: 2 }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"Y ", " Y"}, `{ "flagged": true, "defaulted": true }`)
+		runCmp(t, msgDesc, []string{" N", "N "}, `{ "flagged": false, "defaulted": false }`)
+		runCmp(t, msgDesc, []string{"  ", "1 "}, `{ "flagged": false, "defaulted": true }`)
+		runErr(t, msgDesc, []string{"Y ", "  "})
+	})
+
+	t.Run("Enum Trim Case Insensitive", func(t *testing.T) {
+		fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+			syntax = "proto3";
+			package enums.v1;
+
+			import "flatfile/v1/annotations.proto";
+
+			message Record {
+			  Status status = 1 [(flatfile.v1.field) = {
+				fixed_width: { offset: 0, length: 3 }
+				enum: { trim: TRIM_BOTH, case_insensitive: true }
+			  }];
+			  Status strict = 2 [(flatfile.v1.field) = {
+				fixed_width: { offset: 3, length: 3 }
+			  }];
+			}
+
+			enum Status {
+			  STATUS_UNSPECIFIED = 0;
+			  STATUS_ACTIVE = 1 [(flatfile.v1.enum).key = "AC"];
+			  STATUS_CLOSED = 2 [(flatfile.v1.enum).key = "CL"];
+			}`})
+
+		msgDesc := fileDesc.MessageByName(t, "enums.v1.Record")
+
+		runCmp(t, msgDesc, []string{"ac ", "   "}, `{ "status": "ACTIVE" }`)
+		runCmp(t, msgDesc, []string{" Cl", "   "}, `{ "status": "CLOSED" }`)
+		runErr(t, msgDesc, []string{"AC ", "cl "})
+	})
+
+	t.Run("Enum Unknown Fallback", func(t *testing.T) {
+		fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+			syntax = "proto3";
+			package fallback.v1;
+
+			import "flatfile/v1/annotations.proto";
+
+			message Record {
+			  Status zeroed = 1 [(flatfile.v1.field) = {
+				fixed_width: { offset: 0, length: 2 }
+				enum: { treat_unknown_as: UNKNOWN_IS_ZERO }
+			  }];
+			  Status other = 2 [(flatfile.v1.field) = {
+				fixed_width: { offset: 2, length: 2 }
+				enum: { treat_unknown_as: UNKNOWN_IS_FALLBACK, fallback_value: "STATUS_OTHER" }
+				raw_field: "other_raw"
+			  }];
+			  string other_raw = 3;
+			}
+
+			enum Status {
+			  STATUS_UNSPECIFIED = 0;
+			  STATUS_ACTIVE = 1 [(flatfile.v1.enum).key = "AC"];
+			  STATUS_OTHER = 2;
+			}`})
+
+		msgDesc := fileDesc.MessageByName(t, "fallback.v1.Record")
+
+		runCmp(t, msgDesc, []string{"AC", "AC"}, `{ "zeroed": "ACTIVE", "other": "ACTIVE", "otherRaw": "AC" }`)
+		runCmp(t, msgDesc, []string{"ZZ", "ZZ"}, `{ "other": "OTHER", "otherRaw": "ZZ" }`)
+	})
+
+	t.Run("Enum Raw Code", func(t *testing.T) {
+		fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+			syntax = "proto3";
+			package rawcode.v1;
+
+			import "flatfile/v1/annotations.proto";
+
+			message Record {
+			  Status status = 1 [(flatfile.v1.field) = {
+				fixed_width: { offset: 0, length: 3 }
+				enum: { trim: TRIM_BOTH }
+				raw_field: "status_code"
+			  }];
+			  string status_code = 2;
+			}
+
+			enum Status {
+			  STATUS_UNSPECIFIED = 0;
+			  STATUS_ACTIVE = 1 [(flatfile.v1.enum).key = "AC"];
+			}`})
+
+		msgDesc := fileDesc.MessageByName(t, "rawcode.v1.Record")
+		codeField := msgDesc.Fields().ByName("status_code")
+
+		runCmp(t, msgDesc, []string{"AC "}, `{ "status": "ACTIVE", "statusCode": "AC " }`)
+
+		record := dynamicpb.NewMessage(msgDesc)
+		if err := ParseMessage(record, []byte("XY ")); err == nil {
+			t.Fatalf("expected error for unknown code")
+		}
+		if got := record.Get(codeField).String(); got != "XY " {
+			t.Fatalf("expected raw code 'XY ' after failure, got %q", got)
+		}
+	})
+
+	t.Run("BoolValue Null Values", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("google/protobuf/wrappers.proto"),
+			`
+		  google.protobuf.BoolValue flagged = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 1 }
+			bool: {
+			  true_values: ["Y"]
+			  false_values: ["N"]
+			  null_values: [" ", "?"]
+			  treat_missing_as: MISSING_IS_ERROR
+			}
+		  }];
+		  `)
+
+		flaggedField := msgDesc.Fields().ByName("flagged")
+		for in, want := range map[string]*wrapperspb.BoolValue{
+			"Y": wrapperspb.Bool(true),
+			"N": wrapperspb.Bool(false),
+			" ": nil,
+			"?": nil,
+		} {
+			record := dynamicpb.NewMessage(msgDesc)
+			if err := ParseMessage(record, []byte(in)); err != nil {
+				t.Fatalf("error parsing record %q: %v", in, err)
+			}
+			if want == nil {
+				if record.Has(flaggedField) {
+					t.Fatalf("expected flagged to be unset for %q", in)
+				}
+				continue
+			}
+			prototest.AssertEqualProto(t, want, record.Get(flaggedField).Message().Interface())
+		}
+
+		err := runErr(t, msgDesc, []string{"X"})
+		if !errors.Is(err, ErrMissingBool) {
+			t.Fatalf("expected ErrMissingBool, got %v", err)
+		}
+	})
+
 	t.Run("Decimal", func(t *testing.T) {
-		msgDesc := prototest.SingleMessage(t,
+		msgDesc := singleMessage(t,
 			prototest.WithMessageImports("j5/types/decimal/v1/decimal.proto"),
 			`
 		  j5.types.decimal.v1.Decimal amount = 1 [(flatfile.v1.field) = {
@@ -159,8 +755,394 @@ func TestTypes(t *testing.T) {
 		runCmp(t, msgDesc, []string{"    123.45"}, `{ "amount": "123.45" }`)
 	})
 
+	t.Run("Decimal Signs", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/types/decimal/v1/decimal.proto"),
+			`
+		  j5.types.decimal.v1.Decimal amount = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			number: { }
+		  }];
+		  `)
+
+		for _, tc := range []struct {
+			input string
+			want  string
+		}{
+			{input: "+  12.50", want: "12.5"},
+			{input: "  -012.5", want: "-12.5"},
+			{input: "-   0.50", want: "-0.5"},
+			{input: "+    .25", want: "0.25"},
+			{input: "+0000000", want: "0"},
+		} {
+			runCmp(t, msgDesc, []string{tc.input}, fmt.Sprintf(`{ "amount": %q }`, tc.want))
+		}
+		runErr(t, msgDesc, []string{"+ 12.5.0"})
+	})
+
+	t.Run("Decimal Fixed Scale", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/types/decimal/v1/decimal.proto"),
+			`
+		  j5.types.decimal.v1.Decimal amount = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			number: { fixed_scale: 2 }
+		  }];
+		  j5.types.decimal.v1.Decimal packed = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 8, length: 4 }
+			number: { encoding: ENCODING_PACKED_DECIMAL, fixed_scale: 2 }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"00012345", "\x00\x12\x34\x5D"}, `{ "amount": "123.45", "packed": "-123.45" }`)
+		// A decimal point in the data is taken as it is.
+		runCmp(t, msgDesc, []string{"00012.50", "\x00\x00\x00\x5C"}, `{ "amount": "12.5", "packed": "0.05" }`)
+	})
+
+	t.Run("Decimal Max Digits", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/types/decimal/v1/decimal.proto"),
+			`
+		  j5.types.decimal.v1.Decimal amount = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 10 }
+			number: { max_digits: 5, max_fraction_digits: 2 }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"0000123.45"}, `{ "amount": "123.45" }`)
+		runCmp(t, msgDesc, []string{"   -123.45"}, `{ "amount": "-123.45" }`)
+		runCmp(t, msgDesc, []string{"     12.3 "}, `{ "amount": "12.3" }`)
+		runErr(t, msgDesc, []string{"0001234.56"})
+		runErr(t, msgDesc, []string{"00012.3456"})
+	})
+
+	t.Run("Integer Max Fraction Digits", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  int64 count = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 6 }
+			number: { max_fraction_digits: 0 }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"000012"}, `{ "count": "12" }`)
+		runErr(t, msgDesc, []string{"0012.0"})
+	})
+
+	t.Run("Decimal No Fraction Digits", func(t *testing.T) {
+		// A decimal point is rejected by max_fraction_digits, where the
+		// decimal would otherwise accept it.
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/types/decimal/v1/decimal.proto"),
+			`
+		  j5.types.decimal.v1.Decimal amount = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 6 }
+			number: { max_fraction_digits: 0 }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"000012"}, `{ "amount": "12" }`)
+		err := runErr(t, msgDesc, []string{"0012.0"})
+		if !errors.Is(err, ErrInvalidNumber) {
+			t.Fatalf("expected ErrInvalidNumber, got %v", err)
+		}
+	})
+
+	t.Run("Number Salvage", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/types/decimal/v1/decimal.proto"),
+			`
+		  j5.types.decimal.v1.Decimal amount = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 10 }
+			number: { policy: NUMBER_POLICY_SALVAGE }
+			raw_field: "amount_raw"
+		  }];
+		  int32 count = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 10, length: 5 }
+			number: { policy: NUMBER_POLICY_SALVAGE }
+		  }];
+		  string amount_raw = 3;
+		  `)
+
+		runCmp(t, msgDesc, []string{"$1,234.50 ", " 12- "}, `{
+			"amount": "1234.50",
+			"amountRaw": "$1,234.50 ",
+			"count": -12
+		}`)
+
+		runCmp(t, msgDesc, []string{"    N/A   ", "     "}, `{
+			"amountRaw": "    N/A   "
+		}`)
+
+		msgDesc = singleMessage(t, `
+		  int32 count = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 5 }
+			number: { policy: NUMBER_POLICY_STRICT }
+		  }];
+		  `)
+		runErr(t, msgDesc, []string{" 12- "})
+	})
+
+	t.Run("Checksum", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string data = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 9 }
+		  }];
+		  string crc32 = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 9, length: 8 }
+			checksum: { algorithm: CHECKSUM_ALGORITHM_CRC32, range: { offset: 0, length: 9 }, hex: true }
+		  }];
+		  string crc16 = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 17, length: 4 }
+			checksum: { algorithm: CHECKSUM_ALGORITHM_CRC16, range: { offset: 0, length: 9 }, hex: true }
+		  }];
+		  uint32 sum = 4 [(flatfile.v1.field) = {
+			fixed_width: { offset: 21, length: 3 }
+			number: {}
+			checksum: { algorithm: CHECKSUM_ALGORITHM_SUM, range: { offset: 0, length: 9 } }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"123456789", "CBF43926", "29b1", "221"}, `{
+			"data": "123456789",
+			"crc32": "CBF43926",
+			"crc16": "29b1",
+			"sum": 221
+		}`)
+
+		for _, in := range [][]string{
+			{"123456780", "CBF43926", "29B1", "221"},
+			{"123456789", "CBF43926", "29B2", "221"},
+			{"123456789", "CBF43926", "29B1", "220"},
+		} {
+			err := runErr(t, msgDesc, in)
+			if !errors.Is(err, ErrChecksum) {
+				t.Errorf("expected ErrChecksum for %q, got %v", in, err)
+			}
+		}
+	})
+
+	t.Run("Check Digit", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string pan = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 16 }
+			check_digit: CHECK_DIGIT_LUHN
+		  }];
+		  string routing = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 16, length: 9 }
+			check_digit: CHECK_DIGIT_ABA
+		  }];
+		  string policy = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 25, length: 10 }
+			check_digit: CHECK_DIGIT_MOD11
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"4111111111111111", "011000015", "080442957X"}, `{
+			"pan": "4111111111111111",
+			"routing": "011000015",
+			"policy": "080442957X"
+		}`)
+
+		runCmp(t, msgDesc, []string{"                ", "         ", "0306406152"}, `{
+			"pan": "                ",
+			"routing": "         ",
+			"policy": "0306406152"
+		}`)
+
+		for _, in := range [][]string{
+			{"4111111111111112", "011000015", "0306406152"},
+			{"4111111111111111", "011000016", "0306406152"},
+			{"4111111111111111", "011000015", "0306406153"},
+			{"4111111111111111", "0110A0015", "0306406152"},
+		} {
+			err := runErr(t, msgDesc, in)
+			if !errors.Is(err, ErrCheckDigit) {
+				t.Fatalf("expected ErrCheckDigit, got %v", err)
+			}
+		}
+	})
+
+	t.Run("String Pattern", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string zip = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 10 }
+			string: { trim: TRIM_BOTH, pattern: "^[0-9]{5}(-[0-9]{4})?$" }
+		  }];
+		  string code = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 10, length: 4 }
+			string: { trim: TRIM_RIGHT, pattern: "^[A-Z]+$" }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"12345     ", "AB  "}, `{ "zip": "12345", "code": "AB" }`)
+		runCmp(t, msgDesc, []string{"12345-6789", "    "}, `{ "zip": "12345-6789" }`)
+		runCmp(t, msgDesc, []string{"          ", "    "}, `{}`)
+		runErr(t, msgDesc, []string{"1234X     ", "AB  "})
+		runErr(t, msgDesc, []string{"12345     ", "A1  "})
+
+		// Patterns are compiled with the parser, not for each record.
+		msgDesc = singleMessage(t, `
+		  string code = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 4 }
+			string: { pattern: "[" }
+		  }];
+		  `)
+		if _, err := Compile(msgDesc); err == nil || !strings.Contains(err.Error(), "invalid pattern") {
+			t.Fatalf("expected an invalid pattern error, got %v", err)
+		}
+	})
+
+	t.Run("String Charset Class", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string num = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 4 }
+			string: { trim: TRIM_BOTH, charset_class: CHARSET_CLASS_NUMERIC }
+		  }];
+		  string alpha = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 4, length: 4 }
+			string: { trim: TRIM_BOTH, charset_class: CHARSET_CLASS_ALPHA }
+		  }];
+		  string alnum = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 8, length: 4 }
+			string: { charset_class: CHARSET_CLASS_ALPHANUMERIC }
+		  }];
+		  string print = 4 [(flatfile.v1.field) = {
+			fixed_width: { offset: 12, length: 4 }
+			string: { charset_class: CHARSET_CLASS_PRINTABLE }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"0123", "Ab c", "a1 B", "#$%&"}, `{
+			"num": "0123",
+			"alpha": "Ab c",
+			"alnum": "a1 B",
+			"print": "#$%&"
+		}`)
+		runErr(t, msgDesc, []string{"01 3", "Ab c", "a1 B", "#$%&"})
+		runErr(t, msgDesc, []string{"0123", "Ab1c", "a1 B", "#$%&"})
+		runErr(t, msgDesc, []string{"0123", "Ab c", "a1-B", "#$%&"})
+		runErr(t, msgDesc, []string{"0123", "Ab c", "a1 B", "#$\x00&"})
+	})
+
+	t.Run("String Mapping", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string account_type = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 2 }
+			string: {
+			  mapping: [{key: "01", value: "CHECKING"}, {key: "02", value: "SAVINGS"}]
+			  mapping_required: true
+			}
+		  }];
+		  string region = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 2, length: 3 }
+			string: {
+			  trim: TRIM_RIGHT
+			  mapping: [{key: "E", value: "EAST"}, {key: "", value: "NONE"}]
+			}
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"01", "E  "}, `{ "accountType": "CHECKING", "region": "EAST" }`)
+		runCmp(t, msgDesc, []string{"02", "   "}, `{ "accountType": "SAVINGS", "region": "NONE" }`)
+		runCmp(t, msgDesc, []string{"01", "W  "}, `{ "accountType": "CHECKING", "region": "W" }`)
+		runErr(t, msgDesc, []string{"03", "E  "})
+	})
+
+	t.Run("String Normalize", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string upper = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 4 }
+			string: { trim: TRIM_RIGHT, normalize: NORMALIZE_UPPER, mapping: [{key: "AB", value: "Alpha Bravo"}] }
+		  }];
+		  string lower = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 4, length: 4 }
+			string: { normalize: NORMALIZE_LOWER }
+		  }];
+		  string title = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 8, length: 20 }
+			string: { trim: TRIM_RIGHT, normalize: NORMALIZE_TITLE }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"ab", "  ", "AbCd", "o'NEIL-SMITH mary   "}, `{
+			"upper": "Alpha Bravo",
+			"lower": "abcd",
+			"title": "O'neil-Smith Mary"
+		}`)
+	})
+
+	t.Run("String Collapse Spaces", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string desc = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 20 }
+			string: { trim: TRIM_BOTH, collapse_spaces: true }
+		  }];
+		  string raw = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 20, length: 6 }
+			string: { collapse_spaces: true }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"  ACME   WIDGETS  CO", "A  B  "}, `{
+			"desc": "ACME WIDGETS CO",
+			"raw": "A B "
+		}`)
+	})
+
+	t.Run("String Trim Padding", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string name = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 6 }
+			string: { trim: TRIM_RIGHT_PADDING }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{"AB    "}, `{ "name": "AB" }`)
+		runCmp(t, msgDesc, []string{"AB\x00\x00\x00\x00"}, `{ "name": "AB" }`)
+		runCmp(t, msgDesc, []string{"A B\x00 \x00"}, `{ "name": "A B" }`)
+		runCmp(t, msgDesc, []string{" AB   "}, `{ "name": " AB" }`)
+	})
+
+	t.Run("String Length", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  string ssn = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 11 }
+			string: { trim: TRIM_BOTH, min_length: 9, max_length: 9 }
+		  }];
+		  string code = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 11, length: 6 }
+			string: { trim: TRIM_RIGHT, max_length: 4 }
+		  }];
+		  `)
+
+		runCmp(t, msgDesc, []string{" 123456789 ", "ABCD  "}, `{ "ssn": "123456789", "code": "ABCD" }`)
+		runCmp(t, msgDesc, []string{"           ", "      "}, `{}`)
+		runErr(t, msgDesc, []string{" 12345678  ", "ABCD  "})
+		runErr(t, msgDesc, []string{"1234567890 ", "ABCD  "})
+		runErr(t, msgDesc, []string{" 123456789 ", "ABCDE "})
+	})
+
+	t.Run("UUID Key", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/ext/v1/annotations.proto"),
+			`
+		  string id = 1 [
+			(j5.ext.v1.field).key.format = FORMAT_UUID,
+			(flatfile.v1.field) = {
+			  fixed_width: { offset: 0, length: 36 }
+			}
+		  ];
+		  `)
+
+		runCmp(t, msgDesc, []string{"0F8FAD5B-D9CB-469F-A165-70867728950E"}, `{ "id": "0f8fad5b-d9cb-469f-a165-70867728950e" }`)
+		runCmp(t, msgDesc, []string{"0f8fad5bd9cb469fa16570867728950e    "}, `{ "id": "0f8fad5b-d9cb-469f-a165-70867728950e" }`)
+		runCmp(t, msgDesc, []string{"                                    "}, `{}`)
+		runErr(t, msgDesc, []string{"0f8fad5bd9cb469fa16570867728950    "})
+		runErr(t, msgDesc, []string{"{0f8fad5b-d9cb-469f-a165-70867728950"})
+	})
+
 	t.Run("StringValue", func(t *testing.T) {
-		msgDesc := prototest.SingleMessage(t,
+		msgDesc := singleMessage(t,
 			prototest.WithMessageImports("google/protobuf/wrappers.proto"),
 			`
 		  google.protobuf.StringValue note = 1 [(flatfile.v1.field) = {
@@ -194,8 +1176,47 @@ func TestTypes(t *testing.T) {
 		}
 	})
 
+	t.Run("String Blank Policy", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("google/protobuf/wrappers.proto"),
+			`
+		  optional string plain = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 3 }
+			string: { trim: TRIM_BOTH, treat_blank_as: BLANK_IS_UNSET }
+		  }];
+		  google.protobuf.StringValue wrapped = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 3, length: 3 }
+			string: { trim: TRIM_BOTH, treat_blank_as: BLANK_IS_EMPTY }
+		  }];
+		  `)
+
+		plainField := msgDesc.Fields().ByName("plain")
+		wrappedField := msgDesc.Fields().ByName("wrapped")
+
+		record := dynamicpb.NewMessage(msgDesc)
+		if err := ParseMessage(record, []byte("      ")); err != nil {
+			t.Fatalf("error parsing record: %v", err)
+		}
+		if record.Has(plainField) {
+			t.Fatalf("expected plain field to be unset for blank value")
+		}
+		if !record.Has(wrappedField) {
+			t.Fatalf("expected wrapped field to be set for blank value")
+		}
+		prototest.AssertEqualProto(t, wrapperspb.String(""), record.Get(wrappedField).Message().Interface())
+
+		record = dynamicpb.NewMessage(msgDesc)
+		if err := ParseMessage(record, []byte(" a  b ")); err != nil {
+			t.Fatalf("error parsing record: %v", err)
+		}
+		if got := record.Get(plainField).String(); got != "a" {
+			t.Fatalf("expected plain field 'a', got %q", got)
+		}
+		prototest.AssertEqualProto(t, wrapperspb.String("b"), record.Get(wrappedField).Message().Interface())
+	})
+
 	t.Run("Numeric Types String Encoded", func(t *testing.T) {
-		msgDesc := prototest.SingleMessage(t, `
+		msgDesc := singleMessage(t, `
 		  uint32 u32 = 1 [(flatfile.v1.field) = {
 			fixed_width: { offset: 0, length: 4 }
 			number: {}
@@ -224,8 +1245,81 @@ func TestTypes(t *testing.T) {
 		runCmp(t, msgDesc, []string{"    ", "    ", "    ", "    "}, `{}`)
 	})
 
+	t.Run("Numeric Types Leading Sign", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  int32 i32 = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 6 }
+			number: {}
+		  }];
+		  int64 i64 = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 6, length: 6 }
+			number: {}
+		  }];
+		  uint32 u32 = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 12, length: 6 }
+			number: {}
+		  }];
+		`)
+
+		runCmp(t, msgDesc, []string{"+00123", "-00045", "+00006"}, `{
+			"i32": 123,
+			"i64": "-45",
+			"u32": 6
+		}`)
+
+		runCmp(t, msgDesc, []string{"+  123", "  -045", "    +6"}, `{
+			"i32": 123,
+			"i64": "-45",
+			"u32": 6
+		}`)
+
+		runCmp(t, msgDesc, []string{"+00000", "-00000", "     +"}, `{}`)
+
+		runErr(t, msgDesc, []string{"+00123", "-00045", "-00006"})
+	})
+
+	t.Run("Numeric Types Limits", func(t *testing.T) {
+		msgDesc := singleMessage(t, `
+		  int32 i32 = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 11 }
+			number: {}
+		  }];
+		  uint32 u32 = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 11, length: 11 }
+			number: {}
+		  }];
+		`)
+
+		runCmp(t, msgDesc, []string{"-2147483648", " 4294967295"}, `{
+			"i32": -2147483648,
+			"u32": 4294967295
+		}`)
+
+		runCmp(t, msgDesc, []string{"2147483647 ", "0 12       "}, `{
+			"i32": 2147483647,
+			"u32": 12
+		}`)
+
+		for _, record := range []struct {
+			fields []string
+			want   error
+		}{
+			{[]string{" 2147483648", "          1"}, ErrOverflow},
+			{[]string{"-2147483649", "          1"}, ErrOverflow},
+			{[]string{"          1", " 4294967296"}, ErrOverflow},
+			{[]string{"          1", "99999999999"}, ErrOverflow},
+			{[]string{"        1 2", "          1"}, ErrInvalidNumber},
+			{[]string{"          1", "         -1"}, ErrInvalidNumber},
+			{[]string{"        1.0", "          1"}, ErrInvalidNumber},
+		} {
+			if err := runErr(t, msgDesc, record.fields); !errors.Is(err, record.want) {
+				t.Errorf("%q: expected %v, got %v", record.fields, record.want, err)
+			}
+		}
+	})
+
 	t.Run("Numeric Types Binary Encoded", func(t *testing.T) {
-		msgDesc := prototest.SingleMessage(t, `
+		msgDesc := singleMessage(t, `
 		  uint32 u32 = 1 [(flatfile.v1.field) = {
 			fixed_width: { offset: 0, length: 1 }
 			number: { encoding: ENCODING_BINARY }
@@ -254,6 +1348,18 @@ func TestTypes(t *testing.T) {
 
 }
 
+var msgSeq atomic.Int64
+
+// singleMessage wraps prototest.SingleMessage with a name unique within the
+// test binary. prototest.SingleMessage picks a random name of Msg0 to
+// Msg999, and the j5 codec caches schemas by message name, so runCmp fails
+// when two of the messages of the binary happen to share one.
+func singleMessage(t testing.TB, content ...any) protoreflect.MessageDescriptor {
+	t.Helper()
+	name := fmt.Sprintf("Seq%d", msgSeq.Add(1))
+	return prototest.SingleMessage(t, append([]any{prototest.WithMessageName(name)}, content...)...)
+}
+
 func runErr(t testing.TB, msgDesc protoreflect.MessageDescriptor, in []string) error {
 	t.Helper()
 	line := strings.Join(in, "")
@@ -286,3 +1392,71 @@ func runCmp(t testing.TB, msgDesc protoreflect.MessageDescriptor, in []string, w
 	prototest.AssertEqualProto(t, want, record)
 
 }
+
+func TestDecodeOverpunch(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want string
+	}{
+		{"012C", "0123"},
+		{"012L", "-0123"},
+		{"{", "0"},
+	} {
+		in := []byte(tc.in)
+		got, err := DecodeOverpunch(in)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.in, err)
+		}
+		if got != tc.want {
+			t.Errorf("%q: expected %q, got %q", tc.in, tc.want, got)
+		}
+		if string(in) != tc.in {
+			t.Errorf("%q: input was modified to %q", tc.in, in)
+		}
+	}
+}
+
+func TestEnumKeys(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package enumkeys.v1;
+
+		import "flatfile/v1/annotations.proto";
+
+		message Record {
+		  Status status = 1;
+		}
+
+		enum Status {
+		  STATUS_UNSPECIFIED = 0;
+		  STATUS_ACTIVE = 1 [(flatfile.v1.enum).key = " AC "];
+		  STATUS_ALSO_ACTIVE = 2 [(flatfile.v1.enum).key = "AC"];
+		  STATUS_UMLAUT = 3 [(flatfile.v1.enum).key = "Ü1"];
+		}`})
+
+	enum := fileDesc.MessageByName(t, "enumkeys.v1.Record").Fields().ByName("status").Enum()
+	keys := enumKeysFor(enum)
+	if enumKeysFor(enum) != keys {
+		t.Error("expected the keys to be cached")
+	}
+
+	for _, tc := range []struct {
+		key             string
+		caseInsensitive bool
+		want            protoreflect.EnumNumber
+		found           bool
+	}{
+		{"AC", false, 1, true},
+		{"ac", false, 0, false},
+		{"aC", true, 1, true},
+		{"Ü1", false, 3, true},
+		{"ü1", true, 3, true},
+		{"ü1", false, 0, false},
+		{"", true, 0, false},
+	} {
+		got, found := keys.lookup([]byte(tc.key), tc.caseInsensitive)
+		if got != tc.want || found != tc.found {
+			t.Errorf("%q (case insensitive %v): expected %d %v, got %d %v", tc.key, tc.caseInsensitive, tc.want, tc.found, got, found)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package binfile
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// MessagePool recycles dynamic messages of one type, for streaming consumers
+// which process and discard each record, e.g.
+//
+//	msg := pool.Get()
+//	err := reader.Next(msg)
+//	...
+//	pool.Put(msg)
+//
+// A message must not be used after it is returned to the pool.
+type MessagePool struct {
+	desc protoreflect.MessageDescriptor
+	pool sync.Pool
+}
+
+func NewMessagePool(desc protoreflect.MessageDescriptor) *MessagePool {
+	return &MessagePool{
+		desc: desc,
+		pool: sync.Pool{
+			New: func() any {
+				return dynamicpb.NewMessage(desc)
+			},
+		},
+	}
+}
+
+// Get returns an empty message from the pool.
+func (p *MessagePool) Get() *dynamicpb.Message {
+	return p.pool.Get().(*dynamicpb.Message)
+}
+
+// Put clears the message and returns it to the pool. Messages of other types
+// are dropped.
+func (p *MessagePool) Put(msg *dynamicpb.Message) {
+	if msg == nil || msg.Descriptor() != p.desc {
+		return
+	}
+	// Clearing each field rather than resetting keeps the message's storage
+	// for the next record.
+	msg.Range(func(fieldDesc protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		msg.Clear(fieldDesc)
+		return true
+	})
+	msg.SetUnknown(nil)
+	p.pool.Put(msg)
+}
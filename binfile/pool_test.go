@@ -0,0 +1,54 @@
+package binfile
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestMessagePool(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  string code = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 2 } }];
+	  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 3 }, number: {} }];
+	`)
+
+	pool := NewMessagePool(msgDesc)
+
+	msg := pool.Get()
+	if err := ParseMessage(msg, []byte("AB001")); err != nil {
+		t.Fatal(err)
+	}
+	pool.Put(msg)
+
+	msg = pool.Get()
+	msg.Range(func(fieldDesc protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		t.Errorf("expected empty message from pool, %s is set", fieldDesc.Name())
+		return true
+	})
+	if err := ParseMessage(msg, []byte("CD   ")); err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.Get(msgDesc.Fields().ByName("code")).String(); got != "CD" {
+		t.Errorf("expected code CD, got %q", got)
+	}
+	if msg.Has(msgDesc.Fields().ByName("count")) {
+		t.Error("expected count to be unset")
+	}
+}
+
+func BenchmarkMessagePool(b *testing.B) {
+	msgDesc := benchMessage(b)
+	parser, err := Compile(msgDesc)
+	if err != nil {
+		b.Fatal(err)
+	}
+	pool := NewMessagePool(msgDesc)
+	b.ReportAllocs()
+	for b.Loop() {
+		msg := pool.Get()
+		if err := parser.Parse(msg, benchRecord); err != nil {
+			b.Fatal(err)
+		}
+		pool.Put(msg)
+	}
+}
@@ -0,0 +1,253 @@
+package binfile
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// TypeSelector picks the message type of a record, for files which mix
+// record types such as headers, details and trailers.
+type TypeSelector func(record []byte) (protoreflect.MessageDescriptor, error)
+
+// SingleType selects the same message type for every record.
+func SingleType(desc protoreflect.MessageDescriptor) TypeSelector {
+	return func([]byte) (protoreflect.MessageDescriptor, error) {
+		return desc, nil
+	}
+}
+
+// Report summarizes a whole file, for acceptance checks before ingest.
+type Report struct {
+	Records  int
+	Rejected int
+	Warnings int
+
+	// ByType counts the records of each message type.
+	ByType map[protoreflect.FullName]int
+
+	// ByField counts errors and warnings by field. Errors which are not
+	// about a single field are counted under "".
+	ByField map[protoreflect.FullName]int
+
+	// ByClass counts errors and warnings by the sentinel error they wrap,
+	// e.g. ErrInvalidDate, with "other" for anything else.
+	ByClass map[string]int
+
+	// KeyRanges holds the smallest and largest value of each scalar field
+	// named in the key_fields of its message.
+	KeyRanges map[protoreflect.FullName]*ValueRange
+
+	// Examples holds the first few records failing on each field, keyed as
+	// ByField, when WithExamples is set.
+	Examples map[protoreflect.FullName][]*RecordError
+
+	// TrailerErr is the result of the trailer check, if one is set.
+	TrailerErr error
+
+	examples int
+}
+
+// ValueRange is the smallest and largest value seen for a field.
+type ValueRange struct {
+	Min protoreflect.Value
+	Max protoreflect.Value
+}
+
+// TrailerCheck reconciles the last record of a file against the report, e.g.
+// comparing a trailer's record count or control total. The report counts
+// include the trailer itself.
+type TrailerCheck func(trailer proto.Message, report *Report) error
+
+type reportConfig struct {
+	trailerCheck TrailerCheck
+	examples     int
+}
+
+type ReportOption func(*reportConfig)
+
+// WithTrailerCheck runs the check on the last record once the whole file is
+// read.
+func WithTrailerCheck(check TrailerCheck) ReportOption {
+	return func(rc *reportConfig) {
+		rc.trailerCheck = check
+	}
+}
+
+// WithExamples keeps up to n of the failing records for each field, so that
+// a report can show what went wrong as well as how often.
+func WithExamples(n int) ReportOption {
+	return func(rc *reportConfig) {
+		rc.examples = n
+	}
+}
+
+var errorClasses = []error{
+	ErrShortRecord,
+	ErrRecordLength,
+	ErrInvalidNumber,
+	ErrOverflow,
+	ErrInvalidString,
+	ErrInvalidDate,
+	ErrInvalidEnum,
+	ErrInvalidUUID,
+	ErrMissingBool,
+	ErrRequired,
+	ErrCheckDigit,
+	ErrChecksum,
+	ErrUnmappedData,
+	ErrTrailingData,
+	ErrRule,
+}
+
+// ReportFile reads every newline delimited record of the file and summarizes
+// the results. Rejected records are counted rather than failing the report,
+// only read errors are returned.
+func ReportFile(r io.Reader, selectType TypeSelector, opts ...ReportOption) (*Report, error) {
+	config := &reportConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	report := &Report{
+		ByType:    map[protoreflect.FullName]int{},
+		ByField:   map[protoreflect.FullName]int{},
+		ByClass:   map[string]int{},
+		KeyRanges: map[protoreflect.FullName]*ValueRange{},
+		Examples:  map[protoreflect.FullName][]*RecordError{},
+		examples:  config.examples,
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Split(scanRecords)
+
+	var last proto.Message
+	var lastErr error
+	for scanner.Scan() {
+		line := bytes.TrimSuffix(scanner.Bytes(), []byte("\r"))
+		report.Records++
+		last, lastErr = report.addRecord(line, selectType)
+		if last != nil {
+			report.addKeys(last)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if config.trailerCheck != nil {
+		if report.Records == 0 {
+			report.TrailerErr = fmt.Errorf("no trailer record")
+		} else if lastErr != nil {
+			report.TrailerErr = fmt.Errorf("trailer record: %w", lastErr)
+		} else {
+			report.TrailerErr = config.trailerCheck(last, report)
+		}
+	}
+
+	return report, nil
+}
+
+// addRecord parses and counts one record, returning the message when it
+// parsed.
+func (report *Report) addRecord(record []byte, selectType TypeSelector) (proto.Message, error) {
+	desc, err := selectType(record)
+	if err != nil {
+		report.Rejected++
+		report.addError(err, record)
+		return nil, err
+	}
+	report.ByType[desc.FullName()]++
+
+	msg := dynamicpb.NewMessage(desc)
+	warnings, err := ParseMessageWithWarnings(msg, record)
+	report.Warnings += len(warnings)
+	for _, warning := range warnings {
+		report.addError(warning, record)
+	}
+	if err != nil {
+		report.Rejected++
+		report.addError(err, record)
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (report *Report) addError(err error, record []byte) {
+	field := errorField(err)
+	report.ByField[field]++
+	if len(report.Examples[field]) < report.examples {
+		report.Examples[field] = append(report.Examples[field], &RecordError{
+			Record: report.Records,
+			Raw:    bytes.Clone(record),
+			Err:    err,
+		})
+	}
+
+	for _, class := range errorClasses {
+		if errors.Is(err, class) {
+			report.ByClass[class.Error()]++
+			return
+		}
+	}
+	report.ByClass["other"]++
+}
+
+func (report *Report) addKeys(msg proto.Message) {
+	refl := msg.ProtoReflect()
+	ext, _ := proto.GetExtension(refl.Descriptor().Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	for _, name := range ext.GetKeyFields() {
+		fieldDesc := refl.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fieldDesc == nil || fieldDesc.Message() != nil || !refl.Has(fieldDesc) {
+			continue
+		}
+		val := refl.Get(fieldDesc)
+		existing, ok := report.KeyRanges[fieldDesc.FullName()]
+		if !ok {
+			report.KeyRanges[fieldDesc.FullName()] = &ValueRange{Min: val, Max: val}
+			continue
+		}
+		if compareValues(fieldDesc.Kind(), val, existing.Min) < 0 {
+			existing.Min = val
+		}
+		if compareValues(fieldDesc.Kind(), val, existing.Max) > 0 {
+			existing.Max = val
+		}
+	}
+}
+
+// compareValues orders two scalar values of the same kind.
+func compareValues(kind protoreflect.Kind, a, b protoreflect.Value) int {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return cmp.Compare(a.Int(), b.Int())
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return cmp.Compare(a.Float(), b.Float())
+	case protoreflect.EnumKind:
+		return cmp.Compare(a.Enum(), b.Enum())
+	case protoreflect.BoolKind:
+		return cmp.Compare(boolInt(a.Bool()), boolInt(b.Bool()))
+	default:
+		return cmp.Compare(a.String(), b.String())
+	}
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,114 @@
+package binfile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestReportFile(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package report.v1;
+
+		import "flatfile/v1/annotations.proto";
+
+		message Detail {
+		  option (flatfile.v1.message) = { key_fields: ["account"] };
+		  string type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+		  string account = 2 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 3 } }];
+		  int32 amount = 3 [(flatfile.v1.field) = { fixed_width: { offset: 4, length: 3 }, number: {} }];
+		}
+
+		message Trailer {
+		  string type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+		  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 3 }, number: {} }];
+		}`})
+
+	detail := fileDesc.MessageByName(t, "report.v1.Detail")
+	trailer := fileDesc.MessageByName(t, "report.v1.Trailer")
+
+	selectType := func(record []byte) (protoreflect.MessageDescriptor, error) {
+		switch {
+		case len(record) > 0 && record[0] == 'D':
+			return detail, nil
+		case len(record) > 0 && record[0] == 'T':
+			return trailer, nil
+		default:
+			return nil, fmt.Errorf("unknown record type %q", record)
+		}
+	}
+
+	checkCount := func(msg proto.Message, report *Report) error {
+		refl := msg.ProtoReflect()
+		want := refl.Get(refl.Descriptor().Fields().ByName("count")).Int()
+		if got := int64(report.ByType[detail.FullName()]); got != want {
+			return fmt.Errorf("trailer count %d, file has %d details", want, got)
+		}
+		return nil
+	}
+
+	file := strings.Join([]string{
+		"DBBB010",
+		"DAAA0x0",
+		"DCCC030",
+		"X",
+		"T003",
+	}, "\n")
+
+	report, err := ReportFile(strings.NewReader(file), selectType, WithTrailerCheck(checkCount), WithExamples(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Records != 5 || report.Rejected != 2 {
+		t.Errorf("expected 5 records with 2 rejected, got %d and %d", report.Records, report.Rejected)
+	}
+	if report.ByType[detail.FullName()] != 3 || report.ByType[trailer.FullName()] != 1 {
+		t.Errorf("unexpected type counts %v", report.ByType)
+	}
+	if report.ByField["report.v1.Detail.amount"] != 1 || report.ByField[""] != 1 {
+		t.Errorf("unexpected field counts %v", report.ByField)
+	}
+	if report.ByClass[ErrInvalidNumber.Error()] != 1 || report.ByClass["other"] != 1 {
+		t.Errorf("unexpected class counts %v", report.ByClass)
+	}
+
+	examples := report.Examples["report.v1.Detail.amount"]
+	if len(examples) != 1 || examples[0].Record != 2 || string(examples[0].Raw) != "DAAA0x0" {
+		t.Errorf("unexpected amount examples %v", examples)
+	}
+	if examples := report.Examples[""]; len(examples) != 1 || examples[0].Record != 4 {
+		t.Errorf("unexpected record examples %v", examples)
+	}
+
+	accounts := report.KeyRanges["report.v1.Detail.account"]
+	if accounts == nil || accounts.Min.String() != "BBB" || accounts.Max.String() != "CCC" {
+		t.Errorf("unexpected account range %v", accounts)
+	}
+
+	if report.TrailerErr != nil {
+		t.Errorf("expected trailer to reconcile, got %v", report.TrailerErr)
+	}
+
+	report, err = ReportFile(strings.NewReader("DAAA001\nT002\n"), selectType, WithTrailerCheck(checkCount))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.TrailerErr == nil {
+		t.Error("expected trailer count mismatch")
+	}
+
+	report, err = ReportFile(strings.NewReader("DAAA001\nT0x2\n"), selectType, WithTrailerCheck(checkCount))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(report.TrailerErr, ErrInvalidNumber) {
+		t.Errorf("expected trailer parse error, got %v", report.TrailerErr)
+	}
+}
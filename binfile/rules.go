@@ -0,0 +1,72 @@
+package binfile
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type compiledRule struct {
+	rule    *flatfile_pb.Rule
+	program cel.Program
+}
+
+func compileRules(desc protoreflect.MessageDescriptor, rules []*flatfile_pb.Rule) ([]compiledRule, error) {
+	env, err := cel.NewEnv(
+		cel.TypeDescs(desc.ParentFile()),
+		cel.DeclareContextProto(desc),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("rule environment: %w", err)
+	}
+
+	compiled := make([]compiledRule, 0, len(rules))
+	for _, rule := range rules {
+		ast, issues := env.Compile(rule.Expression)
+		if issues.Err() != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.Expression, issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType {
+			return nil, fmt.Errorf("rule %q: must evaluate to bool, got %s", rule.Expression, ast.OutputType())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.Expression, err)
+		}
+		compiled = append(compiled, compiledRule{
+			rule:    rule,
+			program: program,
+		})
+	}
+	return compiled, nil
+}
+
+// checkRules evaluates the record level rules against the parsed message.
+func checkRules(msg proto.Message, rules []compiledRule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	vars, err := cel.ContextProtoVars(msg)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		out, _, err := rule.program.Eval(vars)
+		if err != nil {
+			return fmt.Errorf("rule %q: %w", rule.rule.Expression, err)
+		}
+		if out.Value() != true {
+			description := rule.rule.Message
+			if description == "" {
+				description = rule.rule.Expression
+			}
+			return fmt.Errorf("%w: %s", ErrRule, description)
+		}
+	}
+	return nil
+}
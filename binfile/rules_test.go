@@ -0,0 +1,43 @@
+package binfile
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRules(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = {
+		rules: [{
+		  expression: "settlement_date >= trade_date"
+		  message: "settlement before trade"
+		}, {
+		  expression: "debit + credit == total"
+		}]
+	  };
+	  string trade_date = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 8 } }];
+	  string settlement_date = 2 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 8 } }];
+	  int64 debit = 3 [(flatfile.v1.field) = { fixed_width: { offset: 16, length: 3 }, number: {} }];
+	  int64 credit = 4 [(flatfile.v1.field) = { fixed_width: { offset: 19, length: 3 }, number: {} }];
+	  int64 total = 5 [(flatfile.v1.field) = { fixed_width: { offset: 22, length: 3 }, number: {} }];
+	`)
+
+	runCmp(t, msgDesc, []string{"20240101", "20240103", "010", "005", "015"}, `{
+		"tradeDate": "20240101",
+		"settlementDate": "20240103",
+		"debit": "10",
+		"credit": "5",
+		"total": "15"
+	}`)
+
+	err := runErr(t, msgDesc, []string{"20240103", "20240101", "010", "005", "015"})
+	if !errors.Is(err, ErrRule) || !strings.Contains(err.Error(), "settlement before trade") {
+		t.Errorf("expected settlement rule error, got %v", err)
+	}
+
+	err = runErr(t, msgDesc, []string{"20240101", "20240103", "010", "005", "016"})
+	if !errors.Is(err, ErrRule) || !strings.Contains(err.Error(), "debit + credit == total") {
+		t.Errorf("expected total rule error, got %v", err)
+	}
+}
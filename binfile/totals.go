@@ -0,0 +1,236 @@
+package binfile
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrControlTotal is wrapped by the errors of control totals which don't
+// match the records they cover.
+var ErrControlTotal = errors.New("control total mismatch")
+
+// TotalResult is the outcome of one control total of a trailer record.
+type TotalResult struct {
+	// Record is the one based number of the trailer record in the file.
+	Record  int
+	Message protoreflect.FullName
+	Total   *flatfile_pb.ControlTotal
+
+	Expected decimal.Decimal
+	Actual   decimal.Decimal
+
+	// Err is set when the total does not match, wrapping ErrControlTotal, or
+	// could not be read.
+	Err error
+}
+
+// Description names the total for reports.
+func (r TotalResult) Description() string {
+	if r.Total.Description != "" {
+		return r.Total.Description
+	}
+	return string(r.Message) + "." + r.Total.Field
+}
+
+// TotalsChecker reconciles the control_totals of trailer records against the
+// records before them, as a file is read in order.
+type TotalsChecker struct {
+	// sources are the fields summed by any control total.
+	sources map[protoreflect.FullName]protoreflect.FieldDescriptor
+
+	current totalsState
+	// since holds the state at the last record of each message type with
+	// control totals, from which its next totals are counted.
+	since map[protoreflect.FullName]totalsState
+}
+
+type totalsState struct {
+	records int
+	counts  map[protoreflect.FullName]int
+	sums    map[protoreflect.FullName]decimal.Decimal
+}
+
+func (s totalsState) clone() totalsState {
+	return totalsState{
+		records: s.records,
+		counts:  maps.Clone(s.counts),
+		sums:    maps.Clone(s.sums),
+	}
+}
+
+// NewTotalsChecker prepares to check the control totals declared by any of
+// the message types, which must include the types summed.
+func NewTotalsChecker(descs ...protoreflect.MessageDescriptor) (*TotalsChecker, error) {
+	fields := map[protoreflect.FullName]protoreflect.FieldDescriptor{}
+	for _, desc := range descs {
+		for i := range desc.Fields().Len() {
+			fieldDesc := desc.Fields().Get(i)
+			fields[fieldDesc.FullName()] = fieldDesc
+		}
+	}
+
+	tc := &TotalsChecker{
+		sources: map[protoreflect.FullName]protoreflect.FieldDescriptor{},
+		current: totalsState{
+			counts: map[protoreflect.FullName]int{},
+			sums:   map[protoreflect.FullName]decimal.Decimal{},
+		},
+		since: map[protoreflect.FullName]totalsState{},
+	}
+	for _, desc := range descs {
+		ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+		for _, total := range ext.GetControlTotals() {
+			if err := validateControlTotal(desc, total); err != nil {
+				return nil, fmt.Errorf("message %s: %w", desc.FullName(), err)
+			}
+			switch total.Kind {
+			case flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_SUM, flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_HASH:
+				source, ok := fields[protoreflect.FullName(total.Of)]
+				if !ok {
+					return nil, fmt.Errorf("message %s: control total %s: field %s not found", desc.FullName(), total.Field, total.Of)
+				}
+				tc.sources[source.FullName()] = source
+			}
+		}
+	}
+	return tc, nil
+}
+
+func validateControlTotal(desc protoreflect.MessageDescriptor, total *flatfile_pb.ControlTotal) error {
+	if desc.Fields().ByName(protoreflect.Name(total.Field)) == nil {
+		return fmt.Errorf("control total field %q not found", total.Field)
+	}
+	switch total.Kind {
+	case flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_COUNT:
+	case flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_SUM, flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_HASH:
+		if total.Of == "" {
+			return fmt.Errorf("control total %s: of must name the field summed", total.Field)
+		}
+	default:
+		return fmt.Errorf("control total %s: kind is not set", total.Field)
+	}
+	return nil
+}
+
+// Add counts the next record of the file, returning the results of its
+// control totals if it declares any. msg is nil for records which failed to
+// parse, which are counted as records but add nothing to sums.
+func (tc *TotalsChecker) Add(msg proto.Message) []TotalResult {
+	var results []TotalResult
+	state := &tc.current
+	state.records++
+	if msg == nil {
+		return nil
+	}
+
+	refl := msg.ProtoReflect()
+	desc := refl.Descriptor()
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	if totals := ext.GetControlTotals(); len(totals) > 0 {
+		since := tc.since[desc.FullName()]
+		for _, total := range totals {
+			results = append(results, tc.check(refl, total, since))
+		}
+	}
+
+	state.counts[desc.FullName()]++
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		if _, ok := tc.sources[fieldDesc.FullName()]; !ok || !refl.Has(fieldDesc) {
+			continue
+		}
+		val, err := totalValue(fieldDesc, refl.Get(fieldDesc))
+		if err != nil {
+			// Values which can't be summed, such as non-digit strings, leave
+			// the sum short, which the total then reports.
+			continue
+		}
+		state.sums[fieldDesc.FullName()] = state.sums[fieldDesc.FullName()].Add(val)
+	}
+
+	if len(ext.GetControlTotals()) > 0 {
+		tc.since[desc.FullName()] = state.clone()
+	}
+	return results
+}
+
+// check compares one total of the trailer to the records since the state.
+// The trailer itself is not yet counted in the current state.
+func (tc *TotalsChecker) check(refl protoreflect.Message, total *flatfile_pb.ControlTotal, since totalsState) TotalResult {
+	result := TotalResult{
+		Record:  tc.current.records,
+		Message: refl.Descriptor().FullName(),
+		Total:   total,
+	}
+
+	fieldDesc := refl.Descriptor().Fields().ByName(protoreflect.Name(total.Field))
+	expected := decimal.Zero
+	if refl.Has(fieldDesc) {
+		var err error
+		expected, err = totalValue(fieldDesc, refl.Get(fieldDesc))
+		if err != nil {
+			result.Err = fmt.Errorf("reading %s: %w", total.Field, err)
+			return result
+		}
+	}
+	result.Expected = expected
+
+	switch total.Kind {
+	case flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_COUNT:
+		count := tc.current.records - 1 - since.records
+		if total.Of != "" {
+			count = tc.current.counts[protoreflect.FullName(total.Of)] - since.counts[protoreflect.FullName(total.Of)]
+		}
+		result.Actual = decimal.NewFromInt(int64(count))
+
+	case flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_SUM:
+		of := protoreflect.FullName(total.Of)
+		result.Actual = tc.current.sums[of].Sub(since.sums[of])
+
+	case flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_HASH:
+		of := protoreflect.FullName(total.Of)
+		sum := tc.current.sums[of].Sub(since.sums[of]).Truncate(0)
+		if opts := fieldOptions(fieldDesc); opts.GetFixedWidth() != nil {
+			sum = sum.Mod(decimal.New(1, int32(opts.FixedWidth.Length)))
+		}
+		result.Actual = sum
+	}
+
+	if !result.Actual.Equal(result.Expected) {
+		result.Err = fmt.Errorf("%w: %s is %s, records total %s", ErrControlTotal, result.Description(), result.Expected, result.Actual)
+	}
+	return result
+}
+
+// totalValue reads a number, decimal or string of digits as a decimal.
+func totalValue(fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) (decimal.Decimal, error) {
+	switch fieldDesc.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return decimal.NewFromInt(val.Int()), nil
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return decimal.NewFromUint64(val.Uint()), nil
+	case protoreflect.StringKind:
+		str := strings.TrimSpace(val.String())
+		if str == "" {
+			return decimal.Zero, nil
+		}
+		return decimal.NewFromString(str)
+	case protoreflect.MessageKind:
+		msg := val.Message()
+		if msg.Descriptor().FullName() == "j5.types.decimal.v1.Decimal" {
+			return decimal.NewFromString(msg.Get(msg.Descriptor().Fields().ByName("value")).String())
+		}
+	}
+	return decimal.Zero, fmt.Errorf("%s is not a number", fieldDesc.Kind())
+}
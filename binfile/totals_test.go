@@ -0,0 +1,107 @@
+package binfile
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestTotalsChecker(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package totals.v1;
+
+		import "flatfile/v1/annotations.proto";
+		import "j5/types/decimal/v1/decimal.proto";
+
+		message Entry {
+		  string routing = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 3 } }];
+		  j5.types.decimal.v1.Decimal amount = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 4, length: 4 }
+			number: {}
+		  }];
+		}
+
+		message Batch {
+		  option (flatfile.v1.message) = { control_totals: [
+			{ field: "count", kind: CONTROL_TOTAL_KIND_COUNT, of: "totals.v1.Entry" },
+			{ field: "amount", kind: CONTROL_TOTAL_KIND_SUM, of: "totals.v1.Entry.amount" },
+			{ field: "hash", kind: CONTROL_TOTAL_KIND_HASH, of: "totals.v1.Entry.routing", description: "entry hash" }
+		  ] };
+		  int32 count = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 2 }, number: {} }];
+		  j5.types.decimal.v1.Decimal amount = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 3, length: 5 }
+			number: {}
+		  }];
+		  int32 hash = 3 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 3 }, number: {} }];
+		}
+
+		message File {
+		  option (flatfile.v1.message) = { control_totals: [
+			{ field: "records", kind: CONTROL_TOTAL_KIND_COUNT }
+		  ] };
+		  int32 records = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 2 }, number: {} }];
+		}`})
+
+	entry := fileDesc.MessageByName(t, "totals.v1.Entry")
+	batch := fileDesc.MessageByName(t, "totals.v1.Batch")
+	file := fileDesc.MessageByName(t, "totals.v1.File")
+
+	checker, err := NewTotalsChecker(entry, batch, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []TotalResult
+	for _, record := range []string{
+		"E9000150",
+		"E8000025",
+		"B0200175700",
+		"E1231000",
+		"", // a record which failed to parse
+		"B0101001123",
+		"F06",
+	} {
+		if record == "" {
+			results = append(results, checker.Add(nil)...)
+			continue
+		}
+		desc := map[byte]protoreflect.MessageDescriptor{'E': entry, 'B': batch, 'F': file}[record[0]]
+		msg := dynamicpb.NewMessage(desc)
+		if err := ParseMessage(msg, []byte(record)); err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, checker.Add(msg)...)
+	}
+
+	if len(results) != 7 {
+		t.Fatalf("expected 7 results, got %d", len(results))
+	}
+	for idx, result := range results {
+		if idx == 4 {
+			if !errors.Is(result.Err, ErrControlTotal) {
+				t.Errorf("expected the second batch amount to mismatch, got %v", result.Err)
+			}
+			if result.Record != 6 || result.Expected.String() != "1001" || result.Actual.String() != "1000" {
+				t.Errorf("unexpected result %+v", result)
+			}
+			continue
+		}
+		if result.Err != nil {
+			t.Errorf("result %d: %s", idx, result.Err)
+		}
+	}
+	if hash := results[2]; hash.Description() != "entry hash" || hash.Actual.String() != "700" {
+		t.Errorf("unexpected hash result %s %s", hash.Description(), hash.Actual)
+	}
+	if records := results[6]; records.Description() != "totals.v1.File.records" || records.Actual.String() != "6" {
+		t.Errorf("unexpected file result %s %s", records.Description(), records.Actual)
+	}
+
+	if _, err := NewTotalsChecker(batch); err == nil {
+		t.Error("expected an error when the summed types are not given")
+	}
+}
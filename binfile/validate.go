@@ -0,0 +1,267 @@
+package binfile
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ValidateMessageDescriptor checks that the flatfile annotations of the
+// message are consistent with each other and with the field types, so that
+// services can fail at startup rather than on the first record. All problems
+// found are joined into the returned error.
+//
+// Annotations of a newer annotations_version than AnnotationsVersion, or
+// with options this build doesn't know, are an error, as they would otherwise
+// be ignored.
+//
+// Of the layout of the record itself, only fields extending past the
+// record_length are an error, as they could never be read. See ValidateLayout
+// for the other checks.
+func ValidateMessageDescriptor(desc protoreflect.MessageDescriptor) error {
+	errs := []error{}
+	if err := checkSupported(desc); err != nil {
+		errs = append(errs, err)
+	}
+
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	if ext != nil {
+		if err := validateMessageOptions(desc, ext); err != nil {
+			errs = append(errs, fmt.Errorf("message %s: %w", desc.FullName(), err))
+		}
+	}
+
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		tc := fieldOptions(fieldDesc)
+		if tc == nil {
+			continue
+		}
+		if err := validateField(fieldDesc, tc); err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", fieldDesc.FullName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateMessageOptions(desc protoreflect.MessageDescriptor, ext *flatfile_pb.Message) error {
+	if ext.TreatTrailingAs == flatfile_pb.TrailingIs_TRAILING_IS_CAPTURED {
+		if err := validateStringSibling(desc, ext.TrailingField); err != nil {
+			return fmt.Errorf("trailing_field: %w", err)
+		}
+	}
+	if ext.DefaultDateFormat != "" {
+		if err := validateDateFormat(ext.DefaultDateFormat); err != nil {
+			return fmt.Errorf("default_date_format: %w", err)
+		}
+	}
+	for _, filler := range ext.Filler {
+		if filler.Length == 0 {
+			return fmt.Errorf("filler at offset %d has zero length", filler.Offset)
+		}
+	}
+	if err := checkPastEnd(ext, layoutSpans(desc, ext)); err != nil {
+		return err
+	}
+	for _, name := range ext.KeyFields {
+		if desc.Fields().ByName(protoreflect.Name(name)) == nil {
+			return fmt.Errorf("key_fields: field %q not found", name)
+		}
+	}
+	for _, total := range ext.ControlTotals {
+		if err := validateControlTotal(desc, total); err != nil {
+			return err
+		}
+	}
+	if len(ext.Rules) > 0 {
+		if _, err := compileRules(desc, ext.Rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateStringSibling(desc protoreflect.MessageDescriptor, name string) error {
+	if name == "" {
+		return fmt.Errorf("no field name")
+	}
+	sibling := desc.Fields().ByName(protoreflect.Name(name))
+	if sibling == nil {
+		return fmt.Errorf("field %q not found", name)
+	}
+	if sibling.Kind() != protoreflect.StringKind || sibling.Cardinality() == protoreflect.Repeated {
+		return fmt.Errorf("field %q must be a string", name)
+	}
+	return nil
+}
+
+// fieldTypeName describes the kind, or the message name for message fields,
+// as dispatched in ReadField.
+func fieldTypeName(fieldDesc protoreflect.FieldDescriptor) string {
+	if fieldDesc.Kind() == protoreflect.MessageKind {
+		return string(fieldDesc.Message().FullName())
+	}
+	return fieldDesc.Kind().String()
+}
+
+// validateShortPolicy rejects padding binary and packed fields, where padding
+// with spaces would read as a value rather than as blank.
+func validateShortPolicy(tc *flatfile_pb.Field) error {
+	if tc.TreatShortAs != flatfile_pb.ShortIs_SHORT_IS_PADDED {
+		return nil
+	}
+	switch encoding := tc.GetNumber().GetEncoding(); encoding {
+	case flatfile_pb.Encoding_ENCODING_BINARY, flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL:
+		return fmt.Errorf("treat_short_as SHORT_IS_PADDED is not supported for %s", encoding)
+	}
+	return nil
+}
+
+func validateField(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) error {
+	if tc.FixedWidth == nil {
+		return fmt.Errorf("no fixed_width")
+	}
+	if fieldDesc.Cardinality() == protoreflect.Repeated {
+		return fmt.Errorf("repeated fields are not supported")
+	}
+
+	typeName := fieldTypeName(fieldDesc)
+	isString, isBool, isNumber, isInt := false, false, false, false
+	switch typeName {
+	case "google.protobuf.StringValue", "string":
+		isString = true
+	case "google.protobuf.BoolValue", "bool":
+		isBool = true
+	case "j5.types.decimal.v1.Decimal":
+		isNumber = true
+	case "uint32", "uint64", "int32", "int64":
+		isNumber = true
+		isInt = true
+	case "j5.types.date.v1.Date", "enum":
+	default:
+		if tc.GetCustom() != nil {
+			// The codec handles any type.
+			break
+		}
+		if fieldDesc.Kind() != protoreflect.MessageKind {
+			return fmt.Errorf("unsupported type %s", typeName)
+		}
+		if _, ok := lookupMessageCodec(fieldDesc.Message().FullName()); !ok {
+			return fmt.Errorf("unsupported type %s", typeName)
+		}
+	}
+
+	if tc.RawField != "" {
+		if err := validateStringSibling(fieldDesc.ContainingMessage(), tc.RawField); err != nil {
+			return fmt.Errorf("raw_field: %w", err)
+		}
+	}
+
+	if err := validateShortPolicy(tc); err != nil {
+		return err
+	}
+
+	if tc.CheckDigit != flatfile_pb.CheckDigit_CHECK_DIGIT_UNSPECIFIED && !isString && !isNumber {
+		return fmt.Errorf("check_digit is not supported for %s", typeName)
+	}
+	if tc.CheckDigit != flatfile_pb.CheckDigit_CHECK_DIGIT_UNSPECIFIED && isNumber {
+		// The check is over the digit characters, which only display and
+		// zoned numbers have.
+		switch encoding := tc.GetNumber().GetEncoding(); encoding {
+		case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL, flatfile_pb.Encoding_ENCODING_BINARY:
+			return fmt.Errorf("check_digit is not supported for %s", encoding)
+		}
+	}
+
+	switch ft := tc.FieldType.(type) {
+	case nil:
+		if typeName == "j5.types.date.v1.Date" {
+			return fmt.Errorf("date fields require a date format")
+		}
+
+	case *flatfile_pb.Field_String_:
+		if !isString {
+			return fmt.Errorf("string options on %s", typeName)
+		}
+		if ft.String_.Pattern != "" {
+			if _, err := regexp.Compile(ft.String_.Pattern); err != nil {
+				return fmt.Errorf("invalid pattern: %w", err)
+			}
+		}
+		if ft.String_.MaxLength > 0 && ft.String_.MinLength > ft.String_.MaxLength {
+			return fmt.Errorf("min_length %d is more than max_length %d", ft.String_.MinLength, ft.String_.MaxLength)
+		}
+
+	case *flatfile_pb.Field_Bool:
+		if !isBool {
+			return fmt.Errorf("bool options on %s", typeName)
+		}
+
+	case *flatfile_pb.Field_Date:
+		if typeName != "j5.types.date.v1.Date" {
+			return fmt.Errorf("date options on %s", typeName)
+		}
+		if err := validateDateFormat(ft.Date.Format); err != nil {
+			return err
+		}
+		if ft.Date.CenturyPivot != nil {
+			if hasFourDigitYear(ft.Date) {
+				return fmt.Errorf("century_pivot is for two digit years")
+			}
+			if *ft.Date.CenturyPivot > 100 {
+				return fmt.Errorf("century_pivot %d is more than 100", *ft.Date.CenturyPivot)
+			}
+		}
+
+	case *flatfile_pb.Field_Number:
+		if !isNumber {
+			return fmt.Errorf("number options on %s", typeName)
+		}
+		if ft.Number.Encoding == flatfile_pb.Encoding_ENCODING_BINARY && !isInt {
+			return fmt.Errorf("binary encoding is only supported for integers")
+		}
+		if _, ok := flatfile_pb.Encoding_name[int32(ft.Number.Encoding)]; !ok {
+			return fmt.Errorf("unknown encoding %d", ft.Number.Encoding)
+		}
+
+	case *flatfile_pb.Field_Enum:
+		if typeName != "enum" {
+			return fmt.Errorf("enum options on %s", typeName)
+		}
+		if ft.Enum.TreatUnknownAs == flatfile_pb.UnknownIs_UNKNOWN_IS_FALLBACK {
+			if fieldDesc.Enum().Values().ByName(protoreflect.Name(ft.Enum.FallbackValue)) == nil {
+				return fmt.Errorf("fallback_value %q not found in %s", ft.Enum.FallbackValue, fieldDesc.Enum().FullName())
+			}
+		}
+
+	case *flatfile_pb.Field_Custom:
+		if _, err := customCodec(tc); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("unknown field type %T", ft)
+	}
+
+	return nil
+}
+
+func validateDateFormat(format string) error {
+	if format == "" {
+		return fmt.Errorf("missing date format")
+	}
+	if !strings.Contains(format, "YY") || !strings.Contains(format, "MM") || !strings.Contains(format, "DD") {
+		return fmt.Errorf("date format %q needs YYYY or YY, MM and DD", format)
+	}
+	if _, err := goTimeFormat(format); err != nil {
+		return fmt.Errorf("invalid date format %q: %w", format, err)
+	}
+	return nil
+}
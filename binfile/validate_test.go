@@ -0,0 +1,163 @@
+package binfile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+)
+
+func TestValidateMessageDescriptor(t *testing.T) {
+
+	t.Run("Valid", func(t *testing.T) {
+		msgDesc := singleMessage(t,
+			prototest.WithMessageImports("j5/types/date/v1/date.proto", "j5/types/decimal/v1/decimal.proto"),
+			`
+		  option (flatfile.v1.message) = { treat_trailing_as: TRAILING_IS_CAPTURED, trailing_field: "rest" };
+		  string name = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 4 }
+			string: { pattern: "^[A-Z]+$" }
+			raw_field: "name_raw"
+		  }];
+		  j5.types.date.v1.Date due = 2 [(flatfile.v1.field) = {
+			fixed_width: { offset: 4, length: 8 }
+			date: { format: "YYYYMMDD" }
+		  }];
+		  j5.types.decimal.v1.Decimal amount = 3 [(flatfile.v1.field) = {
+			fixed_width: { offset: 12, length: 4 }
+			number: { encoding: ENCODING_PACKED_DECIMAL }
+		  }];
+		  int32 count = 4 [(flatfile.v1.field) = {
+			fixed_width: { offset: 16, length: 1 }
+			number: { encoding: ENCODING_BINARY }
+		  }];
+		  string name_raw = 5;
+		  string rest = 6;
+		`)
+
+		if err := ValidateMessageDescriptor(msgDesc); err != nil {
+			t.Fatalf("expected valid, got %v", err)
+		}
+	})
+
+	for _, tc := range []struct {
+		name    string
+		field   string
+		wantErr string
+	}{{
+		name: "Unsupported Kind",
+		field: `double d = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 4 }
+		}];`,
+		wantErr: "unsupported type double",
+	}, {
+		name: "Missing Date Format",
+		field: `j5.types.date.v1.Date d = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+		}];`,
+		wantErr: "date fields require a date format",
+	}, {
+		name: "Bad Date Format",
+		field: `j5.types.date.v1.Date d = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			date: { format: "YYYY-MM" }
+		}];`,
+		wantErr: "needs YYYY or YY, MM and DD",
+	}, {
+		name: "Binary Decimal",
+		field: `j5.types.decimal.v1.Decimal d = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			number: { encoding: ENCODING_BINARY }
+		}];`,
+		wantErr: "binary encoding is only supported for integers",
+	}, {
+		name: "Padded Packed",
+		field: `int32 n = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 3 }
+			number: { encoding: ENCODING_PACKED_DECIMAL }
+			treat_short_as: SHORT_IS_PADDED
+		}];`,
+		wantErr: "SHORT_IS_PADDED is not supported for ENCODING_PACKED_DECIMAL",
+	}, {
+		name: "Packed Check Digit",
+		field: `int64 n = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 5 }
+			number: { encoding: ENCODING_PACKED_DECIMAL }
+			check_digit: CHECK_DIGIT_LUHN
+		}];`,
+		wantErr: "check_digit is not supported for ENCODING_PACKED_DECIMAL",
+	}, {
+		name: "Binary Check Digit",
+		field: `uint32 n = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 4 }
+			number: { encoding: ENCODING_BINARY }
+			check_digit: CHECK_DIGIT_ABA
+		}];`,
+		wantErr: "check_digit is not supported for ENCODING_BINARY",
+	}, {
+		name: "Mismatched Options",
+		field: `int32 n = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			string: { trim: TRIM_BOTH }
+		}];`,
+		wantErr: "string options on int32",
+	}, {
+		name: "Bad Pattern",
+		field: `string s = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			string: { pattern: "[" }
+		}];`,
+		wantErr: "invalid pattern",
+	}, {
+		name: "Missing Raw Field",
+		field: `string s = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			raw_field: "nope"
+		}];`,
+		wantErr: `raw_field: field "nope" not found`,
+	}, {
+		name: "Invalid Default Date Format",
+		field: `option (flatfile.v1.message) = { default_date_format: "MMDD" };
+		j5.types.date.v1.Date d = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 4 }
+		}];`,
+		wantErr: `default_date_format: date format "MMDD" needs YYYY or YY, MM and DD`,
+	}, {
+		name: "Non Bool Rule",
+		field: `option (flatfile.v1.message) = { rules: [{ expression: "n + 1" }] };
+		int32 n = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			number: {}
+		}];`,
+		wantErr: "must evaluate to bool",
+	}, {
+		name: "Unknown Rule Field",
+		field: `option (flatfile.v1.message) = { rules: [{ expression: "m > 1" }] };
+		int32 n = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			number: {}
+		}];`,
+		wantErr: "undeclared reference to 'm'",
+	}, {
+		name: "Past Record Length",
+		field: `option (flatfile.v1.message) = { record_length: 6 };
+		string s = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 2, length: 8 }
+		}];`,
+		wantErr: ".s at offset 2 length 8 extends past record_length 6",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			msgDesc := singleMessage(t,
+				prototest.WithMessageImports("j5/types/date/v1/date.proto", "j5/types/decimal/v1/decimal.proto"),
+				tc.field)
+
+			err := ValidateMessageDescriptor(msgDesc)
+			if err == nil {
+				t.Fatalf("expected error containing %q", tc.wantErr)
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package binfile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AnnotationsVersion is the newest annotations_version this build supports.
+const AnnotationsVersion = 1
+
+// checkSupported returns an error when the annotations of the message use
+// options this build doesn't understand, being of a newer
+// annotations_version, or holding options it has no field or enum value for.
+// Descriptors built against a newer annotations.proto would otherwise parse
+// with those options silently ignored.
+func checkSupported(desc protoreflect.MessageDescriptor) error {
+	errs := []error{}
+
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	if ext != nil {
+		if ext.AnnotationsVersion > AnnotationsVersion {
+			errs = append(errs, fmt.Errorf("message %s: annotations_version %d is newer than %d, the version this build supports", desc.FullName(), ext.AnnotationsVersion, AnnotationsVersion))
+		}
+		if err := unknownOptions(ext.ProtoReflect(), ""); err != nil {
+			errs = append(errs, fmt.Errorf("message %s: %w", desc.FullName(), err))
+		}
+	}
+
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		tc, _ := proto.GetExtension(fieldDesc.Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+		if tc != nil {
+			if err := unknownOptions(tc.ProtoReflect(), ""); err != nil {
+				errs = append(errs, fmt.Errorf("field %s: %w", fieldDesc.FullName(), err))
+			}
+		}
+
+		if fieldDesc.Enum() == nil {
+			continue
+		}
+		values := fieldDesc.Enum().Values()
+		for j := range values.Len() {
+			valueDesc := values.Get(j)
+			enumExt, _ := proto.GetExtension(valueDesc.Options(), flatfile_pb.E_Enum).(*flatfile_pb.Enum)
+			if enumExt == nil {
+				continue
+			}
+			if err := unknownOptions(enumExt.ProtoReflect(), ""); err != nil {
+				errs = append(errs, fmt.Errorf("enum value %s: %w", valueDesc.FullName(), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// unknownOptions returns an error naming the first field or enum value of the
+// options, or of any message within them, which this build has no definition
+// for. path prefixes the names in the error, e.g. "number.".
+func unknownOptions(msg protoreflect.Message, path string) error {
+	if unknown := msg.GetUnknown(); len(unknown) > 0 {
+		num, _, _ := protowire.ConsumeTag(unknown)
+		where := string(msg.Descriptor().FullName())
+		if path != "" {
+			where = strings.TrimSuffix(path, ".")
+		}
+		return fmt.Errorf("unknown option %d in %s, from a newer version of the annotations", num, where)
+	}
+
+	var err error
+	msg.Range(func(fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		name := path + string(fieldDesc.Name())
+		switch {
+		case fieldDesc.IsMap():
+			if fieldDesc.MapValue().Message() == nil {
+				return true
+			}
+			val.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+				err = unknownOptions(val.Message(), fmt.Sprintf("%s[%s].", name, key))
+				return err == nil
+			})
+		case fieldDesc.IsList():
+			list := val.List()
+			for idx := range list.Len() {
+				err = unknownValue(fieldDesc, list.Get(idx), fmt.Sprintf("%s[%d]", name, idx))
+				if err != nil {
+					break
+				}
+			}
+		default:
+			err = unknownValue(fieldDesc, val, name)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func unknownValue(fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value, name string) error {
+	switch fieldDesc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return unknownOptions(val.Message(), name+".")
+	case protoreflect.EnumKind:
+		if fieldDesc.Enum().Values().ByNumber(val.Enum()) == nil {
+			return fmt.Errorf("unknown value %d of option %s, from a newer version of the annotations", val.Enum(), name)
+		}
+	}
+	return nil
+}
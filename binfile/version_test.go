@@ -0,0 +1,94 @@
+package binfile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestCheckSupported(t *testing.T) {
+	msgDesc := singleMessage(t, `
+	  option (flatfile.v1.message) = { annotations_version: 1 };
+	  int32 count = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 3 }, number: {} }];
+	`)
+	if err := ValidateMessageDescriptor(msgDesc); err != nil {
+		t.Fatal(err)
+	}
+
+	// newerOptions rebuilds the message with its options changed as a
+	// build of newer annotations might have written them.
+	newerOptions := func(t *testing.T, edit func(ext *flatfile_pb.Message, tc *flatfile_pb.Field)) protoreflect.MessageDescriptor {
+		t.Helper()
+		fileProto := protodesc.ToFileDescriptorProto(msgDesc.ParentFile())
+		msgProto := fileProto.MessageType[0]
+		ext := proto.GetExtension(msgProto.Options, flatfile_pb.E_Message).(*flatfile_pb.Message)
+		tc := proto.GetExtension(msgProto.Field[0].Options, flatfile_pb.E_Field).(*flatfile_pb.Field)
+		edit(ext, tc)
+		proto.SetExtension(msgProto.Options, flatfile_pb.E_Message, ext)
+		proto.SetExtension(msgProto.Field[0].Options, flatfile_pb.E_Field, tc)
+
+		fileProto.Name = proto.String("newer/" + fileProto.GetName())
+		file, err := protodesc.NewFile(fileProto, protoregistry.GlobalFiles)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return file.Messages().Get(0)
+	}
+	unknownField := func(msg proto.Message) {
+		b := protowire.AppendTag(nil, 99, protowire.VarintType)
+		msg.ProtoReflect().SetUnknown(protowire.AppendVarint(b, 1))
+	}
+
+	for _, tc := range []struct {
+		name    string
+		edit    func(ext *flatfile_pb.Message, tc *flatfile_pb.Field)
+		wantErr string
+	}{{
+		name: "newer version",
+		edit: func(ext *flatfile_pb.Message, tc *flatfile_pb.Field) {
+			ext.AnnotationsVersion = AnnotationsVersion + 1
+		},
+		wantErr: "annotations_version 2 is newer than 1",
+	}, {
+		name: "unknown message option",
+		edit: func(ext *flatfile_pb.Message, tc *flatfile_pb.Field) {
+			unknownField(ext)
+		},
+		wantErr: "unknown option 99 in flatfile.v1.Message",
+	}, {
+		name: "unknown field option",
+		edit: func(ext *flatfile_pb.Message, tc *flatfile_pb.Field) {
+			unknownField(tc.GetNumber())
+		},
+		wantErr: "unknown option 99 in number",
+	}, {
+		name: "unknown enum value",
+		edit: func(ext *flatfile_pb.Message, tc *flatfile_pb.Field) {
+			tc.GetNumber().Encoding = flatfile_pb.Encoding(42)
+		},
+		wantErr: "unknown value 42 of option number.encoding",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			desc := newerOptions(t, tc.edit)
+			err := ValidateMessageDescriptor(desc)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+			if _, err := Compile(desc); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected Compile error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+
+	// The descriptor is rebuilt unchanged without an error.
+	desc := newerOptions(t, func(ext *flatfile_pb.Message, tc *flatfile_pb.Field) {})
+	if _, err := Compile(desc); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/j5/lib/j5codec"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// maxLine bounds the length of a line of input, whether JSON or a record.
+const maxLine = 16 * 1024 * 1024
+
+func buildCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var output string
+	var crlf bool
+
+	cmd := &cobra.Command{
+		Use:   "build [flags] [FILE]",
+		Short: "Build a fixed width file from JSON Lines",
+		Long: "Reads one JSON object per line, from FILE or stdin, and writes each as a fixed width record.\n" +
+			"The JSON uses the same encoding as parse writes, so parsed files can be edited and rebuilt.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msgDesc, err := schema.messageDescriptor(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			path := "-"
+			if len(args) == 1 {
+				path = args[0]
+			}
+			in, err := openInput(cmd, path)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+
+			var opts []binfile.FileWriterOption
+			if crlf {
+				opts = append(opts, binfile.WithLineEnding("\r\n"))
+			}
+			if err := buildRecords(in, binfile.NewFileWriter(out, opts...), func() *dynamicpb.Message {
+				return dynamicpb.NewMessage(msgDesc)
+			}); err != nil {
+				return err
+			}
+
+			return closeOut()
+		},
+	}
+	schema.register(cmd)
+	cmd.Flags().StringVarP(&output, "output", "o", "", "write the records to this file rather than stdout")
+	cmd.Flags().BoolVar(&crlf, "crlf", false, `end records with "\r\n" rather than "\n"`)
+	return cmd
+}
+
+// buildRecords writes each JSON line of in as a record. Blank lines are
+// skipped. The first line which does not decode or format stops the build,
+// as a partial outbound file is rarely useful.
+func buildRecords(in io.Reader, fw *binfile.FileWriter, newMessage func() *dynamicpb.Message) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, maxLine)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		msg := newMessage()
+		if err := j5codec.Global.JSONToProto(data, msg); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := fw.Write(msg); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return fw.Flush()
+}
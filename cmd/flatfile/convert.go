@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pentops/flatfile/arrow"
+	"github.com/pentops/flatfile/avro"
+	"github.com/pentops/flatfile/parquet"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func convertCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	input := &recordInput{}
+	var format, output, avroSchema string
+	var noHeader bool
+
+	cmd := &cobra.Command{
+		Use:   "convert [flags] FILE",
+		Short: "Convert a fixed width file to CSV, Arrow, Parquet or Avro",
+		Long: "Writes a column for each fixed width field of the message, in the order the message\n" +
+			"declares them, with a header row of the field names. Dates are written as YYYY-MM-DD,\n" +
+			"enums by value name and unset fields as empty cells, for loading into warehouses.\n" +
+			"Rejected records are handled as by parse.\n\n" +
+			"The arrow format is an Arrow IPC stream of every field of the message, with the schema\n" +
+			"derived from the message, for reading with pyarrow, DuckDB and other Arrow tooling.\n" +
+			"The parquet format is a Parquet file of the same schema, for landing in a data lake.\n\n" +
+			"The avro format is an Avro object container file of every field of the message, with\n" +
+			"the schema derived from the message. --avro-schema writes the schema alone, e.g. to\n" +
+			"register it for a Kafka topic.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msgDesc, err := schema.messageDescriptor(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			var comma rune
+			switch format {
+			case "csv":
+				comma = ','
+			case "tsv":
+				comma = '\t'
+			case "arrow", "parquet":
+				builder, err := arrow.NewRecordBuilder(msgDesc)
+				if err != nil {
+					return err
+				}
+				return convertRecords(cmd, input, msgDesc, args[0], output, func(w io.Writer) (messageWriter, error) {
+					if format == "parquet" {
+						return parquet.NewWriter(w, builder)
+					}
+					return arrow.NewWriter(w, builder)
+				})
+			case "avro":
+				return convertAvro(cmd, input, msgDesc, args[0], output, avroSchema)
+			default:
+				return fmt.Errorf("unknown format %q, expected csv, tsv, arrow, parquet or avro", format)
+			}
+
+			var columns []protoreflect.FieldDescriptor
+			fields := msgDesc.Fields()
+			for i := range fields.Len() {
+				if fieldOptions(fields.Get(i)).GetFixedWidth() != nil {
+					columns = append(columns, fields.Get(i))
+				}
+			}
+
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+
+			writer := csv.NewWriter(out)
+			writer.Comma = comma
+			row := make([]string, len(columns))
+			if !noHeader {
+				for idx, fieldDesc := range columns {
+					row[idx] = string(fieldDesc.Name())
+				}
+				writer.Write(row) //nolint:errcheck // checked by Error
+			}
+
+			err = input.read(cmd, msgDesc, args[0], func(_ int, msg protoreflect.Message) error {
+				for idx, fieldDesc := range columns {
+					row[idx] = cellValue(msg, fieldDesc)
+				}
+				if err := writer.Write(row); err != nil {
+					return err
+				}
+				if input.follow {
+					writer.Flush()
+					return writer.Error()
+				}
+				return nil
+			})
+			writer.Flush()
+			if flushErr := writer.Error(); flushErr != nil {
+				return flushErr
+			}
+			if err != nil {
+				return err
+			}
+			return closeOut()
+		},
+	}
+	schema.register(cmd)
+	input.register(cmd)
+	flags := cmd.Flags()
+	flags.StringVarP(&format, "format", "f", "csv", "csv, tsv, arrow, parquet or avro")
+	flags.BoolVar(&noHeader, "no-header", false, "leave out the row of field names")
+	flags.StringVar(&avroSchema, "avro-schema", "", "with --format avro, also write the Avro schema to this file")
+	flags.StringVarP(&output, "output", "o", "", "write to this file rather than stdout")
+	return cmd
+}
+
+// messageWriter writes records in one of the formats of whole messages.
+type messageWriter interface {
+	Write(msg proto.Message) error
+	Flush() error
+	Close() error
+}
+
+// convertRecords writes the records of the file with the writer newWriter
+// opens on the output.
+func convertRecords(cmd *cobra.Command, input *recordInput, msgDesc protoreflect.MessageDescriptor, path, output string, newWriter func(w io.Writer) (messageWriter, error)) error {
+	out, closeOut, err := openOutput(cmd, output)
+	if err != nil {
+		return err
+	}
+	defer closeOut() //nolint:errcheck // checked on success
+
+	writer, err := newWriter(out)
+	if err != nil {
+		return err
+	}
+	err = input.read(cmd, msgDesc, path, func(_ int, msg protoreflect.Message) error {
+		if err := writer.Write(msg.Interface()); err != nil {
+			return err
+		}
+		if input.follow {
+			return writer.Flush()
+		}
+		return nil
+	})
+	if closeErr := writer.Close(); closeErr != nil {
+		return closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return closeOut()
+}
+
+// convertAvro writes the records of the file to an Avro object container
+// file, and the schema to schemaPath when it is set.
+func convertAvro(cmd *cobra.Command, input *recordInput, msgDesc protoreflect.MessageDescriptor, path, output, schemaPath string) error {
+	encoder, err := avro.NewEncoder(msgDesc)
+	if err != nil {
+		return err
+	}
+	if schemaPath != "" {
+		if err := os.WriteFile(schemaPath, encoder.Schema(), 0o644); err != nil {
+			return err
+		}
+	}
+	return convertRecords(cmd, input, msgDesc, path, output, func(w io.Writer) (messageWriter, error) {
+		return avro.NewWriter(w, encoder)
+	})
+}
+
+// cellValue renders a field as formatValue does, without quoting strings,
+// which the CSV writer quotes as needed.
+func cellValue(msg protoreflect.Message, fieldDesc protoreflect.FieldDescriptor) string {
+	if fieldDesc.HasPresence() && !msg.Has(fieldDesc) {
+		return ""
+	}
+	val := msg.Get(fieldDesc)
+	if fieldDesc.Kind() == protoreflect.StringKind {
+		return val.String()
+	}
+	if fieldDesc.Message() != nil && fieldDesc.Message().FullName() == "google.protobuf.StringValue" {
+		return val.Message().Get(fieldDesc.Message().Fields().ByName("value")).String()
+	}
+	return formatValue(fieldDesc, val)
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/flatfile/copybook"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func copybookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "copybook",
+		Short: "Work with COBOL copybooks",
+	}
+	cmd.AddCommand(copybookImportCommand())
+	return cmd
+}
+
+func copybookImportCommand() *cobra.Command {
+	var pkg, goPackage, output string
+
+	cmd := &cobra.Command{
+		Use:   "import [flags] COPYBOOK",
+		Short: "Translate a COBOL copybook into an annotated proto",
+		Long: "Writes a .proto file with a message for each 01 level record of the copybook. Group\n" +
+			"items are flattened into their record, OCCURS are expanded into numbered fields, and\n" +
+			"88 level conditions on alphanumeric items become enums keyed by their values.\n\n" +
+			"COMP-3 items are read as packed decimal, COMP and BINARY as big endian binary, and signed\n" +
+			"DISPLAY items as trailing overpunch. Items which cannot be mapped, such as REDEFINES and\n" +
+			"floating point, are left as comments in the output to be finished by hand.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			layout, err := copybook.Parse(in)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+
+			if pkg == "" {
+				base := strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+				pkg = protoIdent(base) + ".v1"
+			}
+
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+
+			if _, err := io.WriteString(out, copybookProto(layout, pkg, goPackage)); err != nil {
+				return err
+			}
+			return closeOut()
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&pkg, "package", "", "the proto package, by default the copybook's file name with .v1")
+	flags.StringVar(&goPackage, "go-package", "", "the go_package option, left out when empty")
+	flags.StringVarP(&output, "output", "o", "", "write the proto to this file rather than stdout")
+	return cmd
+}
+
+// protoIdent turns a COBOL name or spreadsheet heading into a lower snake case identifier.
+func protoIdent(name string) string {
+	var sb strings.Builder
+	for _, c := range strings.ToLower(name) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			sb.WriteRune(c)
+		default:
+			sb.WriteByte('_')
+		}
+	}
+	out := strings.Trim(sb.String(), "_")
+	if out == "" || out[0] >= '0' && out[0] <= '9' {
+		out = "f_" + out
+	}
+	return out
+}
+
+// protoTypeName turns a COBOL name or spreadsheet heading into an upper camel case type name.
+func protoTypeName(name string) string {
+	var sb strings.Builder
+	for part := range strings.SplitSeq(protoIdent(name), "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
+
+type protoWriter struct {
+	sb      strings.Builder
+	date    bool
+	decimal bool
+	enums   []string
+	types   map[string]bool
+}
+
+// typeName returns a unique top level type name based on name.
+func (pw *protoWriter) typeName(name string) string {
+	if pw.types == nil {
+		pw.types = map[string]bool{}
+	}
+	out := protoTypeName(name)
+	for n := 2; pw.types[out]; n++ {
+		out = fmt.Sprintf("%s%d", protoTypeName(name), n)
+	}
+	pw.types[out] = true
+	return out
+}
+
+// copybookProto renders the records as a proto file.
+func copybookProto(layout *copybook.Layout, pkg, goPackage string) string {
+	pw := &protoWriter{}
+	var messages strings.Builder
+	for idx, record := range layout.Flatten() {
+		if idx > 0 {
+			messages.WriteString("\n")
+		}
+		pw.copybookMessage(&messages, record)
+	}
+
+	return pw.file("copybook import", pkg, goPackage, messages.String())
+}
+
+// file renders the messages and any enums as a proto file, importing the j5
+// types which were used.
+func (pw *protoWriter) file(generator, pkg, goPackage, messages string) string {
+	out := &pw.sb
+	fmt.Fprintf(out, "// Code generated by flatfile %s. Review before use.\n\n", generator)
+	out.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(out, "package %s;\n\n", pkg)
+	out.WriteString("import \"flatfile/v1/annotations.proto\";\n")
+	if pw.date {
+		out.WriteString("import \"j5/types/date/v1/date.proto\";\n")
+	}
+	if pw.decimal {
+		out.WriteString("import \"j5/types/decimal/v1/decimal.proto\";\n")
+	}
+	if goPackage != "" {
+		fmt.Fprintf(out, "\noption go_package = %s;\n", strconv.Quote(goPackage))
+	}
+	out.WriteString("\n")
+	out.WriteString(messages)
+	for _, enum := range pw.enums {
+		out.WriteString("\n")
+		out.WriteString(enum)
+	}
+	return out.String()
+}
+
+// copybookMessage writes the message for a flattened record.
+func (pw *protoWriter) copybookMessage(out *strings.Builder, record *copybook.Record) {
+	fields := &strings.Builder{}
+	number := 0
+	for _, field := range record.Fields {
+		for _, note := range field.Notes {
+			fmt.Fprintf(fields, "  // %s\n", note)
+		}
+		if field.Options == nil {
+			continue
+		}
+		number++
+		fmt.Fprintf(fields, "  %s %s = %d [(flatfile.v1.field) = {\n    fixed_width: { offset: %d, length: %d }\n    %s\n  }]; // PIC %s%s\n",
+			pw.copybookType(field), field.Name, number, field.Offset, field.Length, copybookOptions(field.Options), field.Item.Picture, usageComment(field.Item))
+	}
+
+	fmt.Fprintf(out, "// %s, from line %d of the copybook.\n", record.Item.Name, record.Item.Line)
+	fmt.Fprintf(out, "message %s {\n", pw.typeName(record.Item.Name))
+	if record.Length > 0 {
+		if len(record.Filler) > 0 {
+			filler := make([]string, 0, len(record.Filler))
+			for _, span := range record.Filler {
+				filler = append(filler, fmt.Sprintf("{ offset: %d, length: %d }", span.Offset, span.Length))
+			}
+			fmt.Fprintf(out, "  option (flatfile.v1.message) = {\n    record_length: %d\n    filler: [%s]\n  };\n\n", record.Length, strings.Join(filler, ", "))
+		} else {
+			fmt.Fprintf(out, "  option (flatfile.v1.message).record_length = %d;\n\n", record.Length)
+		}
+	}
+	out.WriteString(fields.String())
+	out.WriteString("}\n")
+}
+
+// copybookType returns the proto type of the field, adding the enum of its
+// conditions when it has one.
+func (pw *protoWriter) copybookType(field *copybook.Field) string {
+	switch field.Kind {
+	case protoreflect.MessageKind:
+		pw.decimal = true
+		return "j5.types.decimal.v1.Decimal"
+	case protoreflect.EnumKind:
+		return pw.conditionEnum(field)
+	default:
+		return field.Kind.String()
+	}
+}
+
+// copybookOptions writes the type options of a field as the import sets them.
+func copybookOptions(opts *flatfile_pb.Field) string {
+	switch {
+	case opts.GetString_() != nil:
+		return "string: { trim: TRIM_RIGHT }"
+	case opts.GetEnum() != nil:
+		return "enum: { trim: TRIM_RIGHT }"
+	}
+	var number []string
+	if encoding := opts.GetNumber().GetEncoding(); encoding != flatfile_pb.Encoding_ENCODING_UNSPECIFIED {
+		number = append(number, "encoding: "+encoding.String())
+	}
+	if scale := opts.GetNumber().GetFixedScale(); scale > 0 {
+		number = append(number, fmt.Sprintf("fixed_scale: %d", scale))
+	}
+	if len(number) == 0 {
+		return "number: {}"
+	}
+	return "number: { " + strings.Join(number, ", ") + " }"
+}
+
+func usageComment(item *copybook.Item) string {
+	if item.Usage == copybook.UsageDisplay {
+		return ""
+	}
+	return " " + string(item.Usage)
+}
+
+// conditionEnum adds an enum for the 88 level conditions of a field, keyed
+// by their values, returning its name.
+func (pw *protoWriter) conditionEnum(field *copybook.Field) string {
+	enumName := pw.typeName(field.Name)
+	prefix := strings.ToUpper(field.Name) + "_"
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "// The 88 level conditions of %s.\n", field.Item.Name)
+	fmt.Fprintf(sb, "enum %s {\n", enumName)
+	fmt.Fprintf(sb, "  %sUNSPECIFIED = 0;\n", prefix)
+	for idx, condition := range field.Item.Conditions {
+		fmt.Fprintf(sb, "  %s%s = %d [(flatfile.v1.enum).key = %s];\n",
+			prefix, strings.ToUpper(protoIdent(condition.Name)), idx+1, strconv.Quote(condition.Values[0]))
+	}
+	sb.WriteString("}\n")
+	pw.enums = append(pw.enums, sb.String())
+	return enumName
+}
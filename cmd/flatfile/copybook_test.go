@@ -0,0 +1,78 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCopybook = `
+      * Customer master record
+       01  CUSTOMER-RECORD.
+           05  CUST-TYPE           PIC X.
+               88  CUST-RETAIL     VALUE 'R'.
+               88  CUST-WHOLESALE  VALUE 'W'.
+           05  CUST-ID             PIC 9(8).
+           05  CUST-NAME           PIC X(20).
+           05  CUST-BALANCE        PIC S9(7)V99 COMP-3.
+           05  CUST-LIMIT          PIC S9(5)V99.
+           05  CUST-ADDR.
+               10  ADDR-LINE       PIC X(10) OCCURS 2 TIMES.
+               10  ADDR-ZIP        PIC 9(5).
+           05  CUST-ALT REDEFINES CUST-ADDR PIC X(25).
+           05  FILLER              PIC X(3).
+`
+
+func TestCopybookImport(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"custrec.cpy": testCopybook})
+	protoFile := filepath.Join(dir, "custrec.proto")
+
+	if _, _, err := runCommand(t, "copybook", "import", "-o", protoFile, filepath.Join(dir, "custrec.cpy")); err != nil {
+		t.Fatal(err)
+	}
+	data, _, err := runCommand(t, "doc", "-I", dir, "--proto", "custrec.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"Records are 69 bytes.",
+		`| 0-0 | 1 | cust_type | enum | "R" = CUST_TYPE_CUST_RETAIL, "W" = CUST_TYPE_CUST_WHOLESALE |`,
+		"| 29-33 | 5 | cust_balance | decimal | packed decimal; 2 implied decimal places |",
+		"| 34-40 | 7 | cust_limit | decimal | signed overpunch; 2 implied decimal places |",
+		"| 51-60 | 10 | addr_line_2 | string |",
+		"| 66-68 | 3 | (filler) |",
+	} {
+		if !strings.Contains(data, want) {
+			t.Errorf("layout does not contain %q:\n%s", want, data)
+		}
+	}
+
+	// Records generated from the imported schema parse under it.
+	schema := []string{"-I", dir, "--proto", "custrec.proto", "--message", "custrec.v1.CustomerRecord"}
+	records := filepath.Join(dir, "records.txt")
+	if _, _, err := runCommand(t, append([]string{"gen-fixture", "--rand-seed", "1", "-o", records}, schema...)...); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := runCommand(t, append(append([]string{"parse"}, schema...), records)...); err != nil {
+		t.Fatal(err)
+	}
+
+	// The V of a picture is the implied decimal point of the parsed value.
+	record := "W" + "00001234" + "ACME WHOLESALE      " +
+		"\x00\x01\x23\x45\x6c" + "000250{" +
+		"1 MAIN ST " + "SPRINGFLD " + "12345" + "   "
+	known := filepath.Join(dir, "known.txt")
+	if err := os.WriteFile(known, []byte(record+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	parsed, _, err := runCommand(t, append(append([]string{"parse"}, schema...), known)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"custBalance":"1234.56"`, `"custLimit":"25"`} {
+		if !strings.Contains(parsed, want) {
+			t.Errorf("parsed record does not contain %s:\n%s", want, parsed)
+		}
+	}
+}
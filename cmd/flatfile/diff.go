@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func diffCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var keys, ignore []string
+
+	cmd := &cobra.Command{
+		Use:   "diff [flags] OLD NEW",
+		Short: "Compare two files field by field",
+		Long: "Parses both files and reports the records only in one of them and the fields which differ\n" +
+			"between matching records. Records are matched on the key_fields of the message, or --key,\n" +
+			"and by position when there are none. Values are compared as parsed, so \"0012\" and\n" +
+			"\"  12\" in a number field are the same. Exits non-zero when the files differ.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msgDesc, err := schema.messageDescriptor(cmd.Context())
+			if err != nil {
+				return err
+			}
+			parser, err := binfile.Compile(msgDesc)
+			if err != nil {
+				return err
+			}
+
+			if len(keys) == 0 {
+				ext, _ := proto.GetExtension(msgDesc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+				keys = ext.GetKeyFields()
+			}
+			var keyFields []protoreflect.FieldDescriptor
+			for _, name := range keys {
+				fieldDesc := msgDesc.Fields().ByName(protoreflect.Name(name))
+				if fieldDesc == nil {
+					return fmt.Errorf("key field %s not found in %s", name, msgDesc.FullName())
+				}
+				keyFields = append(keyFields, fieldDesc)
+			}
+
+			var compare []protoreflect.FieldDescriptor
+			fields := msgDesc.Fields()
+			for i := range fields.Len() {
+				fieldDesc := fields.Get(i)
+				if fieldOptions(fieldDesc).GetFixedWidth() == nil || slices.Contains(ignore, string(fieldDesc.Name())) {
+					continue
+				}
+				compare = append(compare, fieldDesc)
+			}
+
+			if args[0] == "-" && args[1] == "-" {
+				return fmt.Errorf("only one of the files can be stdin")
+			}
+			var files [2]*diffFile
+			for idx, path := range args {
+				in, err := openInput(cmd, path)
+				if err != nil {
+					return err
+				}
+				files[idx], err = readDiffFile(parser, keyFields, path, in)
+				in.Close()
+				if err != nil {
+					return err
+				}
+			}
+			oldFile, newFile := files[0], files[1]
+
+			out := bufio.NewWriter(cmd.OutOrStdout())
+			differences := writeDiff(out, oldFile, newFile, compare)
+			if err := out.Flush(); err != nil {
+				return err
+			}
+			if differences > 0 {
+				return fmt.Errorf("%d differences", differences)
+			}
+			return nil
+		},
+	}
+	schema.register(cmd)
+	cmd.Flags().StringArrayVar(&keys, "key", nil, "fields to match records on, instead of the key_fields of the message")
+	cmd.Flags().StringArrayVar(&ignore, "ignore", nil, "fields to leave out of the comparison, e.g. run dates")
+	return cmd
+}
+
+type diffRecord struct {
+	number int
+	key    string
+	msg    protoreflect.Message
+	err    error
+}
+
+type diffFile struct {
+	name    string
+	records []*diffRecord
+	byKey   map[string]*diffRecord
+
+	// duplicates are records with the key of an earlier record, which are
+	// reported rather than compared.
+	duplicates []*diffRecord
+}
+
+func readDiffFile(parser *binfile.MessageParser, keyFields []protoreflect.FieldDescriptor, path string, in io.Reader) (*diffFile, error) {
+	file := &diffFile{
+		name:  path,
+		byKey: map[string]*diffRecord{},
+	}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, maxLine)
+	for scanner.Scan() {
+		record := &diffRecord{number: len(file.records) + 1}
+		msg := dynamicpb.NewMessage(parser.Descriptor())
+		if err := parser.Parse(msg, scanner.Bytes()); err != nil {
+			record.err = err
+			file.records = append(file.records, record)
+			continue
+		}
+		record.msg = msg
+
+		if len(keyFields) == 0 {
+			record.key = fmt.Sprintf("record %d", record.number)
+		} else {
+			parts := make([]string, 0, len(keyFields))
+			for _, fieldDesc := range keyFields {
+				parts = append(parts, formatValue(fieldDesc, msg.Get(fieldDesc)))
+			}
+			record.key = strings.Join(parts, ", ")
+		}
+
+		file.records = append(file.records, record)
+		if _, ok := file.byKey[record.key]; ok {
+			file.duplicates = append(file.duplicates, record)
+			continue
+		}
+		file.byKey[record.key] = record
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return file, nil
+}
+
+// writeDiff writes the differences in the order of the old file, then the
+// records only in the new file, returning the number of differences.
+func writeDiff(out io.Writer, oldFile, newFile *diffFile, compare []protoreflect.FieldDescriptor) int {
+	var matched, changed, onlyOld, onlyNew, failed int
+
+	for _, file := range []*diffFile{oldFile, newFile} {
+		for _, record := range file.records {
+			if record.err != nil {
+				failed++
+				fmt.Fprintf(out, "! %s record %d: %s\n", file.name, record.number, record.err)
+			}
+		}
+		for _, record := range file.duplicates {
+			failed++
+			fmt.Fprintf(out, "! %s record %d: duplicate key %s\n", file.name, record.number, record.key)
+		}
+	}
+
+	for _, oldRecord := range oldFile.records {
+		if oldRecord.msg == nil || oldFile.byKey[oldRecord.key] != oldRecord {
+			continue
+		}
+		newRecord, ok := newFile.byKey[oldRecord.key]
+		if !ok {
+			onlyOld++
+			fmt.Fprintf(out, "- %s (record %d)\n", oldRecord.key, oldRecord.number)
+			continue
+		}
+		matched++
+
+		var changes []string
+		for _, fieldDesc := range compare {
+			oldVal, newVal := fieldValue(oldRecord.msg, fieldDesc), fieldValue(newRecord.msg, fieldDesc)
+			if !sameValue(fieldDesc, oldVal, newVal) {
+				changes = append(changes, fmt.Sprintf("%s: %s -> %s",
+					fieldDesc.Name(), formatValue(fieldDesc, oldVal), formatValue(fieldDesc, newVal)))
+			}
+		}
+		if len(changes) > 0 {
+			changed++
+			fmt.Fprintf(out, "~ %s (records %d, %d)\n", oldRecord.key, oldRecord.number, newRecord.number)
+			for _, change := range changes {
+				fmt.Fprintf(out, "    %s\n", change)
+			}
+		}
+	}
+
+	for _, newRecord := range newFile.records {
+		if newRecord.msg == nil || newFile.byKey[newRecord.key] != newRecord {
+			continue
+		}
+		if _, ok := oldFile.byKey[newRecord.key]; !ok {
+			onlyNew++
+			fmt.Fprintf(out, "+ %s (record %d)\n", newRecord.key, newRecord.number)
+		}
+	}
+
+	fmt.Fprintf(out, "%d matched, %d changed, %d removed, %d added, %d unreadable\n", matched, changed, onlyOld, onlyNew, failed)
+	return changed + onlyOld + onlyNew + failed
+}
+
+// fieldValue returns the value of the field, or an invalid value when it is
+// unset.
+func fieldValue(msg protoreflect.Message, fieldDesc protoreflect.FieldDescriptor) protoreflect.Value {
+	if !msg.Has(fieldDesc) {
+		return protoreflect.Value{}
+	}
+	return msg.Get(fieldDesc)
+}
+
+// sameValue compares parsed values, treating decimals which differ only in
+// trailing zeros as the same.
+func sameValue(fieldDesc protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if fieldDesc.Kind() != protoreflect.MessageKind {
+		return a.Equal(b)
+	}
+	if fieldDesc.Message().FullName() == "j5.types.decimal.v1.Decimal" {
+		value := fieldDesc.Message().Fields().ByName("value")
+		aDec, aErr := decimal.NewFromString(a.Message().Get(value).String())
+		bDec, bErr := decimal.NewFromString(b.Message().Get(value).String())
+		if aErr == nil && bErr == nil {
+			return aDec.Equal(bDec)
+		}
+	}
+	return proto.Equal(a.Message().Interface(), b.Message().Interface())
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func docCommand() *cobra.Command {
+	schema := &schemaFlags{optionalMessage: true}
+	var format, output string
+
+	cmd := &cobra.Command{
+		Use:   "doc [flags]",
+		Short: "Render record layouts as a Markdown or HTML specification",
+		Long: "Writes a table of the position, length, type, format and description of each field, for\n" +
+			"sharing layouts with partners. Descriptions are the description options of the fields, or\n" +
+			"else their comments, which need --proto sources or descriptor sets built with source info.\n" +
+			"Messages with example options on their fields have a column of examples.\n\n" +
+			"The json format is the structured layout of each message, with the offset, length, type,\n" +
+			"encoding and format of every field, for tools which build on layouts.\n\n" +
+			"Without --message, every message of the schema with fixed width fields is included.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			var messages []protoreflect.MessageDescriptor
+			if schema.message != "" {
+				msgDesc, err := findMessage(files, schema.message)
+				if err != nil {
+					return err
+				}
+				messages = append(messages, msgDesc)
+			} else {
+				messages = layoutMessages(files)
+				if len(messages) == 0 {
+					return fmt.Errorf("no messages with fixed width fields")
+				}
+			}
+
+			layouts := make([]*binfile.MessageLayout, 0, len(messages))
+			docs := make([]*docMessage, 0, len(messages))
+			for _, msgDesc := range messages {
+				layout := binfile.DescribeLayout(msgDesc)
+				layouts = append(layouts, layout)
+				docs = append(docs, describeMessage(layout))
+			}
+
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+
+			switch format {
+			case "markdown", "md":
+				err = writeMarkdown(out, docs)
+			case "html":
+				err = htmlDoc.Execute(out, docs)
+			case "json":
+				encoder := json.NewEncoder(out)
+				encoder.SetIndent("", "  ")
+				err = encoder.Encode(layouts)
+			default:
+				return fmt.Errorf("unknown format %q, expected markdown, html or json", format)
+			}
+			if err != nil {
+				return err
+			}
+			return closeOut()
+		},
+	}
+	schema.register(cmd)
+	cmd.Flags().StringVarP(&format, "format", "f", "markdown", "markdown, html or json")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "write the document to this file rather than stdout")
+	return cmd
+}
+
+type docMessage struct {
+	Name        string
+	Description string
+	Notes       []string
+	// Examples is true when any field has an example, adding the column.
+	Examples bool
+	Rows     []docRow
+}
+
+type docRow struct {
+	Position    string
+	Length      int
+	Field       string
+	Type        string
+	Format      string
+	Required    bool
+	Description string
+	Example     string
+}
+
+// layoutMessages returns the messages of the files, including nested
+// messages, which have at least one fixed width field.
+func layoutMessages(files *protoregistry.Files) []protoreflect.MessageDescriptor {
+	var out []protoreflect.MessageDescriptor
+	var walk func(messages protoreflect.MessageDescriptors)
+	walk = func(messages protoreflect.MessageDescriptors) {
+		for i := range messages.Len() {
+			msgDesc := messages.Get(i)
+			fields := msgDesc.Fields()
+			for j := range fields.Len() {
+				if fieldOptions(fields.Get(j)).GetFixedWidth() != nil {
+					out = append(out, msgDesc)
+					break
+				}
+			}
+			walk(msgDesc.Messages())
+		}
+	}
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		walk(fd.Messages())
+		return true
+	})
+	slices.SortFunc(out, func(a, b protoreflect.MessageDescriptor) int {
+		return cmp.Compare(a.FullName(), b.FullName())
+	})
+	return out
+}
+
+func fieldOptions(fieldDesc protoreflect.FieldDescriptor) *flatfile_pb.Field {
+	tc, _ := proto.GetExtension(fieldDesc.Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+	return tc
+}
+
+func describeMessage(layout *binfile.MessageLayout) *docMessage {
+	doc := &docMessage{
+		Name:        layout.Message,
+		Description: layout.Description,
+	}
+	if layout.RecordLength > 0 {
+		switch layout.RecordLengthMode {
+		case "minimum":
+			doc.Notes = append(doc.Notes, fmt.Sprintf("Records are at least %d bytes.", layout.RecordLength))
+		case "maximum":
+			doc.Notes = append(doc.Notes, fmt.Sprintf("Records are at most %d bytes.", layout.RecordLength))
+		default:
+			doc.Notes = append(doc.Notes, fmt.Sprintf("Records are %d bytes.", layout.RecordLength))
+		}
+	}
+	if layout.OneBased {
+		doc.Notes = append(doc.Notes, "Positions start at 1.")
+	} else {
+		doc.Notes = append(doc.Notes, "Positions start at 0.")
+	}
+
+	for _, field := range layout.Fields {
+		name := field.Name
+		if field.Filler {
+			name = "(filler)"
+		}
+		doc.Rows = append(doc.Rows, docRow{
+			Position:    fmt.Sprintf("%d-%d", field.Offset, field.Offset+field.Length-1),
+			Length:      field.Length,
+			Field:       name,
+			Type:        field.Type,
+			Format:      field.Format,
+			Required:    field.Required,
+			Description: field.Description,
+			Example:     field.Example,
+		})
+		if field.Example != "" {
+			doc.Examples = true
+		}
+	}
+	return doc
+}
+
+func writeMarkdown(out io.Writer, docs []*docMessage) error {
+	sb := &strings.Builder{}
+	cell := strings.NewReplacer("|", `\|`, "\n", " ")
+	for idx, doc := range docs {
+		if idx > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(sb, "## %s\n\n", doc.Name)
+		if doc.Description != "" {
+			fmt.Fprintf(sb, "%s\n\n", doc.Description)
+		}
+		if len(doc.Notes) > 0 {
+			fmt.Fprintf(sb, "%s\n\n", strings.Join(doc.Notes, " "))
+		}
+		sb.WriteString("| Position | Length | Field | Type | Format | Required | Description |")
+		if doc.Examples {
+			sb.WriteString(" Example |")
+		}
+		sb.WriteString("\n|---|---|---|---|---|---|---|")
+		if doc.Examples {
+			sb.WriteString("---|")
+		}
+		sb.WriteString("\n")
+		for _, row := range doc.Rows {
+			required := ""
+			if row.Required {
+				required = "yes"
+			}
+			fmt.Fprintf(sb, "| %s | %d | %s | %s | %s | %s | %s |",
+				row.Position, row.Length, cell.Replace(row.Field), row.Type,
+				cell.Replace(row.Format), required, cell.Replace(row.Description))
+			if doc.Examples {
+				fmt.Fprintf(sb, " %s |", exampleCell(row.Example))
+			}
+			sb.WriteString("\n")
+		}
+	}
+	_, err := io.WriteString(out, sb.String())
+	return err
+}
+
+// exampleCell quotes an example as code, so that its spaces are visible.
+func exampleCell(example string) string {
+	if example == "" {
+		return ""
+	}
+	fence := "`"
+	for strings.Contains(example, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(example, "`") || strings.HasSuffix(example, "`") {
+		example = " " + example + " "
+	}
+	return fence + strings.ReplaceAll(strings.ReplaceAll(example, "|", `\|`), "\n", " ") + fence
+}
+
+var htmlDoc = template.Must(template.New("doc").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Record layouts</title>
+<style>
+table { border-collapse: collapse; }
+th, td { border: 1px solid #999; padding: 0.2em 0.5em; text-align: left; vertical-align: top; }
+</style>
+</head>
+<body>
+{{- range . }}
+{{- $examples := .Examples }}
+<h2>{{ .Name }}</h2>
+{{- if .Description }}
+<p>{{ .Description }}</p>
+{{- end }}
+{{- if .Notes }}
+<p>{{ range $idx, $note := .Notes }}{{ if $idx }} {{ end }}{{ $note }}{{ end }}</p>
+{{- end }}
+<table>
+<tr><th>Position</th><th>Length</th><th>Field</th><th>Type</th><th>Format</th><th>Required</th><th>Description</th>{{ if .Examples }}<th>Example</th>{{ end }}</tr>
+{{- range .Rows }}
+<tr><td>{{ .Position }}</td><td>{{ .Length }}</td><td>{{ .Field }}</td><td>{{ .Type }}</td><td>{{ .Format }}</td><td>{{ if .Required }}yes{{ end }}</td><td>{{ .Description }}</td>{{ if $examples }}<td>{{ if .Example }}<code>{{ .Example }}</code>{{ end }}</td>{{ end }}</tr>
+{{- end }}
+</table>
+{{- end }}
+</body>
+</html>
+`))
@@ -0,0 +1,534 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func exploreCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var types []string
+
+	cmd := &cobra.Command{
+		Use:   "explore [flags] FILE",
+		Short: "Page through a file record by record",
+		Long: "Pages through a file in the terminal one record at a time, with a pane of the record's bytes\n" +
+			"in hex above a pane of its fields as the inspect command shows them. Keys:\n\n" +
+			exploreHelp + "\n" +
+			"Records are parsed as --message, unless they start with the prefix of a --type. The file is\n" +
+			"indexed rather than held in memory, so it must be a file rather than stdin.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if args[0] == "-" {
+				return errors.New("explore reads keys from stdin, FILE must be a file")
+			}
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+			selectType, err := prefixSelector(files, schema.message, types)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			ex := &explorer{
+				file:       file,
+				selectType: selectType,
+				parsers:    map[protoreflect.FullName]*binfile.MessageParser{},
+				hex:        true,
+				focus:      paneFields,
+				size:       func() (int, int) { return 80, 24 },
+			}
+			if err := ex.buildIndex(file); err != nil {
+				return err
+			}
+			if len(ex.index) == 0 {
+				return errors.New("file has no records")
+			}
+
+			in, out := cmd.InOrStdin(), cmd.OutOrStdout()
+			if outFile, ok := out.(*os.File); ok && term.IsTerminal(int(outFile.Fd())) {
+				ex.size = func() (int, int) {
+					width, height, err := term.GetSize(int(outFile.Fd()))
+					if err != nil {
+						return 80, 24
+					}
+					return width, height
+				}
+			}
+			if inFile, ok := in.(*os.File); ok && term.IsTerminal(int(inFile.Fd())) {
+				state, err := term.MakeRaw(int(inFile.Fd()))
+				if err != nil {
+					return err
+				}
+				defer term.Restore(int(inFile.Fd()), state) //nolint:errcheck // nothing more can be done on exit
+				fmt.Fprint(out, enterScreen)
+				defer fmt.Fprint(out, leaveScreen)
+			}
+			return ex.run(in, out)
+		},
+	}
+	schema.register(cmd)
+	cmd.Flags().StringArrayVar(&types, "type", nil, "PREFIX=MESSAGE, parsing records which start with PREFIX as MESSAGE")
+	return cmd
+}
+
+const exploreHelp = `  n, p              next and previous record
+  home, end         first and last record
+  g, 0-9            go to a record by number
+  t                 only show records of a message type, or all records when empty
+  up, down, j, k    scroll the focused pane a line
+  space, b          scroll the focused pane a page
+  left, right       scroll the fields pane sideways
+  tab               focus the other pane
+  x                 hide or show the hex pane
+  ?                 show or hide this help
+  q                 quit
+`
+
+// Terminal control sequences. The explorer uses the alternate screen, so the
+// shell's scrollback is left as it was on exit.
+const (
+	enterScreen = "\x1b[?1049h\x1b[?25l"
+	leaveScreen = "\x1b[?25h\x1b[?1049l"
+	clearScreen = "\x1b[H\x1b[2J"
+	inverse     = "\x1b[7m"
+	resetStyle  = "\x1b[0m"
+)
+
+// recordSpan locates a record in the file, excluding its line ending.
+type recordSpan struct {
+	offset int64
+	length int
+}
+
+type explorer struct {
+	file       io.ReaderAt
+	index      []recordSpan
+	selectType binfile.TypeSelector
+	parsers    map[protoreflect.FullName]*binfile.MessageParser
+
+	// size returns the width and height of the terminal, asked again for
+	// each screen so that resizing takes effect on the next key.
+	size func() (int, int)
+
+	current int // zero based
+	filter  string
+	hex     bool
+	help    bool
+
+	// focus is the pane moved by the scroll keys, scroll the first line shown
+	// of each pane, and heights the lines each had on the last screen.
+	focus   pane
+	scroll  [2]int
+	heights [2]int
+	column  int // first column shown of the fields pane
+
+	// prompt is set while a line is read for g or t, into input.
+	prompt string
+	input  string
+
+	// status is shown at the bottom of the screen until the next key.
+	status string
+}
+
+type pane int
+
+const (
+	paneHex pane = iota
+	paneFields
+)
+
+const (
+	promptRecord = "go to record: "
+	promptType   = "message type: "
+)
+
+func (ex *explorer) buildIndex(r io.Reader) error {
+	// start is the offset of the token most recently returned by the split
+	// func, and next the offset after it.
+	var start, next int64
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLine)
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		if token != nil {
+			start = next
+		}
+		next += int64(advance)
+		return advance, token, err
+	})
+	for scanner.Scan() {
+		ex.index = append(ex.index, recordSpan{offset: start, length: len(scanner.Bytes())})
+	}
+	return scanner.Err()
+}
+
+func (ex *explorer) run(in io.Reader, out io.Writer) error {
+	keys := bufio.NewReader(in)
+	for {
+		if err := ex.render(out); err != nil {
+			return err
+		}
+		key, err := readKey(keys)
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		quit, err := ex.handle(key)
+		if err != nil || quit {
+			return err
+		}
+	}
+}
+
+// handle applies a key, returning true when it quits.
+func (ex *explorer) handle(key string) (bool, error) {
+	if key == keyInterrupt {
+		return true, nil
+	}
+	if ex.prompt != "" {
+		return false, ex.edit(key)
+	}
+	ex.status = ""
+	switch key {
+	case "q":
+		return true, nil
+	case "n", keyEnter:
+		return false, ex.seek(ex.current+1, 1)
+	case "p":
+		return false, ex.seek(ex.current-1, -1)
+	case keyHome:
+		return false, ex.seek(0, 1)
+	case keyEnd:
+		return false, ex.seek(len(ex.index)-1, -1)
+	case "g":
+		ex.prompt = promptRecord
+	case "t":
+		ex.prompt, ex.input = promptType, ex.filter
+	case "j", keyDown:
+		ex.scroll[ex.focus]++
+	case "k", keyUp:
+		ex.scroll[ex.focus]--
+	case " ", keyPageDown:
+		ex.scroll[ex.focus] += max(ex.heights[ex.focus]-1, 1)
+	case "b", keyPageUp:
+		ex.scroll[ex.focus] -= max(ex.heights[ex.focus]-1, 1)
+	case keyRight:
+		ex.column += 10
+	case keyLeft:
+		ex.column = max(ex.column-10, 0)
+	case keyTab:
+		if ex.hex {
+			ex.focus = 1 - ex.focus
+		}
+	case "x":
+		ex.hex = !ex.hex
+		ex.focus = paneFields
+	case "?":
+		ex.help = !ex.help
+	default:
+		if len(key) == 1 && key[0] >= '0' && key[0] <= '9' {
+			ex.prompt, ex.input = promptRecord, key
+		}
+	}
+	return false, nil
+}
+
+// edit applies a key to the line read by the prompt, acting on it on enter.
+func (ex *explorer) edit(key string) error {
+	switch key {
+	case keyEscape:
+		ex.prompt, ex.input = "", ""
+	case keyBackspace:
+		if ex.input != "" {
+			ex.input = ex.input[:len(ex.input)-1]
+		}
+	case keyEnter:
+		prompt, input := ex.prompt, strings.TrimSpace(ex.input)
+		ex.prompt, ex.input = "", ""
+		if prompt == promptRecord {
+			ex.jump(input)
+			return nil
+		}
+		ex.filter = input
+		return ex.seek(ex.current, 1)
+	default:
+		if len(key) == 1 {
+			ex.input += key
+		}
+	}
+	return nil
+}
+
+// seek moves to the first record from idx on in the direction which matches
+// the filter, staying on the current record when none does.
+func (ex *explorer) seek(idx int, direction int) error {
+	for ; idx >= 0 && idx < len(ex.index); idx += direction {
+		match, err := ex.matches(idx)
+		if err != nil {
+			return err
+		}
+		if match {
+			ex.moveTo(idx)
+			return nil
+		}
+	}
+	switch {
+	case ex.filter != "":
+		ex.status = fmt.Sprintf("no more %s records", ex.filter)
+	case direction < 0:
+		ex.status = "at the first record"
+	default:
+		ex.status = "at the last record"
+	}
+	return nil
+}
+
+func (ex *explorer) jump(arg string) {
+	record, err := strconv.Atoi(arg)
+	if err != nil || record < 1 || record > len(ex.index) {
+		ex.status = fmt.Sprintf("record must be 1 to %d", len(ex.index))
+		return
+	}
+	ex.moveTo(record - 1)
+}
+
+func (ex *explorer) moveTo(idx int) {
+	if idx != ex.current {
+		ex.current = idx
+		ex.scroll = [2]int{}
+	}
+}
+
+// matches reports whether the record's type matches the filter, by full name
+// or by message name.
+func (ex *explorer) matches(idx int) (bool, error) {
+	if ex.filter == "" {
+		return true, nil
+	}
+	data, err := ex.record(idx)
+	if err != nil {
+		return false, err
+	}
+	desc, err := ex.selectType(data)
+	if err != nil {
+		// Records without a type match no filter, show reports the error.
+		return false, nil
+	}
+	return string(desc.FullName()) == ex.filter || string(desc.Name()) == ex.filter, nil
+}
+
+func (ex *explorer) record(idx int) ([]byte, error) {
+	span := ex.index[idx]
+	data := make([]byte, span.length)
+	if _, err := ex.file.ReadAt(data, span.offset); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// render draws the screen for the current record: a header line, the hex
+// pane when shown, the fields pane, and a status line.
+func (ex *explorer) render(out io.Writer) error {
+	width, height := ex.size()
+	data, err := ex.record(ex.current)
+	if err != nil {
+		return err
+	}
+	title, base, fields, err := ex.decode(data)
+	if err != nil {
+		return err
+	}
+	if ex.help {
+		fields = strings.Split(strings.TrimSuffix(exploreHelp, "\n"), "\n")
+	}
+
+	header := fmt.Sprintf("record %d of %d  %s", ex.current+1, len(ex.index), title)
+	if ex.filter != "" {
+		header += "  (type " + ex.filter + ")"
+	}
+	lines := []string{fit(header, 0, width)}
+
+	// The header, status line and pane titles take a line each, and the hex
+	// pane gets up to half of the rest.
+	body := max(height-3, 2)
+	ex.heights = [2]int{0, body}
+	if ex.hex {
+		body--
+		hexLines := strings.Split(strings.TrimSuffix(hexDump(data, base), "\n"), "\n")
+		ex.heights = [2]int{min(len(hexLines), max(body/2, 1)), 0}
+		ex.heights[paneFields] = body - ex.heights[paneHex]
+		lines = append(lines, ex.pane(paneHex, "hex", hexLines, 0, width)...)
+	}
+	lines = append(lines, ex.pane(paneFields, "fields", fields, ex.column, width)...)
+
+	switch {
+	case ex.prompt != "":
+		lines = append(lines, ex.prompt+ex.input)
+	case ex.status != "":
+		lines = append(lines, fit(ex.status, 0, width))
+	default:
+		lines = append(lines, fit("n/p next/previous  g go to  t type  x hex  ? help  q quit", 0, width))
+	}
+
+	_, err = io.WriteString(out, clearScreen+strings.Join(lines, "\r\n"))
+	return err
+}
+
+// pane returns the title line and the visible lines of a pane, clamping its
+// scroll so that the last page is full.
+func (ex *explorer) pane(p pane, name string, content []string, column int, width int) []string {
+	height := ex.heights[p]
+	ex.scroll[p] = max(min(ex.scroll[p], len(content)-height), 0)
+	first := ex.scroll[p]
+	last := min(first+height, len(content))
+
+	marker := " "
+	if p == ex.focus {
+		marker = "*"
+	}
+	title := fmt.Sprintf("%s %s, lines %d-%d of %d", marker, name, first+1, last, len(content))
+	lines := []string{inverse + fit(title, 0, width) + resetStyle}
+	for idx := first; idx < first+height; idx++ {
+		line := ""
+		if idx < last {
+			line = fit(content[idx], column, width)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// fit returns the columns of line from column, cut to the width.
+func fit(line string, column int, width int) string {
+	runes := []rune(line)
+	if column >= len(runes) {
+		return ""
+	}
+	runes = runes[column:]
+	return string(runes[:min(len(runes), width)])
+}
+
+// decode returns the type of the record, the base of its offsets and the lines
+// of the fields pane. Records without a type show why in the fields pane.
+func (ex *explorer) decode(data []byte) (string, int, []string, error) {
+	desc, err := ex.selectType(data)
+	if err != nil {
+		return "unknown type", 0, []string{err.Error()}, nil
+	}
+	parser, ok := ex.parsers[desc.FullName()]
+	if !ok {
+		parser, err = binfile.Compile(desc)
+		if err != nil {
+			return "", 0, nil, err
+		}
+		ex.parsers[desc.FullName()] = parser
+	}
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	base := 0
+	if ext.GetOneBased() {
+		base = 1
+	}
+
+	out := &strings.Builder{}
+	inspectRecord(out, parser, base, ex.current+1, data)
+	return string(desc.FullName()), base, strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n"), nil
+}
+
+// Keys which are not a single character are read as these names.
+const (
+	keyEnter     = "<enter>"
+	keyEscape    = "<esc>"
+	keyBackspace = "<backspace>"
+	keyTab       = "<tab>"
+	keyInterrupt = "<interrupt>"
+	keyUp        = "<up>"
+	keyDown      = "<down>"
+	keyLeft      = "<left>"
+	keyRight     = "<right>"
+	keyHome      = "<home>"
+	keyEnd       = "<end>"
+	keyPageUp    = "<pgup>"
+	keyPageDown  = "<pgdn>"
+)
+
+var escapeKeys = map[string]string{
+	"A": keyUp, "B": keyDown, "C": keyRight, "D": keyLeft,
+	"H": keyHome, "1~": keyHome, "7~": keyHome,
+	"F": keyEnd, "4~": keyEnd, "8~": keyEnd,
+	"5~": keyPageUp, "6~": keyPageDown,
+}
+
+// readKey reads one key press from a terminal in raw mode. Keys which the
+// explorer doesn't use are returned as "".
+func readKey(in *bufio.Reader) (string, error) {
+	b, err := in.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case b == '\r' || b == '\n':
+		return keyEnter, nil
+	case b == '\t':
+		return keyTab, nil
+	case b == 0x7f || b == 0x08:
+		return keyBackspace, nil
+	case b == 0x03 || b == 0x04:
+		return keyInterrupt, nil
+	case b == 0x1b:
+		// The sequence of a special key arrives in one read, where escape
+		// pressed alone arrives by itself.
+		if in.Buffered() == 0 {
+			return keyEscape, nil
+		}
+		if next, _ := in.Peek(1); next[0] != '[' && next[0] != 'O' {
+			return keyEscape, nil
+		}
+		in.ReadByte() //nolint:errcheck // peeked above
+		seq := []byte{}
+		for {
+			b, err := in.ReadByte()
+			if err != nil {
+				return "", err
+			}
+			seq = append(seq, b)
+			if b >= 0x40 && b <= 0x7e {
+				return escapeKeys[string(seq)], nil
+			}
+		}
+	case b >= ' ' && b <= '~':
+		return string(b), nil
+	default:
+		return "", nil
+	}
+}
+
+// hexDump formats data as rows of 16 bytes labelled with their offsets.
+func hexDump(data []byte, base int) string {
+	out := &strings.Builder{}
+	for start := 0; start < len(data); start += 16 {
+		row := data[start:min(start+16, len(data))]
+		fmt.Fprintf(out, "%6d  % -47x  |%s|\n", start+base, row, printable(row))
+	}
+	return out.String()
+}
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
+	"github.com/pentops/j5/lib/j5codec"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// fixtureAttempts is the number of random records tried for each fixture
+// before giving up, for schemas with rules or patterns which random values
+// rarely meet.
+const fixtureAttempts = 100
+
+func genFixtureCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var count int
+	var seeds, output string
+	var randSeed uint64
+	var crlf bool
+
+	cmd := &cobra.Command{
+		Use:   "gen-fixture [flags]",
+		Short: "Generate sample records which parse under the annotations",
+		Long: "Writes records with random values fitting each field's width, type and format. Each record\n" +
+			"is parsed back before it is written, so the fixtures pass the same checks as real files.\n\n" +
+			"With --seeds, one record is written for each line of the JSON Lines file, keeping the fields\n" +
+			"the line sets and filling the rest at random. Fields which a seed sets to zero or empty are\n" +
+			"filled too, as proto3 cannot tell them from unset.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msgDesc, err := schema.messageDescriptor(cmd.Context())
+			if err != nil {
+				return err
+			}
+			parser, err := binfile.Compile(msgDesc)
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("rand-seed") {
+				randSeed = uint64(time.Now().UnixNano())
+			}
+			gen := &fixtureGenerator{
+				rand:   rand.New(rand.NewPCG(randSeed, randSeed)),
+				parser: parser,
+			}
+
+			seedLines := make([][]byte, count)
+			if seeds != "" {
+				seedLines, err = readLines(seeds)
+				if err != nil {
+					return err
+				}
+			}
+
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+			lineEnding := "\n"
+			if crlf {
+				lineEnding = "\r\n"
+			}
+
+			buf := bufio.NewWriter(out)
+			for idx, seed := range seedLines {
+				record, err := gen.record(seed)
+				if err != nil {
+					return fmt.Errorf("record %d: %w", idx+1, err)
+				}
+				buf.Write(record)           //nolint:errcheck // checked by Flush
+				buf.WriteString(lineEnding) //nolint:errcheck // checked by Flush
+			}
+			if err := buf.Flush(); err != nil {
+				return err
+			}
+			return closeOut()
+		},
+	}
+	schema.register(cmd)
+	flags := cmd.Flags()
+	flags.IntVarP(&count, "count", "n", 10, "the number of records to generate without --seeds")
+	flags.StringVar(&seeds, "seeds", "", "JSON Lines of field values to build the records from")
+	flags.Uint64Var(&randSeed, "rand-seed", 0, "seed the random values, to generate the same records each run")
+	flags.StringVarP(&output, "output", "o", "", "write the records to this file rather than stdout")
+	flags.BoolVar(&crlf, "crlf", false, `end records with "\r\n" rather than "\n"`)
+	return cmd
+}
+
+// readLines returns the non blank lines of the file.
+func readLines(path string) ([][]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var lines [][]byte
+	for line := range bytes.Lines(data) {
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+type fixtureGenerator struct {
+	rand   *rand.Rand
+	parser *binfile.MessageParser
+}
+
+// record builds a record from the seed, or from nothing when the seed is
+// empty, retrying until one parses.
+func (gen *fixtureGenerator) record(seed []byte) ([]byte, error) {
+	desc := gen.parser.Descriptor()
+	var lastErr error
+	for range fixtureAttempts {
+		msg := dynamicpb.NewMessage(desc)
+		if len(seed) > 0 {
+			if err := j5codec.Global.JSONToProto(seed, msg); err != nil {
+				return nil, fmt.Errorf("seed: %w", err)
+			}
+		}
+		gen.fill(msg)
+
+		record, err := gen.parser.AppendRecord(nil, msg)
+		if err == nil {
+			err = gen.parser.Parse(dynamicpb.NewMessage(desc), record)
+		}
+		if err == nil {
+			return record, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("no valid record in %d attempts, set the failing field with --seeds: %w", fixtureAttempts, lastErr)
+}
+
+// fill sets each unset fixed width field to a random value.
+func (gen *fixtureGenerator) fill(msg protoreflect.Message) {
+	fields := msg.Descriptor().Fields()
+	for i := range fields.Len() {
+		fieldDesc := fields.Get(i)
+		tc, _ := proto.GetExtension(fieldDesc.Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+		if tc.GetFixedWidth() == nil || tc.Checksum != nil || msg.Has(fieldDesc) {
+			continue
+		}
+		if val, ok := gen.value(msg, fieldDesc, tc); ok {
+			msg.Set(fieldDesc, val)
+		}
+	}
+}
+
+func (gen *fixtureGenerator) value(msg protoreflect.Message, fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) (protoreflect.Value, bool) {
+	length := int(tc.FixedWidth.Length)
+
+	switch fieldDesc.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(gen.string(fieldDesc, tc)), true
+
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(gen.rand.IntN(2) == 1), true
+
+	case protoreflect.EnumKind:
+		return gen.enum(fieldDesc.Enum(), length)
+
+	case protoreflect.Int32Kind:
+		return protoreflect.ValueOfInt32(int32(gen.number(tc, 9))), true
+	case protoreflect.Int64Kind:
+		return protoreflect.ValueOfInt64(int64(gen.number(tc, 18))), true
+	case protoreflect.Uint32Kind:
+		return protoreflect.ValueOfUint32(uint32(gen.number(tc, 9))), true
+	case protoreflect.Uint64Kind:
+		return protoreflect.ValueOfUint64(gen.number(tc, 19)), true
+
+	case protoreflect.MessageKind:
+		val := msg.NewField(fieldDesc)
+		inner := val.Message()
+		innerFields := inner.Descriptor().Fields()
+		switch inner.Descriptor().FullName() {
+		case "google.protobuf.StringValue":
+			inner.Set(innerFields.ByName("value"), protoreflect.ValueOfString(gen.string(fieldDesc, tc)))
+		case "google.protobuf.BoolValue":
+			inner.Set(innerFields.ByName("value"), protoreflect.ValueOfBool(gen.rand.IntN(2) == 1))
+		case "j5.types.decimal.v1.Decimal":
+			// The digits hold the implied decimal places of a fixed scale.
+			value := decimal.NewFromUint64(gen.number(tc, 18)).Shift(-tc.GetNumber().GetFixedScale())
+			inner.Set(innerFields.ByName("value"), protoreflect.ValueOfString(value.String()))
+		case "j5.types.date.v1.Date":
+			date := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, gen.rand.IntN(365*30))
+			inner.Set(innerFields.ByName("year"), protoreflect.ValueOfInt32(int32(date.Year())))
+			inner.Set(innerFields.ByName("month"), protoreflect.ValueOfInt32(int32(date.Month())))
+			inner.Set(innerFields.ByName("day"), protoreflect.ValueOfInt32(int32(date.Day())))
+		default:
+			return protoreflect.Value{}, false
+		}
+		return val, true
+	}
+	return protoreflect.Value{}, false
+}
+
+const (
+	fixtureDigits       = "0123456789"
+	fixtureLetters      = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	fixtureAlphanumeric = fixtureLetters + fixtureDigits
+)
+
+func (gen *fixtureGenerator) string(fieldDesc protoreflect.FieldDescriptor, tc *flatfile_pb.Field) string {
+	length := int(tc.FixedWidth.Length)
+	stringField := tc.GetString_()
+
+	if j5Field, ok := proto.GetExtension(fieldDesc.Options(), ext_j5pb.E_Field).(*ext_j5pb.FieldOptions); ok && j5Field.GetKey().GetFormat() == ext_j5pb.KeyField_FORMAT_UUID {
+		id := uuid.UUID{}
+		for idx := range id {
+			id[idx] = byte(gen.rand.IntN(256))
+		}
+		if length < 36 {
+			return strings.ReplaceAll(id.String(), "-", "")
+		}
+		return id.String()
+	}
+
+	if keys := stringField.GetMapping(); len(keys) > 0 {
+		options := make([]string, 0, len(keys))
+		for key := range keys {
+			if len(key) <= length {
+				options = append(options, key)
+			}
+		}
+		if len(options) > 0 {
+			// Map order is random, so sort for repeatable fixtures.
+			slices.Sort(options)
+			return options[gen.rand.IntN(len(options))]
+		}
+	}
+
+	if tc.CheckDigit != flatfile_pb.CheckDigit_CHECK_DIGIT_UNSPECIFIED {
+		return gen.withCheckDigit(tc.CheckDigit, length)
+	}
+
+	chars := fixtureAlphanumeric
+	switch stringField.GetCharsetClass() {
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_NUMERIC:
+		chars = fixtureDigits
+	case flatfile_pb.CharsetClass_CHARSET_CLASS_ALPHA:
+		chars = fixtureLetters
+	}
+
+	shortest := max(int(stringField.GetMinLength()), 1)
+	longest := length
+	if maxLength := int(stringField.GetMaxLength()); maxLength > 0 {
+		longest = min(longest, maxLength)
+	}
+	size := longest
+	if longest > shortest {
+		size = shortest + gen.rand.IntN(longest-shortest+1)
+	}
+
+	out := make([]byte, size)
+	for idx := range out {
+		out[idx] = chars[gen.rand.IntN(len(chars))]
+	}
+	return string(out)
+}
+
+// withCheckDigit returns random digits filling the width, ending in a valid
+// check digit.
+func (gen *fixtureGenerator) withCheckDigit(alg flatfile_pb.CheckDigit, length int) string {
+	body := make([]byte, max(length-1, 1))
+	for idx := range body {
+		body[idx] = fixtureDigits[gen.rand.IntN(10)]
+	}
+	for _, check := range fixtureDigits + "X" {
+		value := string(body) + string(check)
+		if valid, _ := binfile.ValidCheckDigit(alg, value); valid {
+			return value
+		}
+	}
+	return string(body) + "0"
+}
+
+func (gen *fixtureGenerator) enum(enum protoreflect.EnumDescriptor, length int) (protoreflect.Value, bool) {
+	var options []protoreflect.EnumNumber
+	values := enum.Values()
+	for i := range values.Len() {
+		valueDesc := values.Get(i)
+		tc, _ := proto.GetExtension(valueDesc.Options(), flatfile_pb.E_Enum).(*flatfile_pb.Enum)
+		if tc != nil && len(strings.TrimSpace(tc.Key)) <= length {
+			options = append(options, valueDesc.Number())
+		}
+	}
+	if len(options) == 0 {
+		return protoreflect.Value{}, false
+	}
+	return protoreflect.ValueOfEnum(options[gen.rand.IntN(len(options))]), true
+}
+
+// number returns a non negative number with as many digits as the field
+// holds, up to limit digits for the type.
+func (gen *fixtureGenerator) number(tc *flatfile_pb.Field, limit int) uint64 {
+	length := int(tc.FixedWidth.Length)
+	number := tc.GetNumber()
+
+	digits := length
+	switch number.GetEncoding() {
+	case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL:
+		digits = length*2 - 1
+	case flatfile_pb.Encoding_ENCODING_BINARY:
+		// Bytes rather than digits, kept to values every width can hold.
+		digits = min(length*2, limit)
+	}
+	if maxDigits := int(number.GetMaxDigits()); maxDigits > 0 {
+		digits = min(digits, maxDigits)
+	}
+	digits = max(min(digits, limit), 1)
+
+	bound := uint64(1)
+	for range digits {
+		bound *= 10
+	}
+	return gen.rand.Uint64N(bound)
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/j5/lib/j5codec"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func inspectCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var first, count int
+
+	cmd := &cobra.Command{
+		Use:   "inspect [flags] FILE",
+		Short: "Show records field by field against a ruler",
+		Long: "Prints each selected record under a column ruler, followed by the offset, length, raw bytes\n" +
+			"and parsed value or error of every field. Offsets are shown as the message declares them,\n" +
+			"one based when one_based is set.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if first < 1 || count < 1 {
+				return errors.New("--record and --count must be at least 1")
+			}
+			msgDesc, err := schema.messageDescriptor(cmd.Context())
+			if err != nil {
+				return err
+			}
+			parser, err := binfile.Compile(msgDesc)
+			if err != nil {
+				return err
+			}
+			ext, _ := proto.GetExtension(msgDesc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+			base := 0
+			if ext.GetOneBased() {
+				base = 1
+			}
+
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			scanner := bufio.NewScanner(in)
+			scanner.Buffer(nil, maxLine)
+			out := bufio.NewWriter(cmd.OutOrStdout())
+			record := 0
+			for record < first+count-1 && scanner.Scan() {
+				record++
+				if record < first {
+					continue
+				}
+				if record > first {
+					fmt.Fprintln(out)
+				}
+				inspectRecord(out, parser, base, record, scanner.Bytes())
+			}
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+			if record < first {
+				return fmt.Errorf("file has %d records, record %d not found", record, first)
+			}
+			return out.Flush()
+		},
+	}
+	schema.register(cmd)
+	cmd.Flags().IntVarP(&first, "record", "r", 1, "the one based number of the first record to show")
+	cmd.Flags().IntVarP(&count, "count", "n", 1, "the number of records to show")
+	return cmd
+}
+
+func inspectRecord(out io.Writer, parser *binfile.MessageParser, base int, record int, data []byte) {
+	fmt.Fprintf(out, "record %d, %d bytes\n", record, len(data))
+	tens, units := ruler(len(data), base)
+	fmt.Fprintln(out, tens)
+	fmt.Fprintln(out, units)
+	fmt.Fprintln(out, printable(data))
+
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "FIELD\tOFFSET\tLENGTH\tRAW\tVALUE")
+	for _, field := range parser.Inspect(data) {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\n",
+			field.Field.Name(),
+			field.Offset+base,
+			field.Length,
+			strconv.Quote(string(field.Raw)),
+			formatInspection(field),
+		)
+	}
+	tw.Flush() //nolint:errcheck // out is checked by the caller
+
+	// Parsing the whole record adds the record level checks, such as the
+	// length and rules, which Inspect skips.
+	if _, err := parser.ParseWithWarnings(dynamicpb.NewMessage(parser.Descriptor()), data); err != nil {
+		fmt.Fprintf(out, "record: %s\n", err)
+	} else {
+		fmt.Fprintln(out, "record: ok")
+	}
+}
+
+// ruler returns two lines numbering the columns of a record of the given
+// length, the tens digit above each multiple of ten and the units digit of
+// every column.
+func ruler(length int, base int) (string, string) {
+	tens := make([]byte, length)
+	units := make([]byte, length)
+	for idx := range length {
+		pos := idx + base
+		tens[idx] = ' '
+		if pos%10 == 0 && pos > 0 {
+			tens[idx] = byte('0' + (pos/10)%10)
+		}
+		units[idx] = byte('0' + pos%10)
+	}
+	return strings.TrimRight(string(tens), " "), string(units)
+}
+
+// printable replaces control and non-ASCII bytes with '.', so that the record
+// lines up with the ruler.
+func printable(data []byte) string {
+	out := make([]byte, len(data))
+	for idx, b := range data {
+		if b < ' ' || b > '~' {
+			b = '.'
+		}
+		out[idx] = b
+	}
+	return string(out)
+}
+
+func formatInspection(field binfile.FieldInspection) string {
+	if field.Err != nil {
+		return "error: " + field.Err.Err.Error()
+	}
+	return formatValue(field.Field, field.Value)
+}
+
+// formatValue renders a parsed value for display, quoting strings and naming
+// enum values.
+func formatValue(fieldDesc protoreflect.FieldDescriptor, val protoreflect.Value) string {
+	if !val.IsValid() {
+		return "(unset)"
+	}
+	switch fieldDesc.Kind() {
+	case protoreflect.StringKind:
+		return strconv.Quote(val.String())
+	case protoreflect.EnumKind:
+		number := val.Enum()
+		if value := fieldDesc.Enum().Values().ByNumber(number); value != nil {
+			return string(value.Name())
+		}
+		return strconv.Itoa(int(number))
+	case protoreflect.MessageKind:
+		msg := val.Message()
+		fields := msg.Descriptor().Fields()
+		switch msg.Descriptor().FullName() {
+		case "j5.types.date.v1.Date":
+			return fmt.Sprintf("%04d-%02d-%02d",
+				msg.Get(fields.ByName("year")).Int(),
+				msg.Get(fields.ByName("month")).Int(),
+				msg.Get(fields.ByName("day")).Int())
+		case "j5.types.decimal.v1.Decimal":
+			return msg.Get(fields.ByName("value")).String()
+		case "google.protobuf.StringValue", "google.protobuf.BoolValue":
+			return formatValue(fields.ByName("value"), msg.Get(fields.ByName("value")))
+		}
+		data, err := j5codec.Global.ProtoToJSON(msg)
+		if err != nil {
+			return fmt.Sprintf("%v", msg.Interface())
+		}
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", val.Interface())
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/spf13/cobra"
+)
+
+func jsonSchemaCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "jsonschema [flags]",
+		Short: "Write a JSON Schema of the records parse writes",
+		Long: "Writes a JSON Schema (draft 2020-12) describing the JSON of each record of the message, as\n" +
+			"parse writes it, for consumers which validate what is ingested. Decimals and 64 bit\n" +
+			"integers are strings, dates are YYYY-MM-DD strings, wrapper fields may be null, and only\n" +
+			"fields which every record sets are required.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msgDesc, err := schema.messageDescriptor(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+
+			encoder := json.NewEncoder(out)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(binfile.JSONSchemaFor(msgDesc)); err != nil {
+				return err
+			}
+			return closeOut()
+		},
+	}
+	schema.register(cmd)
+	cmd.Flags().StringVarP(&output, "output", "o", "", "write the schema to this file rather than stdout")
+	return cmd
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func lintCommand() *cobra.Command {
+	schema := &schemaFlags{optionalMessage: true}
+	var allow []string
+
+	cmd := &cobra.Command{
+		Use:   "lint [flags]",
+		Short: "Check the flatfile annotations of a schema",
+		Long: "Checks each message with fixed width fields for overlapping fields, gaps, fields past the\n" +
+			"record length, unsupported type and option combinations and invalid date formats, printing\n" +
+			"one line per problem. Exits non-zero when any problem is found, for use in schema builds.\n\n" +
+			"Without --message, every message of the schema with fixed width fields is checked.\n" +
+			"Layout issues of an --allow kind (overlap, gap, zero-length, offset-base, past-end) are\n" +
+			"not reported, e.g. --allow gap for layouts which leave unused bytes undeclared.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			var messages []protoreflect.MessageDescriptor
+			if schema.message != "" {
+				msgDesc, err := findMessage(files, schema.message)
+				if err != nil {
+					return err
+				}
+				messages = append(messages, msgDesc)
+			} else {
+				messages = layoutMessages(files)
+			}
+
+			problems, err := writeLint(cmd.OutOrStdout(), messages, allow)
+			if err != nil {
+				return err
+			}
+			if problems > 0 {
+				return fmt.Errorf("%d problems in %d messages", problems, len(messages))
+			}
+			return nil
+		},
+	}
+	schema.register(cmd)
+	cmd.Flags().StringSliceVar(&allow, "allow", nil, "layout issue kinds not to report")
+	return cmd
+}
+
+// writeLint prints the problems of each message, returning how many there
+// were.
+func writeLint(out io.Writer, messages []protoreflect.MessageDescriptor, allow []string) (int, error) {
+	problems := 0
+	for _, msgDesc := range messages {
+		var lines []string
+		if err := binfile.ValidateMessageDescriptor(msgDesc); err != nil {
+			lines = append(lines, joinedErrors(err)...)
+		}
+		for _, issue := range binfile.ValidateLayout(msgDesc) {
+			if slices.Contains(allow, lintKind(issue.Kind)) {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("layout %s: %s", lintKind(issue.Kind), issue))
+		}
+
+		for _, line := range lines {
+			if _, err := fmt.Fprintf(out, "%s: %s\n", msgDesc.FullName(), line); err != nil {
+				return 0, err
+			}
+		}
+		problems += len(lines)
+	}
+	return problems, nil
+}
+
+// lintKind is the --allow name of the issue kind.
+func lintKind(kind binfile.LayoutIssueKind) string {
+	return strings.ReplaceAll(kind.String(), " ", "-")
+}
+
+// joinedErrors splits an errors.Join result into one message per error.
+func joinedErrors(err error) []string {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []string{err.Error()}
+	}
+	var lines []string
+	for _, err := range joined.Unwrap() {
+		lines = append(lines, joinedErrors(err)...)
+	}
+	return lines
+}
@@ -0,0 +1,58 @@
+// Command flatfile works with fixed width files described by flatfile
+// annotated protos, for inspecting partner files without writing Go.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	// Interrupts end follow mode cleanly, flushing what was read.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := rootCommand().ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+func rootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "flatfile",
+		Short: "Work with fixed width files described by annotated protos",
+		Long: "Work with fixed width files described by annotated protos.\n\n" +
+			"Input files may be given as - to read stdin, and --output as - to write stdout, so the\n" +
+			"commands compose in pipelines.",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	root.AddCommand(
+		parseCommand(),
+		buildCommand(),
+		inspectCommand(),
+		validateCommand(),
+		docCommand(),
+		genFixtureCommand(),
+		diffCommand(),
+		convertCommand(),
+		copybookCommand(),
+		lintCommand(),
+		exploreCommand(),
+		statsCommand(),
+		maskCommand(),
+		transcodeCommand(),
+		sampleCommand(),
+		checkTotalsCommand(),
+		mappingCommand(),
+		jsonSchemaCommand(),
+	)
+	return root
+}
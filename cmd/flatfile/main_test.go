@@ -0,0 +1,1105 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+const testProto = `
+syntax = "proto3";
+package cli.v1;
+
+import "flatfile/v1/annotations.proto";
+import "j5/types/date/v1/date.proto";
+
+message Record {
+  option (flatfile.v1.message).record_length = 22;
+
+  string name = 1 [(flatfile.v1.field) = {
+	fixed_width: { offset: 0, length: 10 }
+	string: { trim: TRIM_RIGHT }
+  }];
+  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 10, length: 4 }, number: {} }];
+  j5.types.date.v1.Date due = 3 [(flatfile.v1.field) = {
+	fixed_width: { offset: 14, length: 8 }
+	date: { format: "YYYYMMDD" }
+  }];
+}
+
+message Trailer {
+  int32 count = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 4 }, number: {} }];
+}
+`
+
+// writeFiles writes each of files into a temporary directory, returning the
+// directory.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// runCommand runs the CLI with args, returning stdout and stderr.
+func runCommand(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+	return runCommandWith(t.Context(), nil, args...)
+}
+
+// runCommandWith runs the CLI with the context and stdin.
+func runCommandWith(ctx context.Context, stdin io.Reader, args ...string) (string, string, error) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := rootCommand()
+	cmd.SetArgs(args)
+	cmd.SetIn(stdin)
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	err := cmd.ExecuteContext(ctx)
+	return stdout.String(), stderr.String(), err
+}
+
+func TestParse(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt": strings.Join([]string{
+			"WIDGET    001220240131",
+			"GADGET    00X720240201",
+			"SPROCKET  000320240202",
+		}, "\n") + "\n",
+	})
+
+	stdout, stderr, err := runCommand(t, "parse",
+		"-I", dir,
+		"--proto", "cli.proto",
+		"--message", "cli.v1.Record",
+		filepath.Join(dir, "data.txt"),
+	)
+	if err == nil {
+		t.Fatal("expected an error for the rejected record")
+	}
+	if want := "1 of 3 records rejected"; err.Error() != want {
+		t.Errorf("error %q, want %q", err, want)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %s", len(lines), stdout)
+	}
+	for idx, want := range []string{`"name":"WIDGET"`, `"name":"SPROCKET"`} {
+		if !strings.Contains(lines[idx], want) {
+			t.Errorf("line %d %s does not contain %s", idx+1, lines[idx], want)
+		}
+	}
+	if !strings.Contains(lines[0], `"count":12`) || !strings.Contains(lines[0], `"due":"2024-01-31"`) {
+		t.Errorf("unexpected first line %s", lines[0])
+	}
+	if !strings.Contains(stderr, "record 2") {
+		t.Errorf("expected the rejected record on stderr, got %q", stderr)
+	}
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt":  "",
+	})
+
+	for name, args := range map[string][]string{
+		"unknown message": {"--proto", "cli.proto", "--message", "cli.v1.Missing"},
+		"not a message":   {"--proto", "cli.proto", "--message", "cli.v1.Record.name"},
+		"no schema":       {"--message", "cli.v1.Record"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			args := append([]string{"parse", "-I", dir}, args...)
+			args = append(args, filepath.Join(dir, "data.txt"))
+			if _, _, err := runCommand(t, args...); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestParseDescriptorSet(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt":  "WIDGET    001220240131\n",
+	})
+
+	fileSet, err := compileProtos(t.Context(), []string{dir}, []string{"cli.proto"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := proto.Marshal(fileSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	descriptorSet := filepath.Join(dir, "cli.binpb")
+	if err := os.WriteFile(descriptorSet, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, _, err := runCommand(t, "parse",
+		"--descriptor-set", descriptorSet,
+		"--message", "cli.v1.Record",
+		filepath.Join(dir, "data.txt"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(stdout, `"name":"WIDGET"`) {
+		t.Errorf("unexpected output %s", stdout)
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt":  "WIDGET    001220240131\n",
+	})
+
+	// The layouts doc writes read records without the proto source.
+	stdout, _, err := runCommand(t, "doc", "-I", dir, "--proto", "cli.proto", "--format", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The message is renamed as the JSON encoding of messages is cached by
+	// name, and the layout reads count as int64 rather than int32.
+	layout := filepath.Join(dir, "layout.json")
+	stdout = strings.ReplaceAll(stdout, "cli.v1.", "layout.v1.")
+	if err := os.WriteFile(layout, []byte(stdout), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, _, err = runCommand(t, "parse",
+		"--layout", layout,
+		"--message", "layout.v1.Record",
+		filepath.Join(dir, "data.txt"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"name":"WIDGET"`, `"count":"12"`, `"due":"2024-01-31"`} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output %s does not contain %s", stdout, want)
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	records := strings.Join([]string{
+		"WIDGET    001220240131",
+		"SPROCKET  000320240202",
+	}, "\n") + "\n"
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt":  records,
+	})
+	schema := []string{"-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record"}
+
+	parsed, _, err := runCommand(t, append(append([]string{"parse"}, schema...), filepath.Join(dir, "data.txt"))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonFile := filepath.Join(dir, "data.jsonl")
+	if err := os.WriteFile(jsonFile, []byte(parsed+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	built, _, err := runCommand(t, append(append([]string{"build"}, schema...), jsonFile)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if built != records {
+		t.Errorf("round trip got:\n%s\nwant:\n%s", built, records)
+	}
+
+	output := filepath.Join(dir, "out.txt")
+	if _, _, err := runCommand(t, append(append([]string{"build", "--crlf", "-o", output}, schema...), jsonFile)...); err != nil {
+		t.Fatal(err)
+	}
+	written, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := strings.ReplaceAll(records, "\n", "\r\n"); string(written) != want {
+		t.Errorf("crlf output %q, want %q", written, want)
+	}
+
+	badFile := filepath.Join(dir, "bad.jsonl")
+	if err := os.WriteFile(badFile, []byte(`{"name":"WIDGET"}`+"\n"+`{"name":"FAR TOO LONG A NAME"}`+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = runCommand(t, append(append([]string{"build"}, schema...), badFile)...)
+	if err == nil || !strings.HasPrefix(err.Error(), "line 2:") {
+		t.Errorf("expected an error on line 2, got %v", err)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt": strings.Join([]string{
+			"WIDGET    001220240131",
+			"GADGET    00X720240201",
+		}, "\n") + "\n",
+	})
+	schema := []string{"-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record"}
+
+	stdout, _, err := runCommand(t, append(append([]string{"inspect", "--record", "2"}, schema...), filepath.Join(dir, "data.txt"))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(stdout, "\n")
+	if len(lines) < 4 {
+		t.Fatalf("unexpected output:\n%s", stdout)
+	}
+	for idx, want := range []string{
+		"record 2, 22 bytes",
+		"          1         2",
+		"0123456789012345678901",
+		"GADGET    00X720240201",
+	} {
+		if lines[idx] != want {
+			t.Errorf("line %d %q, want %q", idx+1, lines[idx], want)
+		}
+	}
+	for _, want := range []string{
+		`name   0       10      "GADGET    "  "GADGET"`,
+		`count  10      4       "00X7"        error: invalid number`,
+		"record: error reading field cli.v1.Record.count",
+	} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output does not contain %q:\n%s", want, stdout)
+		}
+	}
+
+	if _, _, err := runCommand(t, append(append([]string{"inspect", "--record", "3"}, schema...), filepath.Join(dir, "data.txt"))...); err == nil {
+		t.Error("expected an error for a record past the end of the file")
+	}
+}
+
+func TestRuler(t *testing.T) {
+	tens, units := ruler(12, 1)
+	if tens != "         1" {
+		t.Errorf("tens %q", tens)
+	}
+	if units != "123456789012" {
+		t.Errorf("units %q", units)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"good.txt": strings.Join([]string{
+			"WIDGET    001220240131",
+			"SPROCKET  000320240202",
+			"T0002",
+		}, "\n") + "\n",
+		"bad.txt": strings.Join([]string{
+			"WIDGET    001220240131",
+			"GADGET    00X720240201",
+			"T0003",
+		}, "\n") + "\n",
+	})
+	validate := func(file string, extra ...string) (string, error) {
+		args := []string{"validate",
+			"-I", dir,
+			"--proto", "cli.proto",
+			"--message", "cli.v1.Record",
+			"--type", "T=cli.v1.Trailer",
+			"--trailer-count", "count",
+		}
+		args = append(append(args, extra...), filepath.Join(dir, file))
+		stdout, _, err := runCommand(t, args...)
+		return stdout, err
+	}
+
+	stdout, err := validate("good.txt")
+	if err != nil {
+		t.Fatalf("expected the good file to pass, got %v:\n%s", err, stdout)
+	}
+	for _, want := range []string{"records:  3", "rejected: 0", "cli.v1.Trailer: 1", "trailer: ok"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output does not contain %q:\n%s", want, stdout)
+		}
+	}
+
+	stdout, err = validate("bad.txt")
+	if err == nil || err.Error() != "1 of 3 records rejected" {
+		t.Errorf("expected the rejected record to fail, got %v", err)
+	}
+	for _, want := range []string{
+		"cli.v1.Record.count: 1",
+		`record 2 "GADGET    00X720240201": invalid number`,
+		"invalid number: 1",
+	} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output does not contain %q:\n%s", want, stdout)
+		}
+	}
+
+	if _, err := validate("bad.txt", "--allow-rejected", "1"); err == nil || !strings.HasPrefix(err.Error(), "trailer does not reconcile") {
+		t.Errorf("expected the trailer count to fail, got %v", err)
+	}
+}
+
+func TestDoc(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"doc.proto": `
+syntax = "proto3";
+package docs.v1;
+
+import "flatfile/v1/annotations.proto";
+
+// A payment detail record.
+message Detail {
+  option (flatfile.v1.message) = { record_length: 18, one_based: true, filler: [{ offset: 15, length: 4 }] };
+
+  // Always D.
+  Type type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 1 } }];
+  string account = 2 [(flatfile.v1.field) = { fixed_width: { offset: 2, length: 9 }, required: true, example: "021000021" }]; // Routing | transit
+  string name = 3 [(flatfile.v1.field) = { fixed_width: { offset: 11, length: 4 }, description: "Shown | as written" }]; // Not this comment
+}
+
+enum Type {
+  TYPE_UNSPECIFIED = 0;
+  TYPE_DETAIL = 1 [(flatfile.v1.enum).key = "D"];
+}
+`,
+	})
+
+	stdout, _, err := runCommand(t, "doc", "-I", dir, "--proto", "doc.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `## docs.v1.Detail
+
+A payment detail record.
+
+Records are 18 bytes. Positions start at 1.
+
+| Position | Length | Field | Type | Format | Required | Description | Example |
+|---|---|---|---|---|---|---|---|
+| 1-1 | 1 | type | enum | "D" = TYPE_DETAIL |  | Always D. |  |
+| 2-10 | 9 | account | string |  | yes | Routing \| transit | ` + "`021000021`" + ` |
+| 11-14 | 4 | name | string |  |  | Shown \| as written |  |
+| 15-18 | 4 | (filler) |  | spaces |  |  |  |
+`
+	if stdout != want {
+		t.Errorf("got:\n%s\nwant:\n%s", stdout, want)
+	}
+
+	// Without --message, every message with fixed width fields is included.
+	stdout, _, err = runCommand(t, "doc", "-I", dir, "--proto", "cli.proto", "--format", "html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<h2>cli.v1.Record</h2>", "<h2>cli.v1.Trailer</h2>", "<td>YYYYMMDD</td>"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output does not contain %q:\n%s", want, stdout)
+		}
+	}
+
+	stdout, _, err = runCommand(t, "doc", "-I", dir, "--proto", "doc.proto", "--format", "html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<th>Example</th>", "<td><code>021000021</code></td>", "<td>Shown | as written</td><td></td>"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output does not contain %q:\n%s", want, stdout)
+		}
+	}
+
+	stdout, _, err = runCommand(t, "doc", "-I", dir, "--proto", "doc.proto", "--format", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var layouts []binfile.MessageLayout
+	if err := json.Unmarshal([]byte(stdout), &layouts); err != nil {
+		t.Fatal(err)
+	}
+	if len(layouts) != 1 || len(layouts[0].Fields) != 4 {
+		t.Fatalf("got layouts %+v", layouts)
+	}
+	if key := layouts[0].Fields[0].EnumKeys; len(key) != 1 || key[0].Key != "D" {
+		t.Errorf("got enum keys %+v", key)
+	}
+	if account := layouts[0].Fields[1]; account.Name != "account" || !account.Required || account.Offset != 2 || account.Example != "021000021" {
+		t.Errorf("got account %+v", account)
+	}
+}
+
+func TestGenFixture(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto":   testProto,
+		"seeds.jsonl": `{"name":"SEEDED"}` + "\n\n" + `{"count":42}` + "\n",
+	})
+	schema := []string{"-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record"}
+
+	generate := func(extra ...string) string {
+		t.Helper()
+		stdout, _, err := runCommand(t, append(append([]string{"gen-fixture"}, schema...), extra...)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stdout
+	}
+
+	fixtures := generate("--count", "20", "--rand-seed", "7")
+	if again := generate("--count", "20", "--rand-seed", "7"); again != fixtures {
+		t.Errorf("expected the same seed to give the same records")
+	}
+
+	records := filepath.Join(dir, "fixtures.txt")
+	if err := os.WriteFile(records, []byte(fixtures), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	parsed, _, err := runCommand(t, append(append([]string{"parse"}, schema...), records)...)
+	if err != nil {
+		t.Fatalf("generated records do not parse: %v\n%s", err, fixtures)
+	}
+	if lines := strings.Count(parsed, "\n"); lines != 20 {
+		t.Errorf("expected 20 records, got %d", lines)
+	}
+
+	seeded := generate("--seeds", filepath.Join(dir, "seeds.jsonl"))
+	lines := strings.Split(strings.TrimSuffix(seeded, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected a record per seed, got:\n%s", seeded)
+	}
+	if !strings.HasPrefix(lines[0], "SEEDED    ") {
+		t.Errorf("expected the seeded name, got %q", lines[0])
+	}
+	if lines[1][10:14] != "0042" {
+		t.Errorf("expected the seeded count, got %q", lines[1])
+	}
+}
+
+func TestDiff(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"old.txt": strings.Join([]string{
+			"WIDGET    001220240131",
+			"GADGET    000720240201",
+			"GIZMO     000120240201",
+		}, "\n") + "\n",
+		"new.txt": strings.Join([]string{
+			"GADGET    000920240202",
+			"WIDGET      1220240131",
+			"SPROCKET  000320240202",
+			"BROKEN    00X120240202",
+		}, "\n") + "\n",
+	})
+	schema := []string{"-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record"}
+	files := []string{filepath.Join(dir, "old.txt"), filepath.Join(dir, "new.txt")}
+
+	stdout, _, err := runCommand(t, append(append([]string{"diff", "--key", "name"}, schema...), files...)...)
+	if err == nil || err.Error() != "4 differences" {
+		t.Errorf("expected 4 differences, got %v", err)
+	}
+	want := strings.Join([]string{
+		"! " + files[1] + " record 4: error reading field cli.v1.Record.count (offset 10, length 4): invalid number: parsing \"X1\" as int: strconv.ParseInt: parsing \"X1\": invalid syntax",
+		`~ "GADGET" (records 2, 1)`,
+		`    count: 7 -> 9`,
+		`    due: 2024-02-01 -> 2024-02-02`,
+		`- "GIZMO" (record 3)`,
+		`+ "SPROCKET" (record 3)`,
+		"2 matched, 1 changed, 1 removed, 1 added, 1 unreadable",
+	}, "\n") + "\n"
+	if stdout != want {
+		t.Errorf("got:\n%s\nwant:\n%s", stdout, want)
+	}
+
+	stdout, _, _ = runCommand(t, append(append([]string{"diff", "--key", "name", "--ignore", "due"}, schema...), files...)...)
+	if strings.Contains(stdout, "due:") {
+		t.Errorf("expected due to be ignored:\n%s", stdout)
+	}
+
+	// Without keys, records are matched by position.
+	stdout, _, _ = runCommand(t, append(append([]string{"diff"}, schema...), files...)...)
+	if !strings.Contains(stdout, `    name: "WIDGET" -> "GADGET"`) || !strings.Contains(stdout, "~ record 3 (records 3, 3)") {
+		t.Errorf("unexpected positional diff:\n%s", stdout)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt": strings.Join([]string{
+			"WIDGET, 2 001220240131",
+			"GADGET    00X720240201",
+			"GIZMO     000120240201",
+		}, "\n") + "\n",
+	})
+	schema := []string{"-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record"}
+
+	stdout, stderr, err := runCommand(t, append(append([]string{"convert"}, schema...), filepath.Join(dir, "data.txt"))...)
+	if err == nil || err.Error() != "1 of 3 records rejected" {
+		t.Errorf("expected the rejected record to fail the command, got %v", err)
+	}
+	if !strings.Contains(stderr, "record 2") {
+		t.Errorf("expected the rejected record on stderr, got %q", stderr)
+	}
+	want := strings.Join([]string{
+		"name,count,due",
+		`"WIDGET, 2",12,2024-01-31`,
+		"GIZMO,1,2024-02-01",
+	}, "\n") + "\n"
+	if stdout != want {
+		t.Errorf("got:\n%s\nwant:\n%s", stdout, want)
+	}
+
+	stdout, _, _ = runCommand(t, append(append([]string{"convert", "--format", "tsv", "--no-header"}, schema...), filepath.Join(dir, "data.txt"))...)
+	if !strings.HasPrefix(stdout, "WIDGET, 2\t12\t2024-01-31\n") {
+		t.Errorf("unexpected tsv:\n%s", stdout)
+	}
+
+	// The arrow format is an IPC stream, ending with the end of stream marker.
+	stdout, _, _ = runCommand(t, append(append([]string{"convert", "--format", "arrow"}, schema...), filepath.Join(dir, "data.txt"))...)
+	if !strings.HasPrefix(stdout, "\xff\xff\xff\xff") || !strings.HasSuffix(stdout, "\xff\xff\xff\xff\x00\x00\x00\x00") {
+		t.Errorf("unexpected arrow stream % x", stdout)
+	}
+
+	stdout, _, _ = runCommand(t, append(append([]string{"convert", "--format", "parquet"}, schema...), filepath.Join(dir, "data.txt"))...)
+	if !strings.HasPrefix(stdout, "PAR1") || !strings.HasSuffix(stdout, "PAR1") {
+		t.Errorf("unexpected parquet file % x", stdout)
+	}
+}
+
+func TestStdio(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"cli.proto": testProto})
+	schema := []string{"-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record"}
+
+	records := "WIDGET    001220240131\n"
+	parsed, _, err := runCommandWith(t.Context(), strings.NewReader(records), append(append([]string{"parse"}, schema...), "-")...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	built, _, err := runCommandWith(t.Context(), strings.NewReader(parsed), append(append([]string{"build", "-o", "-"}, schema...), "-")...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if built != records {
+		t.Errorf("pipeline got %q, want %q", built, records)
+	}
+
+	if _, _, err := runCommandWith(t.Context(), strings.NewReader(records), append(append([]string{"diff"}, schema...), "-", "-")...); err == nil {
+		t.Error("expected an error diffing stdin against itself")
+	}
+}
+
+func TestParseFollow(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt":  "WIDGET    001220240131\n",
+	})
+	path := filepath.Join(dir, "data.txt")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan string)
+	go func() {
+		stdout, _, err := runCommandWith(ctx, nil, "parse", "--follow", "-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record", path)
+		if err != nil {
+			t.Error(err)
+		}
+		done <- stdout
+	}()
+
+	// The second record is written in two parts, and is only parsed once
+	// its line ending arrives.
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	for _, part := range []string{"SPROCKET  0003", "20240202\n"} {
+		time.Sleep(2 * followPoll)
+		if _, err := file.WriteString(part); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(2 * followPoll)
+	cancel()
+
+	stdout := <-done
+	if lines := strings.Split(strings.TrimSpace(stdout), "\n"); len(lines) != 2 || !strings.Contains(lines[1], `"name":"SPROCKET"`) {
+		t.Errorf("expected both records, got:\n%s", stdout)
+	}
+}
+
+func TestLint(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"lint.proto": `
+syntax = "proto3";
+package lint.v1;
+
+import "flatfile/v1/annotations.proto";
+import "j5/types/date/v1/date.proto";
+
+message Detail {
+  option (flatfile.v1.message).record_length = 20;
+
+  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 10 } }];
+  bool active = 2 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 1 }, number: {} }];
+  j5.types.date.v1.Date due = 3 [(flatfile.v1.field) = {
+	fixed_width: { offset: 12, length: 8 }
+	date: { format: "MMDD" }
+  }];
+}
+`,
+	})
+
+	stdout, _, err := runCommand(t, "lint", "-I", dir, "--proto", "cli.proto", "--proto", "lint.proto")
+	if err == nil || err.Error() != "5 problems in 3 messages" {
+		t.Errorf("got error %v", err)
+	}
+	want := `cli.v1.Trailer: layout offset-base: cli.v1.Trailer.count offset 1 doesn't fit the one_based setting
+lint.v1.Detail: field lint.v1.Detail.active: number options on bool
+lint.v1.Detail: field lint.v1.Detail.due: date format "MMDD" needs YYYY or YY, MM and DD
+lint.v1.Detail: layout overlap: lint.v1.Detail.name overlaps lint.v1.Detail.active for 1 bytes at offset 8
+lint.v1.Detail: layout gap: 2 unmapped bytes at offset 10
+`
+	if stdout != want {
+		t.Errorf("got:\n%s\nwant:\n%s", stdout, want)
+	}
+
+	stdout, _, err = runCommand(t, "lint", "-I", dir, "--proto", "lint.proto", "--allow", "gap,overlap", "-m", "lint.v1.Detail")
+	if err == nil || err.Error() != "2 problems in 1 messages" {
+		t.Errorf("got error %v", err)
+	}
+	if strings.Contains(stdout, "layout") {
+		t.Errorf("allowed issues reported:\n%s", stdout)
+	}
+
+	if _, _, err := runCommand(t, "lint", "-I", dir, "--proto", "cli.proto", "-m", "cli.v1.Record"); err != nil {
+		t.Errorf("clean message: %s", err)
+	}
+}
+
+func TestExplore(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt": strings.Join([]string{
+			"WIDGET    001220240131",
+			"T0002",
+			"GADGET    00X720240201",
+			"T0001",
+		}, "\r\n") + "\r\n",
+	})
+	args := []string{
+		"explore", "-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record", "--type", "T=cli.v1.Trailer",
+		filepath.Join(dir, "data.txt"),
+	}
+
+	// Each key draws a screen, and each step checks the last screen it drew.
+	steps := []struct {
+		keys string
+		want []string
+	}{
+		{"", []string{"record 1 of 4  cli.v1.Record", "  hex, lines 1-2 of 2", "* fields, lines 1-", "|WIDGET    001220|", "record 1, 22 bytes"}},
+		{"n", []string{"record 2 of 4  cli.v1.Trailer", "count  1       4       \"0002\"  2"}},
+		{"x", []string{"* fields, lines 1-"}},
+		{"tRecord", []string{"message type: Record"}},
+		{"\r", []string{"record 3 of 4  cli.v1.Record  (type Record)"}},
+		{"n", []string{"record 3 of 4", "no more Record records"}},
+		{"t\x7f\x7f\x7f\x7f\x7f\x7f\r", []string{"record 3 of 4  cli.v1.Record\r\n"}},
+		{"p", []string{"record 2 of 4"}},
+		{"9\r", []string{"record 2 of 4", "record must be 1 to 4"}},
+		{"\x1b[F", []string{"record 4 of 4  cli.v1.Trailer"}},
+		{"x", []string{"|T0001|"}},
+		{"\tj", []string{"* hex, lines 1-1 of 1", "  fields, lines 1-"}},
+		{"q", nil},
+	}
+	input := ""
+	for _, step := range steps {
+		input += step.keys
+	}
+	stdout, _, err := runCommandWith(t.Context(), strings.NewReader(input+"n"), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	screens := strings.Split(stdout, clearScreen)[1:]
+	screen := 0
+	for _, step := range steps {
+		keys := bufio.NewReader(strings.NewReader(step.keys))
+		for {
+			if _, err := readKey(keys); err != nil {
+				break
+			}
+			screen++
+		}
+		if step.want == nil {
+			continue
+		}
+		if screen >= len(screens) {
+			t.Fatalf("step %q: only %d screens", step.keys, len(screens))
+		}
+		for _, want := range step.want {
+			if !strings.Contains(screens[screen], want) {
+				t.Errorf("step %q: screen %d missing %q:\n%s", step.keys, screen, want, screens[screen])
+			}
+		}
+	}
+	if screen != len(screens) {
+		t.Errorf("expected %d screens, got %d", screen, len(screens))
+	}
+
+	if _, _, err := runCommand(t, append(args[:len(args)-1], "-")...); err == nil {
+		t.Error("expected an error exploring stdin")
+	}
+}
+
+func TestStats(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt": strings.Join([]string{
+			"WIDGET    001220240131",
+			"GADGET    00X720240201",
+			"WIDGET    030020231215",
+			"          0001        ",
+			"T0004",
+		}, "\n") + "\n",
+	})
+
+	stdout, _, err := runCommand(t, "stats", "-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record",
+		"--type", "T=cli.v1.Trailer", filepath.Join(dir, "data.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `5 records
+
+cli.v1.Record: 4 records
+FIELD  BLANK  FAILED  DISTINCT  MIN         MAX         TOP
+name   25.0%  0.0%    3         ""          "WIDGET"    "WIDGET" (2), "" (1), "GADGET" (1)
+count  0.0%   25.0%   3         1           300         1 (1), 12 (1), 300 (1)
+due    25.0%  0.0%    3         2023-12-15  2024-02-01  2023-12-15 (1), 2024-01-31 (1), 2024-02-01 (1)
+
+cli.v1.Trailer: 1 records
+FIELD  BLANK  FAILED  DISTINCT  MIN  MAX  TOP
+count  0.0%   0.0%    1         4    4    4 (1)
+`
+	if stdout != want {
+		t.Errorf("got:\n%s\nwant:\n%s", stdout, want)
+	}
+}
+
+func TestMask(t *testing.T) {
+	data := strings.Join([]string{
+		"Widget-9  001220240131",
+		"T0002",
+		"Widget-9  003020240201",
+	}, "\r\n") + "\r\n"
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt":  data,
+	})
+	args := []string{
+		"mask", "-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record", "--type", "T=cli.v1.Trailer",
+		"--field", "name", "--field", "cli.v1.Record.count=redact", "--key", "secret",
+	}
+
+	stdout, _, err := runCommand(t, append(args, filepath.Join(dir, "data.txt"))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(stdout, "\r\n")
+	if len(lines) != 4 || len(lines[0]) != 22 || lines[1] != "T0002" {
+		t.Fatalf("layout changed:\n%q", stdout)
+	}
+	name := lines[0][:10]
+	if name == "Widget-9  " || name[0] < 'A' || name[0] > 'Z' || name[6] != '-' || name[8:] != "  " {
+		t.Errorf("name %q does not keep the shape of the original", name)
+	}
+	if lines[2][:10] != name {
+		t.Errorf("the same value masked differently: %q, %q", name, lines[2][:10])
+	}
+	if lines[0][10:] != "000020240131" {
+		t.Errorf("count not redacted, or due changed: %q", lines[0][10:])
+	}
+
+	// The same key masks the same way, another key differently.
+	again, _, err := runCommand(t, append(args, filepath.Join(dir, "data.txt"))...)
+	if err != nil || again != stdout {
+		t.Errorf("masking is not repeatable: %v\n%s", err, again)
+	}
+	other, _, err := runCommand(t, append(args, "--key", "other", filepath.Join(dir, "data.txt"))...)
+	if err != nil || other[:10] == name {
+		t.Errorf("another key masked the same way: %v\n%s", err, other)
+	}
+
+	if _, _, err := runCommand(t, append(args, "--field", "missing", filepath.Join(dir, "data.txt"))...); err == nil {
+		t.Error("expected an error for a field matching nothing")
+	}
+}
+
+func TestMaskReconciles(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"totals.proto": `
+syntax = "proto3";
+package totals.v1;
+
+import "flatfile/v1/annotations.proto";
+
+message Entry {
+  string type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+  int32 amount = 2 [(flatfile.v1.field) = {
+	fixed_width: { offset: 1, length: 4 }
+	number: { encoding: ENCODING_OVERPUNCH }
+  }];
+  string account = 3 [(flatfile.v1.field) = {
+	fixed_width: { offset: 5, length: 6 }
+	check_digit: CHECK_DIGIT_MOD11
+  }];
+  string sum = 4 [(flatfile.v1.field) = {
+	fixed_width: { offset: 11, length: 3 }
+	checksum: { algorithm: CHECKSUM_ALGORITHM_SUM, range: { offset: 0, length: 11 } }
+  }];
+}
+
+message Trailer {
+  option (flatfile.v1.message) = { control_totals: [
+	{ field: "count", kind: CONTROL_TOTAL_KIND_COUNT, of: "totals.v1.Entry" },
+	{ field: "amount", kind: CONTROL_TOTAL_KIND_SUM, of: "totals.v1.Entry.amount" },
+	{ field: "hash", kind: CONTROL_TOTAL_KIND_HASH, of: "totals.v1.Entry.amount" }
+  ] };
+  string type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 2 }, number: {} }];
+  int32 amount = 3 [(flatfile.v1.field) = { fixed_width: { offset: 3, length: 5 }, number: {} }];
+  int32 hash = 4 [(flatfile.v1.field) = { fixed_width: { offset: 8, length: 3 }, number: {} }];
+  string sum = 5 [(flatfile.v1.field) = {
+	fixed_width: { offset: 11, length: 3 }
+	checksum: { algorithm: CHECKSUM_ALGORITHM_SUM, range: { offset: 0, length: 11 } }
+  }];
+}
+`,
+		// The second entry is negative, with X as its check digit.
+		"data.txt": "E012E123455081\nE003J00104X111\nT0200094094080\n",
+	})
+	schema := []string{"-I", dir, "--proto", "totals.proto", "--message", "totals.v1.Entry", "--type", "T=totals.v1.Trailer"}
+
+	// Under this key the masked amounts still total more than zero, which
+	// the unsigned hash field needs.
+	masked, _, err := runCommand(t, slices.Concat([]string{"mask"}, schema, []string{
+		"--field", "totals.v1.Entry.amount", "--field", "account", "--key", "a", filepath.Join(dir, "data.txt"),
+	})...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(masked, "\n"), "\n")
+	if len(lines) != 3 || lines[0][1:5] == "012E" || lines[2][3:8] == "00094" {
+		t.Fatalf("amounts or totals not masked:\n%s", masked)
+	}
+	if !strings.ContainsAny(lines[1][4:5], "}JKLMNOPQR") {
+		t.Errorf("negative amount lost its sign: %q", lines[1])
+	}
+
+	// The masked file parses, with its check digits and checksums, and its
+	// totals still reconcile.
+	if err := os.WriteFile(filepath.Join(dir, "masked.txt"), []byte(masked), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := runCommand(t, slices.Concat([]string{"validate"}, schema, []string{filepath.Join(dir, "masked.txt")})...); err != nil {
+		t.Errorf("masked file does not parse: %v\n%s", err, masked)
+	}
+	if stdout, _, err := runCommand(t, slices.Concat([]string{"check-totals"}, schema, []string{filepath.Join(dir, "masked.txt")})...); err != nil {
+		t.Errorf("masked totals do not reconcile: %v\n%s", err, stdout)
+	}
+}
+
+func TestFixCheckDigit(t *testing.T) {
+	for _, tc := range []struct {
+		alg       flatfile_pb.CheckDigit
+		raw, want string
+	}{
+		{flatfile_pb.CheckDigit_CHECK_DIGIT_LUHN, " 4111111111111110 ", " 4111111111111111 "},
+		{flatfile_pb.CheckDigit_CHECK_DIGIT_MOD11, "001040", "00104X"},
+		{flatfile_pb.CheckDigit_CHECK_DIGIT_MOD11, "12345X", "123455"},
+	} {
+		raw := []byte(tc.raw)
+		fixCheckDigit(raw, tc.alg)
+		if string(raw) != tc.want {
+			t.Errorf("%s %q: got %q, want %q", tc.alg, tc.raw, raw, tc.want)
+		}
+	}
+}
+
+func TestTranscode(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"packed.proto": `
+syntax = "proto3";
+package transcode.v1;
+
+import "flatfile/v1/annotations.proto";
+
+message Record {
+  option (flatfile.v1.message).record_length = 6;
+
+  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 3 } }];
+  int32 amount = 2 [(flatfile.v1.field) = {
+	fixed_width: { offset: 3, length: 3 }
+	number: { encoding: ENCODING_PACKED_DECIMAL }
+  }];
+}
+`,
+		// The packed bytes include EBCDIC A and a space, which a whole
+		// file translation would corrupt.
+		"data.txt": "AB1\xc1\x40\x1c\nXY2\x00\x12\x3d\n",
+	})
+	schema := []string{"-I", dir, "--proto", "packed.proto", "--message", "transcode.v1.Record"}
+	ebcdic := filepath.Join(dir, "data.ebc")
+
+	if _, _, err := runCommand(t, append(append([]string{"transcode", "--to", "ebcdic", "--out-framing", "rdw", "-o", ebcdic}, schema...), filepath.Join(dir, "data.txt"))...); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(ebcdic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "\x00\x0a\x00\x00\xc1\xc2\xf1\xc1\x40\x1c\x00\x0a\x00\x00\xe7\xe8\xf2\x00\x12\x3d"
+	if string(got) != want {
+		t.Errorf("got % x\nwant % x", got, want)
+	}
+
+	fixed := filepath.Join(dir, "data.fix")
+	if _, _, err := runCommand(t, append(append([]string{"transcode", "--from", "ebcdic", "--to", "ebcdic", "--in-framing", "rdw", "--out-framing", "fixed", "-o", fixed}, schema...), ebcdic)...); err != nil {
+		t.Fatal(err)
+	}
+	stdout, _, err := runCommand(t, append(append([]string{"transcode", "--from", "ebcdic", "--in-framing", "fixed"}, schema...), fixed)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stdout != "AB1\xc1\x40\x1c\nXY2\x00\x12\x3d\n" {
+		t.Errorf("round trip: got %q", stdout)
+	}
+
+	if _, _, err := runCommand(t, append(append([]string{"transcode", "--in-framing", "fixed", "--record-length", "4"}, schema...), filepath.Join(dir, "data.txt"))...); err == nil {
+		t.Error("expected an error for a file which does not fit the fixed length")
+	}
+}
+
+func TestSample(t *testing.T) {
+	lines := []string{"H HEADER\n"}
+	for idx := range 6 {
+		lines = append(lines, fmt.Sprintf("WIDGET    %04d20240131\r\n", idx))
+		if idx%2 == 0 {
+			lines = append(lines, fmt.Sprintf("X%04d\n", idx))
+		}
+	}
+	lines = append(lines, "T0009", "")
+	dir := writeFiles(t, map[string]string{
+		"cli.proto": testProto,
+		"data.txt":  strings.Join(lines, ""),
+	})
+
+	stdout, stderr, err := runCommand(t, "sample", "-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record",
+		"--type", "X=cli.v1.Trailer", "-n", "2", filepath.Join(dir, "data.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "H HEADER\n" +
+		"WIDGET    000020240131\r\n" +
+		"X0000\n" +
+		"WIDGET    000120240131\r\n" +
+		"X0002\n" +
+		"T0009"
+	if stdout != want {
+		t.Errorf("got:\n%q\nwant:\n%q", stdout, want)
+	}
+	if stderr != "kept 6 of 11 records\n" {
+		t.Errorf("stderr %q", stderr)
+	}
+}
+
+func TestCheckTotals(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"totals.proto": `
+syntax = "proto3";
+package totals.v1;
+
+import "flatfile/v1/annotations.proto";
+
+message Entry {
+  string type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+  int32 amount = 2 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 4 }, number: {} }];
+}
+
+message Trailer {
+  option (flatfile.v1.message) = { control_totals: [
+	{ field: "count", kind: CONTROL_TOTAL_KIND_COUNT, of: "totals.v1.Entry" },
+	{ field: "amount", kind: CONTROL_TOTAL_KIND_SUM, of: "totals.v1.Entry.amount", description: "amount total" }
+  ] };
+  string type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 2 }, number: {} }];
+  int32 amount = 3 [(flatfile.v1.field) = { fixed_width: { offset: 3, length: 5 }, number: {} }];
+}
+`,
+		"good.txt": "E0100\nE0025\nT0200125\n",
+		"bad.txt":  "E0100\nE00X5\nT0200125\n",
+	})
+	args := []string{"check-totals", "-I", dir, "--proto", "totals.proto", "--message", "totals.v1.Entry", "--type", "T=totals.v1.Trailer"}
+
+	stdout, _, err := runCommand(t, append(args, filepath.Join(dir, "good.txt"))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `RECORD  TOTAL                    EXPECTED  ACTUAL  RESULT
+3       totals.v1.Trailer.count  2         2       ok
+3       amount total             125       125     ok
+`
+	if stdout != want {
+		t.Errorf("got:\n%s\nwant:\n%s", stdout, want)
+	}
+
+	stdout, stderr, err := runCommand(t, append(args, filepath.Join(dir, "bad.txt"))...)
+	if err == nil || err.Error() != "2 of 2 control totals failed" {
+		t.Errorf("got error %v", err)
+	}
+	if !strings.Contains(stdout, "amount total             125       100     MISMATCH") {
+		t.Errorf("mismatch not reported:\n%s", stdout)
+	}
+	if !strings.HasPrefix(stderr, "record 2: ") {
+		t.Errorf("rejected record not reported: %q", stderr)
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"cli.proto": testProto})
+
+	stdout, _, err := runCommand(t, "jsonschema", "-I", dir, "--proto", "cli.proto", "--message", "cli.v1.Record")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := &binfile.JSONSchema{}
+	if err := json.Unmarshal([]byte(stdout), schema); err != nil {
+		t.Fatal(err)
+	}
+	if schema.Schema != binfile.JSONSchemaDialect || schema.Title != "cli.v1.Record" || len(schema.Properties) != 3 {
+		t.Errorf("got schema %s", stdout)
+	}
+	if due := schema.Properties["due"]; due == nil || due.Type != "string" || due.Format != "date" {
+		t.Errorf("got due %+v", due)
+	}
+}
@@ -0,0 +1,516 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/flatfile/copybook"
+	"github.com/spf13/cobra"
+)
+
+func mappingCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mapping",
+		Short: "Work with partner layout mapping spreadsheets",
+	}
+	cmd.AddCommand(mappingImportCommand())
+	return cmd
+}
+
+func mappingImportCommand() *cobra.Command {
+	var pkg, goPackage, message, sheet, output string
+	var zeroBased bool
+
+	cmd := &cobra.Command{
+		Use:   "import [flags] FILE",
+		Short: "Translate a layout mapping spreadsheet into an annotated proto",
+		Long: "Reads a layout mapping as exported by a partner, as CSV, tab separated text or an Excel\n" +
+			".xlsx workbook, and writes a .proto file with a message for the layout. The header row\n" +
+			"is found by its column names, name, start and length (or end), with optional type,\n" +
+			"format and description columns; rows above it are ignored. When the mapping has a\n" +
+			"record column, each record type becomes a message of its own.\n\n" +
+			"Positions are kept as in the mapping, which are taken to be one based unless a start of\n" +
+			"0 is found or --zero-based is set. Gaps between fields become filler. Types are matched\n" +
+			"loosely, by names such as AN, numeric, amount, date and packed, and a COBOL picture or\n" +
+			"a date format in the format column is used when present. Anything which cannot be\n" +
+			"mapped is left as a string field or a comment to be finished by hand.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			rows, err := readMappingRows(in, sheet)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+			base := strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+			if message == "" {
+				message = base
+			}
+			layouts, err := parseMapping(rows, message)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+			if pkg == "" {
+				pkg = protoIdent(base) + ".v1"
+			}
+
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+
+			if _, err := io.WriteString(out, mappingProto(layouts, pkg, goPackage, zeroBased)); err != nil {
+				return err
+			}
+			return closeOut()
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&pkg, "package", "", "the proto package, by default the file name with .v1")
+	flags.StringVar(&goPackage, "go-package", "", "the go_package option, left out when empty")
+	flags.StringVar(&message, "message", "", "the message name when there is no record column, by default the file name")
+	flags.StringVar(&sheet, "sheet", "", "the worksheet of an .xlsx workbook, by default the first")
+	flags.BoolVar(&zeroBased, "zero-based", false, "read start positions as zero based")
+	flags.StringVarP(&output, "output", "o", "", "write the proto to this file rather than stdout")
+	return cmd
+}
+
+// readMappingRows reads the cells of a CSV, TSV or .xlsx mapping.
+func readMappingRows(in io.Reader, sheet string) ([][]string, error) {
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return xlsxRows(bytes.NewReader(data), int64(len(data)), sheet)
+	}
+	if sheet != "" {
+		return nil, errors.New("--sheet only applies to .xlsx workbooks")
+	}
+
+	// Spreadsheet exports often start with a byte order mark.
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	firstLine, _, _ := bufio.NewReader(bytes.NewReader(data)).ReadLine()
+	if bytes.Count(firstLine, []byte("\t")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = '\t'
+	}
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	return reader.ReadAll()
+}
+
+// mappingColumns are the names a column of each meaning goes by in partner
+// mappings, after lower casing and replacing punctuation with spaces.
+var mappingColumns = map[string][]string{
+	"name":        {"name", "field", "field name", "element", "data element", "element name"},
+	"start":       {"start", "start position", "start pos", "position", "pos", "from", "begin", "offset"},
+	"length":      {"length", "len", "size", "width", "field length"},
+	"end":         {"end", "end position", "end pos", "to"},
+	"type":        {"type", "data type", "datatype", "field type"},
+	"format":      {"format", "picture", "pic", "mask"},
+	"description": {"description", "desc", "notes", "comments", "definition"},
+	"record":      {"record", "record type", "segment"},
+}
+
+func mappingColumnName(header string) string {
+	var sb strings.Builder
+	for _, c := range strings.ToLower(header) {
+		if c >= 'a' && c <= 'z' || c >= '0' && c <= '9' {
+			sb.WriteRune(c)
+		} else {
+			sb.WriteByte(' ')
+		}
+	}
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// mappingHeader returns the index of each known column, if the row is a
+// header naming at least the name and start columns and a length or end.
+func mappingHeader(row []string) (map[string]int, bool) {
+	columns := map[string]int{}
+	for idx, cell := range row {
+		name := mappingColumnName(cell)
+		for column, aliases := range mappingColumns {
+			if _, ok := columns[column]; !ok && slices.Contains(aliases, name) {
+				columns[column] = idx
+			}
+		}
+	}
+	_, hasName := columns["name"]
+	_, hasStart := columns["start"]
+	_, hasLength := columns["length"]
+	_, hasEnd := columns["end"]
+	return columns, hasName && hasStart && (hasLength || hasEnd)
+}
+
+// mappingRow is one field of a mapping, with positions as given.
+type mappingRow struct {
+	row                     int // one based, in the spreadsheet
+	name, typ, format, desc string
+	start, length           int
+	err                     error
+}
+
+type mappingLayout struct {
+	name string
+	rows []mappingRow
+}
+
+// parseMapping finds the header and reads the rows below it into a layout
+// for each record type.
+func parseMapping(rows [][]string, message string) ([]*mappingLayout, error) {
+	headerIdx := -1
+	var columns map[string]int
+	for idx, row := range rows {
+		if cols, ok := mappingHeader(row); ok {
+			headerIdx, columns = idx, cols
+			break
+		}
+	}
+	if headerIdx < 0 {
+		return nil, errors.New("no header row with name, start and length or end columns")
+	}
+
+	cell := func(row []string, column string) string {
+		idx, ok := columns[column]
+		if !ok || idx >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[idx])
+	}
+
+	var layouts []*mappingLayout
+	byRecord := map[string]*mappingLayout{}
+	for idx, row := range rows[headerIdx+1:] {
+		field := mappingRow{
+			row:    headerIdx + idx + 2,
+			name:   cell(row, "name"),
+			typ:    cell(row, "type"),
+			format: cell(row, "format"),
+			desc:   cell(row, "description"),
+		}
+		start, length, end := cell(row, "start"), cell(row, "length"), cell(row, "end")
+		if field.name == "" && start == "" {
+			// Blank and section heading rows.
+			continue
+		}
+		field.err = field.position(start, length, end)
+
+		record := cell(row, "record")
+		layout, ok := byRecord[record]
+		if !ok {
+			name := record
+			if name == "" {
+				name = message
+			}
+			layout = &mappingLayout{name: name}
+			byRecord[record] = layout
+			layouts = append(layouts, layout)
+		}
+		layout.rows = append(layout.rows, field)
+	}
+	if len(layouts) == 0 {
+		return nil, errors.New("no fields below the header row")
+	}
+	return layouts, nil
+}
+
+func (field *mappingRow) position(start, length, end string) error {
+	var err error
+	if field.start, err = mappingNumber(start); err != nil {
+		return fmt.Errorf("start %q is not a position", start)
+	}
+	if length != "" {
+		if field.length, err = mappingNumber(length); err != nil {
+			return fmt.Errorf("length %q is not a number", length)
+		}
+	} else {
+		last, err := mappingNumber(end)
+		if err != nil {
+			return fmt.Errorf("end %q is not a position", end)
+		}
+		field.length = last - field.start + 1
+	}
+	if field.length < 1 {
+		return fmt.Errorf("length %d is not positive", field.length)
+	}
+	return nil
+}
+
+// mappingNumber reads a whole number, as spreadsheets may write it with a
+// decimal point.
+func mappingNumber(cell string) (int, error) {
+	cell = strings.TrimSuffix(strings.TrimSpace(cell), ".0")
+	return strconv.Atoi(cell)
+}
+
+// mappingProto renders the layouts as a proto file.
+func mappingProto(layouts []*mappingLayout, pkg, goPackage string, zeroBased bool) string {
+	if !zeroBased {
+		zeroBased = slices.ContainsFunc(layouts, func(layout *mappingLayout) bool {
+			return slices.ContainsFunc(layout.rows, func(field mappingRow) bool {
+				return field.err == nil && field.start == 0
+			})
+		})
+	}
+
+	pw := &protoWriter{}
+	var messages strings.Builder
+	for idx, layout := range layouts {
+		if idx > 0 {
+			messages.WriteString("\n")
+		}
+		pw.mappingMessage(&messages, layout, zeroBased)
+	}
+	return pw.file("mapping import", pkg, goPackage, messages.String())
+}
+
+type mappingState struct {
+	out    *strings.Builder
+	number int
+	names  map[string]int
+	filler []string
+}
+
+func (pw *protoWriter) mappingMessage(out *strings.Builder, layout *mappingLayout, zeroBased bool) {
+	state := &mappingState{
+		out:   &strings.Builder{},
+		names: map[string]int{},
+	}
+	first := 1
+	if zeroBased {
+		first = 0
+	}
+
+	// Fields are laid out in position order, so gaps and overlaps between
+	// neighbours can be found, but numbered in the order of the mapping.
+	fields := slices.Clone(layout.rows)
+	slices.SortStableFunc(fields, func(a, b mappingRow) int {
+		return a.start - b.start
+	})
+	end := first
+	for _, field := range fields {
+		if field.err != nil {
+			continue
+		}
+		switch {
+		case field.start > end:
+			state.filler = append(state.filler, fmt.Sprintf("{ offset: %d, length: %d }", end, field.start-end))
+		case field.start < end:
+			fmt.Fprintf(state.out, "  // %s at %d overlaps the field before it, which ends at %d.\n", field.name, field.start, end-1)
+		}
+		end = max(end, field.start+field.length)
+	}
+	for _, field := range layout.rows {
+		pw.mappingField(state, field)
+	}
+
+	fmt.Fprintf(out, "// %s, from the layout mapping.\n", layout.name)
+	fmt.Fprintf(out, "message %s {\n", pw.typeName(layout.name))
+	var options []string
+	if !zeroBased {
+		options = append(options, "one_based: true")
+	}
+	options = append(options, fmt.Sprintf("record_length: %d", end-first))
+	if len(state.filler) > 0 {
+		options = append(options, fmt.Sprintf("filler: [%s]", strings.Join(state.filler, ", ")))
+	}
+	fmt.Fprintf(out, "  option (flatfile.v1.message) = {\n    %s\n  };\n\n", strings.Join(options, "\n    "))
+	out.WriteString(state.out.String())
+	out.WriteString("}\n")
+}
+
+var (
+	stringTypes  = []string{"", "a", "an", "x", "alpha", "alphanumeric", "char", "character", "text", "string", "varchar"}
+	numberTypes  = []string{"n", "9", "num", "numeric", "number", "int", "integer", "long", "signed"}
+	decimalTypes = []string{"d", "dec", "decimal", "amount", "amt", "money", "currency"}
+	packedTypes  = []string{"p", "pd", "packed", "packed decimal", "comp 3", "comp3"}
+	binaryTypes  = []string{"b", "bin", "binary", "comp", "comp 4", "comp 5"}
+	dateTypes    = []string{"dt", "date"}
+	boolTypes    = []string{"bool", "boolean", "flag", "indicator", "y n", "yn"}
+	fillerTypes  = []string{"filler", "reserved", "unused", "blank", "spaces"}
+
+	// scaleFormat matches implied decimal places written out, as in
+	// "2 decimals", "2dp" and "implied 2".
+	scaleFormat = regexp.MustCompile(`(?i)^(?:implied\s*)?(\d+)\s*(?:implied\s*)?(?:decimals?|dp|dec|decimal places)?$`)
+)
+
+// mappingField writes the field for one row of the mapping.
+func (pw *protoWriter) mappingField(state *mappingState, field mappingRow) {
+	if field.err != nil {
+		fmt.Fprintf(state.out, "  // %s, row %d: %s\n", field.name, field.row, field.err)
+		return
+	}
+	typ := mappingColumnName(field.typ)
+	if slices.Contains(fillerTypes, typ) || field.name == "" || slices.Contains(fillerTypes, mappingColumnName(field.name)) {
+		state.filler = append(state.filler, fmt.Sprintf("{ offset: %d, length: %d }", field.start, field.length))
+		return
+	}
+
+	name := protoIdent(field.name)
+	if count := state.names[name]; count > 0 {
+		state.names[name]++
+		name = fmt.Sprintf("%s_%d", name, count+1)
+	} else {
+		state.names[name] = 1
+	}
+
+	// A COBOL picture, usually in the format column but sometimes given as
+	// the type, says more than the type.
+	var pic copybook.Picture
+	hasPic := false
+	for idx, source := range []string{field.format, field.typ} {
+		source = strings.ToUpper(strings.ReplaceAll(source, " ", ""))
+		if !strings.ContainsAny(source, "9X") {
+			continue
+		}
+		if parsed, err := copybook.ParsePicture(source); err == nil && !parsed.Edited {
+			pic, hasPic = parsed, true
+			if idx == 1 {
+				typ = ""
+			}
+			break
+		}
+	}
+	scale := 0
+	if hasPic {
+		scale = pic.Scale
+	} else if match := scaleFormat.FindStringSubmatch(strings.TrimSpace(field.format)); match != nil {
+		scale, _ = strconv.Atoi(match[1])
+	}
+	signed := hasPic && pic.Signed || typ == "signed"
+
+	var fieldType, options, note string
+	switch {
+	case slices.Contains(dateTypes, typ):
+		format, ok := mappingDateFormat(field.format, field.length)
+		if !ok {
+			fieldType = "string"
+			options = "string: { trim: TRIM_RIGHT }"
+			note = fmt.Sprintf("date format %q is not supported, and read as text", field.format)
+			break
+		}
+		pw.date = true
+		fieldType = "j5.types.date.v1.Date"
+		options = fmt.Sprintf("date: { format: %s }", strconv.Quote(format))
+
+	case slices.Contains(boolTypes, typ):
+		trueValue, falseValue := "Y", "N"
+		if values := strings.Split(field.format, "/"); len(values) == 2 {
+			trueValue, falseValue = strings.TrimSpace(values[0]), strings.TrimSpace(values[1])
+		}
+		fieldType = "bool"
+		options = fmt.Sprintf("bool: { true_values: [%s] false_values: [%s] trim: TRIM_RIGHT }",
+			strconv.Quote(trueValue), strconv.Quote(falseValue))
+
+	case slices.Contains(packedTypes, typ), slices.Contains(binaryTypes, typ),
+		slices.Contains(numberTypes, typ), slices.Contains(decimalTypes, typ),
+		slices.Contains(stringTypes, typ) && hasPic && pic.Numeric:
+		var number []string
+		digits := field.length
+		switch {
+		case slices.Contains(packedTypes, typ):
+			number = append(number, "encoding: ENCODING_PACKED_DECIMAL")
+			digits = field.length*2 - 1
+			signed = true
+		case slices.Contains(binaryTypes, typ):
+			number = append(number, "encoding: ENCODING_BINARY")
+			digits = field.length * 2
+		case signed:
+			number = append(number, "encoding: ENCODING_OVERPUNCH")
+		}
+		switch {
+		case scale > 0:
+			number = append(number, fmt.Sprintf("fixed_scale: %d", scale))
+			fallthrough
+		case slices.Contains(decimalTypes, typ):
+			pw.decimal = true
+			fieldType = "j5.types.decimal.v1.Decimal"
+		case signed && digits <= 9:
+			fieldType = "int32"
+		case signed:
+			fieldType = "int64"
+		case digits <= 9:
+			fieldType = "uint32"
+		default:
+			fieldType = "uint64"
+		}
+		options = "number: { " + strings.Join(number, ", ") + " }"
+		if len(number) == 0 {
+			options = "number: {}"
+		}
+
+	default:
+		fieldType = "string"
+		options = "string: { trim: TRIM_RIGHT }"
+		if !slices.Contains(stringTypes, typ) {
+			note = fmt.Sprintf("type %q is not recognised, and read as text", field.typ)
+		}
+	}
+
+	if field.desc != "" {
+		for line := range strings.SplitSeq(field.desc, "\n") {
+			fmt.Fprintf(state.out, "  // %s\n", strings.TrimSpace(line))
+		}
+	}
+	if note != "" {
+		fmt.Fprintf(state.out, "  // %s: %s.\n", field.name, note)
+	}
+	state.number++
+	fmt.Fprintf(state.out, "  %s %s = %d [(flatfile.v1.field) = {\n    fixed_width: { offset: %d, length: %d }\n    %s\n  }]; // row %d%s\n",
+		fieldType, name, state.number, field.start, field.length, options, field.row, mappingComment(field))
+}
+
+// mappingComment repeats the type and format of the mapping row for review.
+func mappingComment(field mappingRow) string {
+	out := strings.TrimSpace(field.typ + " " + field.format)
+	if out == "" {
+		return ""
+	}
+	return ": " + out
+}
+
+// mappingDateFormat converts a date format as partners write it, such as
+// CCYYMMDD or MM/DD/YY, to the format of a date field, defaulting by length
+// when none is given.
+func mappingDateFormat(format string, length int) (string, bool) {
+	format = strings.ToUpper(strings.TrimSpace(format))
+	if format == "" {
+		switch length {
+		case 8:
+			return "YYYYMMDD", true
+		case 6:
+			return "YYMMDD", true
+		case 10:
+			return "YYYY-MM-DD", true
+		}
+		return "", false
+	}
+	format = strings.Replace(format, "CCYY", "YYYY", 1)
+	if len(format) != length {
+		return "", false
+	}
+	rest := format
+	for _, token := range []string{"YYYY", "YY", "MM", "DD"} {
+		rest = strings.Replace(rest, token, "", 1)
+	}
+	if strings.ContainsFunc(rest, func(c rune) bool { return c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' }) {
+		return "", false
+	}
+	return format, true
+}
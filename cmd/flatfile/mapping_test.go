@@ -0,0 +1,147 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testMapping = "\xef\xbb\xbfACME Daily Settlement Feed,,,,,\n" +
+	"Field Name,Start,Length,Type,Format,Description\n" +
+	"Record Type,1,1,AN,,Always D\n" +
+	"Account Number,2,10,N,,\n" +
+	"Customer Name,12,20,AN,,\n" +
+	"Amount,32,9,Numeric,S9(7)V99,\"Signed, in dollars\"\n" +
+	"Settle Date,41,8,Date,CCYYMMDD,\n" +
+	"Active,49,1,Flag,Y/N,\n" +
+	",,,,,\n" +
+	"Branch,55,4,X(4),,\n"
+
+func TestMappingImport(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"settle.csv": testMapping})
+	protoFile := filepath.Join(dir, "settle.proto")
+
+	if _, _, err := runCommand(t, "mapping", "import", "-o", protoFile, filepath.Join(dir, "settle.csv")); err != nil {
+		t.Fatal(err)
+	}
+	data, _, err := runCommand(t, "doc", "-I", dir, "--proto", "settle.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"Records are 58 bytes.",
+		"Always D",
+		"| 2-11 | 10 | account_number | integer |",
+		"| 32-40 | 9 | amount | decimal | signed overpunch; 2 implied decimal places |",
+		"| 50-54 | 5 | (filler) |",
+		"| 55-58 | 4 | branch | string |",
+	} {
+		if !strings.Contains(data, want) {
+			t.Errorf("layout does not contain %q:\n%s", want, data)
+		}
+	}
+
+	// Records generated from the imported schema parse under it.
+	schema := []string{"-I", dir, "--proto", "settle.proto", "--message", "settle.v1.Settle"}
+	records := filepath.Join(dir, "records.txt")
+	if _, _, err := runCommand(t, append([]string{"gen-fixture", "--rand-seed", "1", "-o", records}, schema...)...); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := runCommand(t, append(append([]string{"parse"}, schema...), records)...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMappingRecords(t *testing.T) {
+	rows, err := readMappingRows(strings.NewReader(
+		"Record\tField\tFrom\tTo\tData Type\n"+
+			"Header\tRecord Type\t0\t0\tA\n"+
+			"Header\tFile Date\t1\t6\tDate\n"+
+			"Detail\tRecord Type\t0\t0\tA\n"+
+			"Detail\tAmount\t1\t5\tpacked\n"+
+			"Detail\tCount\tx\t4\tN\n"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	layouts, err := parseMapping(rows, "feed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := mappingProto(layouts, "feed.v1", "", false)
+	for _, want := range []string{
+		"message Header {\n  option (flatfile.v1.message) = {\n    record_length: 7\n  };",
+		`date: { format: "YYMMDD" }`,
+		"message Detail {\n  option (flatfile.v1.message) = {\n    record_length: 6\n  };",
+		"int32 amount = 2",
+		"encoding: ENCODING_PACKED_DECIMAL",
+		`// Count, row 6: start "x" is not a position`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("proto does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestXLSXRows(t *testing.T) {
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	for name, content := range map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Notes" sheetId="1" r:id="rId1"/><sheet name="Layout" sheetId="2" r:id="rId2"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Target="/xl/worksheets/sheet2.xml"/></Relationships>`,
+		"xl/sharedStrings.xml":     `<sst><si><t>Name</t></si><si><r><t>Sta</t></r><r><t>rt</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData/></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>
+<row r="3"><c r="A3" t="inlineStr"><is><t>id</t></is></c><c r="C3"><v>1</v></c></row>
+</sheetData></worksheet>`,
+	} {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := readMappingRows(bytes.NewReader(buf.Bytes()), "Layout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"Name", "", "Start"}, nil, {"id", "", "1"}}
+	if !slices.EqualFunc(rows, want, slices.Equal) {
+		t.Errorf("got rows %q, want %q", rows, want)
+	}
+
+	if _, err := readMappingRows(bytes.NewReader(buf.Bytes()), "Missing"); err == nil {
+		t.Error("expected an error for a missing sheet")
+	}
+}
+
+func TestMappingDateFormat(t *testing.T) {
+	for _, tc := range []struct {
+		format string
+		length int
+		want   string
+	}{
+		{"CCYYMMDD", 8, "YYYYMMDD"},
+		{"mm/dd/yyyy", 10, "MM/DD/YYYY"},
+		{"", 6, "YYMMDD"},
+		{"YYYYMMDD", 6, ""},
+		{"YYYYDDD", 7, ""},
+		{"", 5, ""},
+	} {
+		got, ok := mappingDateFormat(tc.format, tc.length)
+		if got != tc.want || ok != (tc.want != "") {
+			t.Errorf("mappingDateFormat(%q, %d) = %q, %v, want %q", tc.format, tc.length, got, ok, tc.want)
+		}
+	}
+}
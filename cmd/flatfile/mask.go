@@ -0,0 +1,417 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	mathrand "math/rand/v2"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func maskCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var types, fields []string
+	var key, output string
+
+	cmd := &cobra.Command{
+		Use:   "mask [flags] FILE",
+		Short: "Replace sensitive fields, leaving the layout intact",
+		Long: "Copies the file, replacing the bytes of each --field in place so that every other byte,\n" +
+			"and the record layout, is unchanged. Fields are named as NAME or NAME=MODE, where NAME is\n" +
+			"the field name or full name, and MODE is one of:\n\n" +
+			"  fake    replace each digit with a digit and each letter with a letter of the same case,\n" +
+			"          the same input always giving the same output under one --key (default)\n" +
+			"  redact  replace each digit with 0 and each letter with X or x\n\n" +
+			"Spaces and punctuation are kept, packed decimal keeps its sign nibble and overpunch keeps\n" +
+			"its sign, so masked values parse as the originals did. Check digits and checksums are\n" +
+			"recalculated, and control totals move by as much as masking changed what they cover, so\n" +
+			"that a file which reconciled still does.\n" +
+			"Without --key, a random key is used and fakes differ from run to run.\n\n" +
+			"Records are parsed as --message, unless they start with the prefix of a --type.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+			selectType, err := prefixSelector(files, schema.message, types)
+			if err != nil {
+				return err
+			}
+
+			masker := &masker{
+				selectType: selectType,
+				modes:      map[string]maskMode{},
+				parsers:    map[protoreflect.FullName]*binfile.MessageParser{},
+				key:        []byte(key),
+			}
+			if masker.original, err = binfile.NewTotalsChecker(layoutMessages(files)...); err != nil {
+				return err
+			}
+			if masker.masked, err = binfile.NewTotalsChecker(layoutMessages(files)...); err != nil {
+				return err
+			}
+			for _, spec := range fields {
+				name, modeName, _ := strings.Cut(spec, "=")
+				mode := maskFake
+				switch modeName {
+				case "", "fake":
+				case "redact":
+					mode = maskRedact
+				default:
+					return fmt.Errorf("--field %q: unknown mode %q, expected fake or redact", spec, modeName)
+				}
+				masker.modes[name] = mode
+			}
+			if len(masker.modes) == 0 {
+				return fmt.Errorf("no --field to mask")
+			}
+			if key == "" {
+				masker.key = []byte(rand.Text())
+			}
+
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+
+			if err := masker.maskFile(in, out); err != nil {
+				return err
+			}
+			if err := masker.unmatched(); err != nil {
+				return err
+			}
+			return closeOut()
+		},
+	}
+	schema.register(cmd)
+	flags := cmd.Flags()
+	flags.StringArrayVar(&types, "type", nil, "PREFIX=MESSAGE, parsing records which start with PREFIX as MESSAGE")
+	flags.StringArrayVar(&fields, "field", nil, "NAME[=MODE], a field to mask")
+	flags.StringVar(&key, "key", "", "the secret the fakes are derived from, for repeatable output")
+	flags.StringVarP(&output, "output", "o", "", "write the masked file here rather than stdout")
+	return cmd
+}
+
+type maskMode int
+
+const (
+	maskFake maskMode = iota
+	maskRedact
+)
+
+type masker struct {
+	selectType binfile.TypeSelector
+	modes      map[string]maskMode
+	parsers    map[protoreflect.FullName]*binfile.MessageParser
+	key        []byte
+
+	// matched records the --field names found in any parsed message type.
+	matched map[string]bool
+
+	// original and masked reconcile the control totals of the file as read
+	// and as masked, for fixTotal.
+	original *binfile.TotalsChecker
+	masked   *binfile.TotalsChecker
+}
+
+func (m *masker) maskFile(in io.Reader, w io.Writer) error {
+	m.matched = map[string]bool{}
+	out := bufio.NewWriter(w)
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			record := bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r"))
+			if err := m.maskRecord(record); err != nil {
+				return err
+			}
+			if _, err := out.Write(line); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return out.Flush()
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// maskRecord masks the fields of the record in place, then brings its control
+// totals and checksums up to date. Records without a type are copied
+// unchanged.
+func (m *masker) maskRecord(record []byte) error {
+	desc, err := m.selectType(record)
+	if err != nil {
+		// Records without a type are copied as they are.
+		m.original.Add(nil)
+		m.masked.Add(nil)
+		return nil
+	}
+	parser, ok := m.parsers[desc.FullName()]
+	if !ok {
+		parser, err = binfile.Compile(desc)
+		if err != nil {
+			return err
+		}
+		m.parsers[desc.FullName()] = parser
+	}
+	originalTotals := m.original.Add(parseOrNil(parser, record))
+
+	// Checksums are only written when every one read as valid, so that a
+	// record which failed its checksum still does. They are written before
+	// the record is parsed again for its totals, and again if a total
+	// changes.
+	changed, checksums := false, true
+	for _, field := range parser.Inspect(record) {
+		if fieldOptions(field.Field).GetChecksum() != nil && (field.Err != nil || len(bytes.Trim(field.Raw, " \x00")) == 0) {
+			checksums = false
+		}
+		mode, ok := m.modes[string(field.Field.FullName())]
+		if ok {
+			m.matched[string(field.Field.FullName())] = true
+		} else if mode, ok = m.modes[string(field.Field.Name())]; ok {
+			m.matched[string(field.Field.Name())] = true
+		} else {
+			continue
+		}
+		raw := record[field.Offset : field.Offset+len(field.Raw)]
+		m.maskField(raw, fieldOptions(field.Field), mode)
+		changed = true
+	}
+
+	if changed && checksums {
+		if err := parser.WriteChecksums(record); err != nil {
+			return err
+		}
+	}
+
+	masked := parseOrNil(parser, record)
+	maskedTotals := m.masked.Add(masked)
+	if len(maskedTotals) != len(originalTotals) {
+		return nil
+	}
+	changed = false
+	for idx, total := range maskedTotals {
+		fixed, err := fixTotal(parser, record, masked, originalTotals[idx], total)
+		if err != nil {
+			return err
+		}
+		changed = changed || fixed
+	}
+	if changed && checksums {
+		return parser.WriteChecksums(record)
+	}
+	return nil
+}
+
+// parseOrNil returns the record parsed, or nil when it fails to parse, which
+// the totals checkers count as a record adding nothing to sums.
+func parseOrNil(parser *binfile.MessageParser, record []byte) proto.Message {
+	msg := dynamicpb.NewMessage(parser.Descriptor())
+	if err := parser.Parse(msg, record); err != nil {
+		return nil
+	}
+	return msg
+}
+
+// fixTotal moves a control total of the masked trailer by as much as masking
+// changed the records it covers, so that a total which matched the original
+// records matches the masked ones, and one which didn't is off by as much as
+// before. Totals which couldn't be read, or which were masked themselves, are
+// left as they are.
+func fixTotal(parser *binfile.MessageParser, record []byte, trailer proto.Message, original, masked binfile.TotalResult) (bool, error) {
+	for _, err := range []error{original.Err, masked.Err} {
+		if err != nil && !errors.Is(err, binfile.ErrControlTotal) {
+			return false, nil
+		}
+	}
+	if !masked.Expected.Equal(original.Expected) {
+		return false, nil
+	}
+
+	refl := trailer.ProtoReflect()
+	fieldDesc := refl.Descriptor().Fields().ByName(protoreflect.Name(masked.Total.Field))
+	want := original.Expected.Add(masked.Actual).Sub(original.Actual)
+	if fw := fieldOptions(fieldDesc).GetFixedWidth(); fw != nil && masked.Total.Kind == flatfile_pb.ControlTotalKind_CONTROL_TOTAL_KIND_HASH {
+		// Hash totals keep the low digits which fit the field.
+		want = want.Mod(decimal.New(1, int32(fw.Length)))
+	}
+	if want.Equal(masked.Expected) {
+		return false, nil
+	}
+
+	if err := setTotal(refl, fieldDesc, want); err != nil {
+		return false, fmt.Errorf("control total %s: %w", masked.Description(), err)
+	}
+	if err := parser.WriteField(record, trailer, fieldDesc.Name()); err != nil {
+		return false, fmt.Errorf("control total %s: %w", masked.Description(), err)
+	}
+	return true, nil
+}
+
+// setTotal sets a control total field to val, for each type of field which
+// binfile reads totals from.
+func setTotal(refl protoreflect.Message, fieldDesc protoreflect.FieldDescriptor, val decimal.Decimal) error {
+	switch fieldDesc.Kind() {
+	case protoreflect.Int32Kind:
+		refl.Set(fieldDesc, protoreflect.ValueOfInt32(int32(val.IntPart())))
+	case protoreflect.Int64Kind:
+		refl.Set(fieldDesc, protoreflect.ValueOfInt64(val.IntPart()))
+	case protoreflect.Uint32Kind:
+		refl.Set(fieldDesc, protoreflect.ValueOfUint32(uint32(val.IntPart())))
+	case protoreflect.Uint64Kind:
+		refl.Set(fieldDesc, protoreflect.ValueOfUint64(uint64(val.IntPart())))
+	case protoreflect.StringKind:
+		// Strings of digits are zero padded to the width of the field.
+		sign, digits := "", val.Abs().String()
+		if val.IsNegative() {
+			sign = "-"
+		}
+		pad := int(fieldOptions(fieldDesc).GetFixedWidth().GetLength()) - len(sign) - len(digits)
+		refl.Set(fieldDesc, protoreflect.ValueOfString(sign+strings.Repeat("0", max(pad, 0))+digits))
+	case protoreflect.MessageKind:
+		if fieldDesc.Message().FullName() != "j5.types.decimal.v1.Decimal" {
+			return fmt.Errorf("%s is not a number", fieldDesc.Message().FullName())
+		}
+		msg := refl.NewField(fieldDesc).Message()
+		msg.Set(msg.Descriptor().Fields().ByName("value"), protoreflect.ValueOfString(val.String()))
+		refl.Set(fieldDesc, protoreflect.ValueOfMessage(msg))
+	default:
+		return fmt.Errorf("%s is not a number", fieldDesc.Kind())
+	}
+	return nil
+}
+
+func (m *masker) unmatched() error {
+	for name := range m.modes {
+		if !m.matched[name] {
+			return fmt.Errorf("--field %s matched no field of any record", name)
+		}
+	}
+	return nil
+}
+
+// maskField replaces the bytes of one field in place. Blank fields stay
+// blank.
+func (m *masker) maskField(raw []byte, tc *flatfile_pb.Field, mode maskMode) {
+	if len(bytes.Trim(raw, " \x00")) == 0 {
+		return
+	}
+
+	// The fakes of a value are drawn from a generator seeded by the keyed
+	// hash of the value, so that a value masks the same way wherever it
+	// appears.
+	mac := hmac.New(sha256.New, m.key)
+	mac.Write(raw)
+	sum := mac.Sum(nil)
+	gen := mathrand.New(mathrand.NewPCG(binary.BigEndian.Uint64(sum[:8]), binary.BigEndian.Uint64(sum[8:16])))
+	digit := func() byte {
+		if mode == maskRedact {
+			return '0'
+		}
+		return byte('0' + gen.IntN(10))
+	}
+
+	number := tc.GetNumber()
+	switch number.GetEncoding() {
+	case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL:
+		// Every nibble is a digit other than the sign in the last.
+		for idx := range raw {
+			high, low := digit()-'0', raw[idx]&0x0f
+			if idx < len(raw)-1 {
+				low = digit() - '0'
+			}
+			raw[idx] = high<<4 | low
+		}
+		return
+
+	case flatfile_pb.Encoding_ENCODING_BINARY:
+		// The top bit is kept, so that signed values keep their sign.
+		for idx := range raw {
+			b := byte(0)
+			if mode == maskFake {
+				b = byte(gen.Uint32())
+			}
+			if idx == 0 {
+				b = b&0x7f | raw[0]&0x80
+			}
+			raw[idx] = b
+		}
+		return
+	}
+
+	isNumber := number != nil
+	for idx, c := range raw {
+		switch {
+		case c >= '0' && c <= '9':
+			raw[idx] = digit()
+		case isNumber:
+			// Signs and decimal points are kept, and the digit of an
+			// overpunched byte is masked in its sign's zone.
+			if zone := strings.IndexByte(overpunchBytes, c); zone >= 0 && number.GetEncoding() == flatfile_pb.Encoding_ENCODING_OVERPUNCH {
+				raw[idx] = overpunchBytes[zone/10*10+int(digit()-'0')]
+			}
+		case c >= 'A' && c <= 'Z':
+			if mode == maskRedact {
+				raw[idx] = 'X'
+			} else {
+				raw[idx] = byte('A' + gen.IntN(26))
+			}
+		case c >= 'a' && c <= 'z':
+			if mode == maskRedact {
+				raw[idx] = 'x'
+			} else {
+				raw[idx] = byte('a' + gen.IntN(26))
+			}
+		}
+	}
+
+	if tc.GetCheckDigit() != flatfile_pb.CheckDigit_CHECK_DIGIT_UNSPECIFIED {
+		fixCheckDigit(raw, tc.GetCheckDigit())
+	}
+}
+
+// overpunchBytes are the last bytes of overpunch numbers, for the digits 0 to
+// 9 of positive numbers then of negative ones.
+const overpunchBytes = "{ABCDEFGHI}JKLMNOPQR"
+
+// fixCheckDigit sets the check character at the end of the value so that it
+// passes the check digit algorithm, leaving it unchanged if none does. MOD11
+// uses X where the check digit would be 10.
+func fixCheckDigit(raw []byte, alg flatfile_pb.CheckDigit) {
+	end := len(bytes.TrimRight(raw, " "))
+	start := len(raw[:end]) - len(bytes.TrimLeft(raw[:end], " "))
+	if end-start < 2 {
+		return
+	}
+	original := raw[end-1]
+	for _, check := range []byte("0123456789X") {
+		raw[end-1] = check
+		if valid, _ := binfile.ValidCheckDigit(alg, string(raw[start:end])); valid {
+			return
+		}
+	}
+	raw[end-1] = original
+}
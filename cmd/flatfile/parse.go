@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/j5/lib/j5codec"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func parseCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	input := &recordInput{}
+
+	cmd := &cobra.Command{
+		Use:   "parse [flags] FILE",
+		Short: "Parse a fixed width file to JSON Lines",
+		Long: "Parses each record of the file as the message and writes it to stdout as a line of JSON.\n" +
+			"Rejected records are reported on stderr, or written to --quarantine, and reading continues.\n" +
+			"Use - for FILE to read stdin.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msgDesc, err := schema.messageDescriptor(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			out := bufio.NewWriter(cmd.OutOrStdout())
+			err = input.read(cmd, msgDesc, args[0], func(record int, msg protoreflect.Message) error {
+				line, err := j5codec.Global.ProtoToJSON(msg)
+				if err != nil {
+					return fmt.Errorf("record %d: %w", record, err)
+				}
+				out.Write(line)     //nolint:errcheck // checked by Flush
+				out.WriteByte('\n') //nolint:errcheck // checked by Flush
+				if input.follow {
+					return out.Flush()
+				}
+				return nil
+			})
+			if flushErr := out.Flush(); flushErr != nil {
+				return flushErr
+			}
+			return err
+		},
+	}
+	schema.register(cmd)
+	input.register(cmd)
+	return cmd
+}
+
+// recordInput reads the records of a file for the streaming commands.
+type recordInput struct {
+	quarantine string
+	follow     bool
+}
+
+func (ri *recordInput) register(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVar(&ri.quarantine, "quarantine", "", "write rejected records to this file as JSON Lines rather than stderr")
+	flags.BoolVarP(&ri.follow, "follow", "F", false, "keep reading as the file grows, like tail -f, until interrupted")
+}
+
+// read calls handle with each record of the file, or stdin for "-", which
+// parses. Rejected records are reported on stderr, or written to the
+// quarantine file when one is named, and fail the command once the whole
+// file is read.
+func (ri *recordInput) read(cmd *cobra.Command, msgDesc protoreflect.MessageDescriptor, path string, handle func(record int, msg protoreflect.Message) error) error {
+	in, err := openInput(cmd, path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	var r io.Reader = in
+	if ri.follow && path != "-" {
+		r = &followReader{ctx: cmd.Context(), r: in}
+	}
+
+	rejects := binfile.QuarantineFunc(func(rejected *binfile.RecordError) error {
+		_, err := fmt.Fprintln(cmd.ErrOrStderr(), rejected)
+		return err
+	})
+	if ri.quarantine != "" {
+		file, err := os.Create(ri.quarantine)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		rejects = binfile.QuarantineWriter(file)
+	}
+
+	fr := binfile.NewFileReader(r, binfile.WithQuarantine(rejects))
+	for {
+		msg := dynamicpb.NewMessage(msgDesc)
+		err := fr.Next(msg)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := handle(fr.Record(), msg); err != nil {
+			return err
+		}
+	}
+
+	if rejected := fr.Quarantined(); rejected > 0 {
+		return fmt.Errorf("%d of %d records rejected", rejected, fr.Record())
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func sampleCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var types []string
+	var perType, headers, trailers int
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "sample [flags] FILE",
+		Short: "Extract a small representative file from a large one",
+		Long: "Copies the first --header and last --trailer records of the file, and the first --count\n" +
+			"records of each record type in between, keeping their order and line endings. The file\n" +
+			"is read once, holding only the sampled records, so it may be of any size. A summary of\n" +
+			"the records kept is written to stderr.\n\n" +
+			"Records are parsed as --message, unless they start with the prefix of a --type. Records\n" +
+			"matching no type are sampled as a type of their own.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if perType < 0 || headers < 0 || trailers < 0 {
+				return errors.New("--count, --header and --trailer must not be negative")
+			}
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+			selectType, err := prefixSelector(files, schema.message, types)
+			if err != nil {
+				return err
+			}
+
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			sampler := &sampler{
+				selectType: selectType,
+				perType:    perType,
+				headers:    headers,
+				trailers:   trailers,
+				counts:     map[protoreflect.FullName]int{},
+			}
+			if err := sampler.read(in); err != nil {
+				return err
+			}
+
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+			kept := sampler.sample()
+			for _, record := range kept {
+				if _, err := out.Write(record.line); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "kept %d of %d records\n", len(kept), sampler.records)
+			return closeOut()
+		},
+	}
+	schema.register(cmd)
+	flags := cmd.Flags()
+	flags.StringArrayVar(&types, "type", nil, "PREFIX=MESSAGE, parsing records which start with PREFIX as MESSAGE")
+	flags.IntVarP(&perType, "count", "n", 5, "the number of records to keep of each type")
+	flags.IntVar(&headers, "header", 1, "the number of records at the start of the file to keep")
+	flags.IntVar(&trailers, "trailer", 1, "the number of records at the end of the file to keep")
+	flags.StringVarP(&output, "output", "o", "", "write the sample here rather than stdout")
+	return cmd
+}
+
+// sampledRecord is a record with its line ending, numbered from 1.
+type sampledRecord struct {
+	number int
+	line   []byte
+}
+
+type sampler struct {
+	selectType binfile.TypeSelector
+	perType    int
+	headers    int
+	trailers   int
+
+	records int
+	counts  map[protoreflect.FullName]int
+	kept    []sampledRecord
+
+	// last holds the most recent records, the trailers once the whole file
+	// is read.
+	last []sampledRecord
+}
+
+func (s *sampler) read(in io.Reader) error {
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			s.add(line)
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *sampler) add(line []byte) {
+	s.records++
+	record := sampledRecord{number: s.records, line: line}
+
+	if s.trailers > 0 {
+		if len(s.last) == s.trailers {
+			s.last = s.last[1:]
+		}
+		s.last = append(s.last, record)
+	}
+
+	if s.records <= s.headers {
+		s.kept = append(s.kept, record)
+		return
+	}
+
+	// Records without a type are counted under the empty name.
+	var name protoreflect.FullName
+	data := bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r"))
+	if desc, err := s.selectType(data); err == nil {
+		name = desc.FullName()
+	}
+	if s.counts[name] < s.perType {
+		s.counts[name]++
+		s.kept = append(s.kept, record)
+	}
+}
+
+// sample merges the kept records with the trailers, in file order.
+func (s *sampler) sample() []sampledRecord {
+	out := slices.Clone(s.kept)
+	for _, record := range s.last {
+		if !slices.ContainsFunc(s.kept, func(kept sampledRecord) bool { return kept.number == record.number }) {
+			out = append(out, record)
+		}
+	}
+	slices.SortFunc(out, func(a, b sampledRecord) int {
+		return a.number - b.number
+	})
+	return out
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/pentops/flatfile/layoutdef"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	// Registers the annotations and the field types they support, so that
+	// schemas can import them without the source.
+	_ "github.com/pentops/flatfile/binfile"
+)
+
+// schemaFlags selects the message describing the records, from a compiled
+// descriptor set, proto source or a layout definition.
+type schemaFlags struct {
+	// optionalMessage lets commands which work on every message of the
+	// schema run without --message.
+	optionalMessage bool
+
+	descriptorSet string
+	protoFiles    []string
+	importPaths   []string
+	layout        string
+	message       string
+}
+
+func (sf *schemaFlags) register(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVar(&sf.descriptorSet, "descriptor-set", "", "a FileDescriptorSet, e.g. from buf build -o or protoc --descriptor_set_out")
+	flags.StringArrayVar(&sf.protoFiles, "proto", nil, "proto source files to compile, relative to an import path")
+	flags.StringArrayVarP(&sf.importPaths, "import-path", "I", nil, "directories to search for --proto files and their imports")
+	flags.StringVar(&sf.layout, "layout", "", "a JSON or YAML layout definition, as doc -f json writes, in place of proto annotations")
+	flags.StringVarP(&sf.message, "message", "m", "", "the full name of the record message, e.g. partner.v1.Detail")
+	if !sf.optionalMessage {
+		cmd.MarkFlagRequired("message") //nolint:errcheck // the flag exists
+	}
+	cmd.MarkFlagsOneRequired("descriptor-set", "proto", "layout")
+	cmd.MarkFlagsMutuallyExclusive("descriptor-set", "proto", "layout")
+}
+
+func (sf *schemaFlags) files(ctx context.Context) (*protoregistry.Files, error) {
+	if sf.layout != "" {
+		data, err := os.ReadFile(sf.layout)
+		if err != nil {
+			return nil, err
+		}
+		layouts, err := layoutdef.Load(data)
+		if err != nil {
+			return nil, fmt.Errorf("reading layout %s: %w", sf.layout, err)
+		}
+		return layoutdef.Files(layouts)
+	}
+
+	var fileSet *descriptorpb.FileDescriptorSet
+	if sf.descriptorSet != "" {
+		data, err := os.ReadFile(sf.descriptorSet)
+		if err != nil {
+			return nil, err
+		}
+		fileSet = &descriptorpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(data, fileSet); err != nil {
+			return nil, fmt.Errorf("reading descriptor set %s: %w", sf.descriptorSet, err)
+		}
+	} else {
+		var err error
+		fileSet, err = compileProtos(ctx, sf.importPaths, sf.protoFiles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Files already linked into the binary, such as the annotations, are
+	// taken from the global registry so that their options and extensions
+	// are the generated types.
+	files := &protoregistry.Files{}
+	for _, file := range fileSet.File {
+		if _, err := protoregistry.GlobalFiles.FindFileByPath(file.GetName()); err == nil {
+			continue
+		}
+		fd, err := protodesc.NewFile(file, resolverChain{files, protoregistry.GlobalFiles})
+		if err != nil {
+			return nil, fmt.Errorf("loading %s: %w", file.GetName(), err)
+		}
+		if err := files.RegisterFile(fd); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func (sf *schemaFlags) messageDescriptor(ctx context.Context) (protoreflect.MessageDescriptor, error) {
+	files, err := sf.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findMessage(files, sf.message)
+}
+
+func findMessage(files *protoregistry.Files, name string) (protoreflect.MessageDescriptor, error) {
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("message %s: %w", name, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+	return msgDesc, nil
+}
+
+// compileProtos compiles the source files into a descriptor set. Options are
+// marshalled and read back so that the flatfile extensions are parsed as the
+// generated types.
+func compileProtos(ctx context.Context, importPaths []string, protoFiles []string) (*descriptorpb.FileDescriptorSet, error) {
+	if len(importPaths) == 0 {
+		importPaths = []string{"."}
+	}
+	compiler := protocompile.Compiler{
+		// Comments are kept for the doc command.
+		SourceInfoMode: protocompile.SourceInfoStandard,
+		Resolver: protocompile.CompositeResolver{
+			&protocompile.SourceResolver{ImportPaths: importPaths},
+			protocompile.ResolverFunc(func(path string) (protocompile.SearchResult, error) {
+				fd, err := protoregistry.GlobalFiles.FindFileByPath(path)
+				if err != nil {
+					return protocompile.SearchResult{}, err
+				}
+				return protocompile.SearchResult{Desc: fd}, nil
+			}),
+		},
+	}
+	compiled, err := compiler.Compile(ctx, protoFiles...)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSet := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor) error
+	add = func(fd protoreflect.FileDescriptor) error {
+		if seen[fd.Path()] {
+			return nil
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := range imports.Len() {
+			if err := add(imports.Get(i).FileDescriptor); err != nil {
+				return err
+			}
+		}
+		data, err := proto.Marshal(protodesc.ToFileDescriptorProto(fd))
+		if err != nil {
+			return err
+		}
+		file := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(data, file); err != nil {
+			return err
+		}
+		fileSet.File = append(fileSet.File, file)
+		return nil
+	}
+	for _, fd := range compiled {
+		if err := add(fd); err != nil {
+			return nil, err
+		}
+	}
+	return fileSet, nil
+}
+
+// resolverChain resolves from each of the resolvers in turn.
+type resolverChain []protodesc.Resolver
+
+func (rc resolverChain) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	for _, resolver := range rc {
+		if fd, err := resolver.FindFileByPath(path); err == nil {
+			return fd, nil
+		}
+	}
+	return nil, protoregistry.NotFound
+}
+
+func (rc resolverChain) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	for _, resolver := range rc {
+		if desc, err := resolver.FindDescriptorByName(name); err == nil {
+			return desc, nil
+		}
+	}
+	return nil, protoregistry.NotFound
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func statsCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var types []string
+	var top, maxDistinct int
+
+	cmd := &cobra.Command{
+		Use:   "stats [flags] FILE",
+		Short: "Profile the values of each field over a file",
+		Long: "Reads every record of the file and prints, for each field, the share of records where it\n" +
+			"is blank or fails to parse, the number of distinct values, the smallest and largest value\n" +
+			"and the most common values, for profiling a new feed.\n\n" +
+			"Distinct values are counted up to --max-distinct, beyond which only the blank, failure and\n" +
+			"range figures are kept. Records are parsed as --message, unless they start with the prefix\n" +
+			"of a --type.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+			selectType, err := prefixSelector(files, schema.message, types)
+			if err != nil {
+				return err
+			}
+
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			profile := &fileProfile{
+				selectType:  selectType,
+				maxDistinct: maxDistinct,
+				messages:    map[protoreflect.FullName]*messageProfile{},
+			}
+			scanner := bufio.NewScanner(in)
+			scanner.Buffer(nil, maxLine)
+			for scanner.Scan() {
+				if err := profile.add(bytes.TrimSuffix(scanner.Bytes(), []byte("\r"))); err != nil {
+					return err
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+			return profile.write(cmd.OutOrStdout(), top)
+		},
+	}
+	schema.register(cmd)
+	flags := cmd.Flags()
+	flags.StringArrayVar(&types, "type", nil, "PREFIX=MESSAGE, parsing records which start with PREFIX as MESSAGE")
+	flags.IntVar(&top, "top", 3, "the number of most common values to show for each field")
+	flags.IntVar(&maxDistinct, "max-distinct", 1000, "the number of distinct values to count for each field")
+	return cmd
+}
+
+type fileProfile struct {
+	selectType  binfile.TypeSelector
+	maxDistinct int
+
+	records  int
+	untyped  int
+	messages map[protoreflect.FullName]*messageProfile
+}
+
+type messageProfile struct {
+	parser  *binfile.MessageParser
+	records int
+	fields  map[protoreflect.FullName]*fieldProfile
+}
+
+type fieldProfile struct {
+	field  protoreflect.FieldDescriptor
+	offset int
+
+	blank  int
+	failed int
+
+	// counts holds the number of records with each formatted value, until
+	// there are more than maxDistinct, when it is dropped.
+	counts   map[string]int
+	overflow bool
+
+	min, max protoreflect.Value
+}
+
+func (fp *fileProfile) add(record []byte) error {
+	fp.records++
+	desc, err := fp.selectType(record)
+	if err != nil {
+		// Records without a type are counted rather than failing the profile.
+		fp.untyped++
+		return nil
+	}
+
+	mp, ok := fp.messages[desc.FullName()]
+	if !ok {
+		parser, err := binfile.Compile(desc)
+		if err != nil {
+			return err
+		}
+		mp = &messageProfile{
+			parser: parser,
+			fields: map[protoreflect.FullName]*fieldProfile{},
+		}
+		fp.messages[desc.FullName()] = mp
+	}
+	mp.records++
+
+	for _, inspection := range mp.parser.Inspect(record) {
+		field, ok := mp.fields[inspection.Field.FullName()]
+		if !ok {
+			field = &fieldProfile{
+				field:  inspection.Field,
+				offset: inspection.Offset,
+				counts: map[string]int{},
+			}
+			mp.fields[inspection.Field.FullName()] = field
+		}
+		field.add(inspection, fp.maxDistinct)
+	}
+	return nil
+}
+
+func (fp *fieldProfile) add(inspection binfile.FieldInspection, maxDistinct int) {
+	if len(bytes.Trim(inspection.Raw, " \x00")) == 0 {
+		fp.blank++
+	}
+	if inspection.Err != nil {
+		fp.failed++
+		return
+	}
+	if !inspection.Value.IsValid() {
+		return
+	}
+
+	if !fp.overflow {
+		fp.counts[formatValue(fp.field, inspection.Value)]++
+		if len(fp.counts) > maxDistinct {
+			fp.overflow = true
+			fp.counts = nil
+		}
+	}
+
+	if !fp.min.IsValid() {
+		fp.min, fp.max = inspection.Value, inspection.Value
+		return
+	}
+	if order, ok := compareValue(fp.field, inspection.Value, fp.min); ok && order < 0 {
+		fp.min = inspection.Value
+	}
+	if order, ok := compareValue(fp.field, inspection.Value, fp.max); ok && order > 0 {
+		fp.max = inspection.Value
+	}
+}
+
+func (fp *fileProfile) write(w io.Writer, top int) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "%d records", fp.records)
+	if fp.untyped > 0 {
+		fmt.Fprintf(out, ", %d without a type", fp.untyped)
+	}
+	fmt.Fprintln(out)
+
+	for _, name := range slices.Sorted(maps.Keys(fp.messages)) {
+		mp := fp.messages[name]
+		fmt.Fprintf(out, "\n%s: %d records\n", name, mp.records)
+
+		fields := slices.SortedFunc(maps.Values(mp.fields), func(a, b *fieldProfile) int {
+			return cmp.Compare(a.offset, b.offset)
+		})
+		tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "FIELD\tBLANK\tFAILED\tDISTINCT\tMIN\tMAX\tTOP")
+		for _, field := range fields {
+			minText, maxText := "-", "-"
+			if field.min.IsValid() {
+				if _, ok := compareValue(field.field, field.min, field.max); ok {
+					minText, maxText = formatValue(field.field, field.min), formatValue(field.field, field.max)
+				}
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				field.field.Name(),
+				percent(field.blank, mp.records),
+				percent(field.failed, mp.records),
+				field.distinct(),
+				minText,
+				maxText,
+				field.top(top),
+			)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}
+
+func (fp *fieldProfile) distinct() string {
+	if fp.overflow {
+		return "many"
+	}
+	return strconv.Itoa(len(fp.counts))
+}
+
+// top lists the n most common values with their counts, most common first.
+func (fp *fieldProfile) top(n int) string {
+	values := slices.SortedFunc(maps.Keys(fp.counts), func(a, b string) int {
+		return cmp.Or(cmp.Compare(fp.counts[b], fp.counts[a]), cmp.Compare(a, b))
+	})
+	parts := make([]string, 0, n)
+	for _, value := range values[:min(n, len(values))] {
+		parts = append(parts, fmt.Sprintf("%s (%d)", value, fp.counts[value]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func percent(count, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(count)*100/float64(total))
+}
+
+// compareValue orders two values of the field, reporting false for kinds
+// without a meaningful order, such as enums and bools.
+func compareValue(fieldDesc protoreflect.FieldDescriptor, a, b protoreflect.Value) (int, bool) {
+	switch fieldDesc.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return cmp.Compare(a.Int(), b.Int()), true
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return cmp.Compare(a.Uint(), b.Uint()), true
+	case protoreflect.StringKind:
+		return cmp.Compare(a.String(), b.String()), true
+	case protoreflect.MessageKind:
+		switch fieldDesc.Message().FullName() {
+		case "j5.types.decimal.v1.Decimal":
+			value := fieldDesc.Message().Fields().ByName("value")
+			aDec, aErr := decimal.NewFromString(a.Message().Get(value).String())
+			bDec, bErr := decimal.NewFromString(b.Message().Get(value).String())
+			if aErr != nil || bErr != nil {
+				return 0, false
+			}
+			return aDec.Cmp(bDec), true
+		case "j5.types.date.v1.Date":
+			return cmp.Compare(formatValue(fieldDesc, a), formatValue(fieldDesc, b)), true
+		case "google.protobuf.StringValue":
+			value := fieldDesc.Message().Fields().ByName("value")
+			return cmp.Compare(a.Message().Get(value).String(), b.Message().Get(value).String()), true
+		}
+	}
+	return 0, false
+}
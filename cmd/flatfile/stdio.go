@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// openInput opens the named file, or stdin for "-", so that commands can
+// read from pipelines.
+func openInput(cmd *cobra.Command, path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(cmd.InOrStdin()), nil
+	}
+	return os.Open(path)
+}
+
+// openOutput creates the named file, or returns stdout when the path is empty
+// or "-". The returned close may be deferred and also called to check the
+// error once writing is done.
+func openOutput(cmd *cobra.Command, path string) (io.Writer, func() error, error) {
+	if path == "" || path == "-" {
+		return cmd.OutOrStdout(), func() error { return nil }, nil
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, sync.OnceValue(file.Close), nil
+}
+
+// followPoll is how often a followed file is checked for new records.
+const followPoll = 250 * time.Millisecond
+
+// followReader reads on past the end of a file as it grows, like tail -f,
+// until the context is done. Records are framed by their line endings, so a
+// record which is only partly written is held back until it is complete.
+type followReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (fr *followReader) Read(p []byte) (int, error) {
+	for {
+		n, err := fr.r.Read(p)
+		if n > 0 || err != io.EOF {
+			return n, err
+		}
+		select {
+		case <-fr.ctx.Done():
+			return 0, io.EOF
+		case <-time.After(followPoll):
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func checkTotalsCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var types []string
+
+	cmd := &cobra.Command{
+		Use:   "check-totals [flags] FILE",
+		Short: "Reconcile the control totals of trailer records",
+		Long: "Reads every record of the file and checks the control_totals declared by each trailer\n" +
+			"message, the record counts, sums and hash totals, against the records before it, printing\n" +
+			"a line per total. Exits non-zero when any total does not match, for use in transfer\n" +
+			"acceptance scripts.\n\n" +
+			"Records which fail to parse count as records but add nothing to sums, and are reported\n" +
+			"on stderr. Records are parsed as --message, unless they start with the prefix of a --type.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+			selectType, err := prefixSelector(files, schema.message, types)
+			if err != nil {
+				return err
+			}
+			checker, err := binfile.NewTotalsChecker(layoutMessages(files)...)
+			if err != nil {
+				return err
+			}
+
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			results, err := readTotals(in, cmd.ErrOrStderr(), selectType, checker)
+			if err != nil {
+				return err
+			}
+			if len(results) == 0 {
+				return errors.New("no records with control totals")
+			}
+			failed, err := writeTotals(cmd.OutOrStdout(), results)
+			if err != nil {
+				return err
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d control totals failed", failed, len(results))
+			}
+			return nil
+		},
+	}
+	schema.register(cmd)
+	cmd.Flags().StringArrayVar(&types, "type", nil, "PREFIX=MESSAGE, parsing records which start with PREFIX as MESSAGE")
+	return cmd
+}
+
+func readTotals(in io.Reader, errOut io.Writer, selectType binfile.TypeSelector, checker *binfile.TotalsChecker) ([]binfile.TotalResult, error) {
+	var results []binfile.TotalResult
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, maxLine)
+	for record := 1; scanner.Scan(); record++ {
+		data := bytes.TrimSuffix(scanner.Bytes(), []byte("\r"))
+		var msg proto.Message
+		if desc, err := selectType(data); err != nil {
+			fmt.Fprintf(errOut, "record %d: %s\n", record, err)
+		} else {
+			dyn := dynamicpb.NewMessage(desc)
+			if err := binfile.ParseMessage(dyn, data); err != nil {
+				fmt.Fprintf(errOut, "record %d: %s\n", record, err)
+			} else {
+				msg = dyn
+			}
+		}
+		results = append(results, checker.Add(msg)...)
+	}
+	return results, scanner.Err()
+}
+
+// writeTotals prints a line per total, returning how many failed.
+func writeTotals(out io.Writer, results []binfile.TotalResult) (int, error) {
+	failed := 0
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "RECORD\tTOTAL\tEXPECTED\tACTUAL\tRESULT")
+	for _, result := range results {
+		status := "ok"
+		switch {
+		case errors.Is(result.Err, binfile.ErrControlTotal):
+			status = "MISMATCH"
+			failed++
+		case result.Err != nil:
+			status = result.Err.Error()
+			failed++
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", result.Record, result.Description(), result.Expected, result.Actual, status)
+	}
+	return failed, tw.Flush()
+}
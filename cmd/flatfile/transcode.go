@@ -0,0 +1,186 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/flatfile/recordio"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func transcodeCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var types []string
+	var from, to, inFraming, outFraming, output string
+	var recordLength int
+
+	cmd := &cobra.Command{
+		Use:   "transcode [flags] FILE",
+		Short: "Convert a file between ASCII and EBCDIC, or between record framings",
+		Long: "Copies the file, translating the text of each record between ascii (ISO-8859-1) and\n" +
+			"ebcdic (code page 037) and re-framing the records. Packed decimal and binary fields are\n" +
+			"copied byte for byte, as the schema marks them, so they are not corrupted as they would\n" +
+			"be by translating the whole file.\n\n" +
+			"Framings are newline (records end in a newline of the file's character set), rdw (each\n" +
+			"record starts with a four byte record descriptor word, as on z/OS variable length files)\n" +
+			"and fixed (records are --record-length bytes with no separator, by default the\n" +
+			"record_length of --message). Newline framing cannot hold binary fields which may contain\n" +
+			"the newline byte.\n\n" +
+			"Records are parsed as --message, unless they start with the prefix of a --type. Prefixes\n" +
+			"are matched against the text of the record, whichever the input character set.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+			selectType, err := prefixSelector(files, schema.message, types)
+			if err != nil {
+				return err
+			}
+			fromSet, err := recordio.CharsetByName(from)
+			if err != nil {
+				return err
+			}
+			toSet, err := recordio.CharsetByName(to)
+			if err != nil {
+				return err
+			}
+			if recordLength == 0 {
+				msgDesc, err := findMessage(files, schema.message)
+				if err != nil {
+					return err
+				}
+				ext, _ := proto.GetExtension(msgDesc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+				recordLength = int(ext.GetRecordLength())
+			}
+			if recordLength <= 0 && (inFraming == string(recordio.Fixed) || outFraming == string(recordio.Fixed)) {
+				return errors.New("fixed framing needs --record-length or a message record_length")
+			}
+
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+			out, closeOut, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOut() //nolint:errcheck // checked on success
+
+			reader, err := recordio.NewReader(in, recordio.Framing(inFraming), fromSet, recordLength)
+			if err != nil {
+				return err
+			}
+			writer, err := recordio.NewWriter(out, recordio.Framing(outFraming), toSet, recordLength)
+			if err != nil {
+				return err
+			}
+
+			tc := &transcoder{
+				selectType: selectType,
+				from:       fromSet,
+				to:         toSet,
+				binary:     map[protoreflect.FullName][]fieldSpan{},
+			}
+			for record := 1; ; record++ {
+				data, err := reader.Read()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					return fmt.Errorf("record %d: %w", record, err)
+				}
+				if err := tc.translate(data); err != nil {
+					return fmt.Errorf("record %d: %w", record, err)
+				}
+				if err := writer.Write(data); err != nil {
+					return fmt.Errorf("record %d: %w", record, err)
+				}
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			return closeOut()
+		},
+	}
+	schema.register(cmd)
+	flags := cmd.Flags()
+	flags.StringArrayVar(&types, "type", nil, "PREFIX=MESSAGE, parsing records which start with PREFIX as MESSAGE")
+	flags.StringVar(&from, "from", "ascii", "the character set of the file, ascii or ebcdic")
+	flags.StringVar(&to, "to", "ascii", "the character set to write, ascii or ebcdic")
+	flags.StringVar(&inFraming, "in-framing", "newline", "how the file's records are separated, newline, rdw or fixed")
+	flags.StringVar(&outFraming, "out-framing", "newline", "how to separate the records written, newline, rdw or fixed")
+	flags.IntVar(&recordLength, "record-length", 0, "the length of fixed framing records")
+	flags.StringVarP(&output, "output", "o", "", "write the converted file here rather than stdout")
+	return cmd
+}
+
+// fieldSpan is a zero based range of a record.
+type fieldSpan struct {
+	offset, length int
+}
+
+type transcoder struct {
+	selectType binfile.TypeSelector
+	from, to   recordio.Charset
+
+	// binary holds the packed and binary fields of each message type.
+	binary map[protoreflect.FullName][]fieldSpan
+}
+
+// translate converts the text of the record in place, leaving the bytes of
+// its binary fields as they are.
+func (tc *transcoder) translate(record []byte) error {
+	text := make([]byte, len(record))
+	for idx, b := range record {
+		text[idx] = tc.from.DecodeByte(b)
+	}
+	desc, err := tc.selectType(text)
+	if err != nil {
+		return err
+	}
+	spans, ok := tc.binary[desc.FullName()]
+	if !ok {
+		spans = binarySpans(desc)
+		tc.binary[desc.FullName()] = spans
+	}
+
+	keep := make([]bool, len(record))
+	for _, span := range spans {
+		for idx := max(span.offset, 0); idx < min(span.offset+span.length, len(record)); idx++ {
+			keep[idx] = true
+		}
+	}
+	for idx := range record {
+		if !keep[idx] {
+			record[idx] = tc.to.EncodeByte(text[idx])
+		}
+	}
+	return nil
+}
+
+func binarySpans(desc protoreflect.MessageDescriptor) []fieldSpan {
+	ext, _ := proto.GetExtension(desc.Options(), flatfile_pb.E_Message).(*flatfile_pb.Message)
+	var spans []fieldSpan
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		tc := fieldOptions(fields.Get(i))
+		switch tc.GetNumber().GetEncoding() {
+		case flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL, flatfile_pb.Encoding_ENCODING_BINARY:
+		default:
+			continue
+		}
+		offset := int(tc.GetFixedWidth().GetOffset())
+		if ext.GetOneBased() {
+			offset--
+		}
+		spans = append(spans, fieldSpan{offset: offset, length: int(tc.GetFixedWidth().GetLength())})
+	}
+	return spans
+}
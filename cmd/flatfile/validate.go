@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func validateCommand() *cobra.Command {
+	schema := &schemaFlags{}
+	var types []string
+	var trailerCount string
+	var examples int
+	var allowRejected int
+
+	cmd := &cobra.Command{
+		Use:   "validate [flags] FILE",
+		Short: "Check a whole file and summarize the failures",
+		Long: "Reads every record of the file and prints the record counts, the errors by field with\n" +
+			"example records, and the trailer reconciliation. Exits non-zero when more records are\n" +
+			"rejected than --allow-rejected or the trailer does not reconcile, for use as an\n" +
+			"acceptance check before ingest.\n\n" +
+			"Records are parsed as --message, unless they start with the prefix of a --type.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := schema.files(cmd.Context())
+			if err != nil {
+				return err
+			}
+			selectType, err := prefixSelector(files, schema.message, types)
+			if err != nil {
+				return err
+			}
+
+			opts := []binfile.ReportOption{binfile.WithExamples(examples)}
+			if trailerCount != "" {
+				opts = append(opts, binfile.WithTrailerCheck(checkTrailerCount(protoreflect.Name(trailerCount))))
+			}
+
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			report, err := binfile.ReportFile(in, selectType, opts...)
+			if err != nil {
+				return err
+			}
+			if err := writeReport(cmd.OutOrStdout(), report, trailerCount != ""); err != nil {
+				return err
+			}
+
+			if report.Rejected > allowRejected {
+				return fmt.Errorf("%d of %d records rejected", report.Rejected, report.Records)
+			}
+			if report.TrailerErr != nil {
+				return fmt.Errorf("trailer does not reconcile: %w", report.TrailerErr)
+			}
+			return nil
+		},
+	}
+	schema.register(cmd)
+	flags := cmd.Flags()
+	flags.StringArrayVar(&types, "type", nil, "PREFIX=MESSAGE, parsing records which start with PREFIX as MESSAGE")
+	flags.StringVar(&trailerCount, "trailer-count", "", "a field of the last record which must equal the number of records before it")
+	flags.IntVar(&examples, "examples", 3, "the number of failing records to show for each field")
+	flags.IntVar(&allowRejected, "allow-rejected", 0, "the number of rejected records to accept")
+	return cmd
+}
+
+// prefixSelector selects the message of the longest matching prefix, or the
+// default message when none match.
+func prefixSelector(files *protoregistry.Files, defaultName string, types []string) (binfile.TypeSelector, error) {
+	defaultDesc, err := findMessage(files, defaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	byPrefix := map[string]protoreflect.MessageDescriptor{}
+	for _, spec := range types {
+		prefix, name, ok := strings.Cut(spec, "=")
+		if !ok || prefix == "" {
+			return nil, fmt.Errorf("--type %q is not PREFIX=MESSAGE", spec)
+		}
+		desc, err := findMessage(files, name)
+		if err != nil {
+			return nil, err
+		}
+		byPrefix[prefix] = desc
+	}
+	prefixes := slices.SortedFunc(maps.Keys(byPrefix), func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	return func(record []byte) (protoreflect.MessageDescriptor, error) {
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(record, []byte(prefix)) {
+				return byPrefix[prefix], nil
+			}
+		}
+		return defaultDesc, nil
+	}, nil
+}
+
+// checkTrailerCount compares the named field of the trailer to the number of
+// records before it.
+func checkTrailerCount(name protoreflect.Name) binfile.TrailerCheck {
+	return func(trailer proto.Message, report *binfile.Report) error {
+		refl := trailer.ProtoReflect()
+		fieldDesc := refl.Descriptor().Fields().ByName(name)
+		if fieldDesc == nil {
+			return fmt.Errorf("trailer %s has no field %s", refl.Descriptor().FullName(), name)
+		}
+
+		var count int64
+		val := refl.Get(fieldDesc)
+		switch fieldDesc.Kind() {
+		case protoreflect.Int32Kind, protoreflect.Int64Kind,
+			protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+			protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+			count = val.Int()
+		case protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+			protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+			count = int64(val.Uint())
+		default:
+			return fmt.Errorf("trailer field %s is not an integer", fieldDesc.FullName())
+		}
+
+		if want := int64(report.Records - 1); count != want {
+			return fmt.Errorf("%s is %d, file has %d records before the trailer", fieldDesc.FullName(), count, want)
+		}
+		return nil
+	}
+}
+
+func writeReport(out io.Writer, report *binfile.Report, trailerChecked bool) error {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "records:  %d\n", report.Records)
+	fmt.Fprintf(sb, "accepted: %d\n", report.Records-report.Rejected)
+	fmt.Fprintf(sb, "rejected: %d\n", report.Rejected)
+	fmt.Fprintf(sb, "warnings: %d\n", report.Warnings)
+
+	if len(report.ByType) > 0 {
+		fmt.Fprintln(sb, "\nrecords by type:")
+		for _, name := range slices.Sorted(maps.Keys(report.ByType)) {
+			fmt.Fprintf(sb, "  %s: %d\n", name, report.ByType[name])
+		}
+	}
+
+	if len(report.ByField) > 0 {
+		fmt.Fprintln(sb, "\nerrors by field:")
+		for _, name := range slices.Sorted(maps.Keys(report.ByField)) {
+			label := string(name)
+			if label == "" {
+				label = "(record)"
+			}
+			fmt.Fprintf(sb, "  %s: %d\n", label, report.ByField[name])
+			for _, example := range report.Examples[name] {
+				fmt.Fprintf(sb, "    record %d %s: %s\n", example.Record, strconv.Quote(string(example.Raw)), exampleError(example.Err))
+			}
+		}
+	}
+
+	if len(report.ByClass) > 0 {
+		fmt.Fprintln(sb, "\nerrors by class:")
+		for _, class := range slices.Sorted(maps.Keys(report.ByClass)) {
+			fmt.Fprintf(sb, "  %s: %d\n", class, report.ByClass[class])
+		}
+	}
+
+	if report.TrailerErr != nil {
+		fmt.Fprintf(sb, "\ntrailer: %s\n", report.TrailerErr)
+	} else if trailerChecked {
+		fmt.Fprintln(sb, "\ntrailer: ok")
+	}
+
+	_, err := io.WriteString(out, sb.String())
+	return err
+}
+
+// exampleError drops the field prefix from field errors, as the example is
+// already listed under its field.
+func exampleError(err error) string {
+	var fieldErr *binfile.FieldError
+	if errors.As(err, &fieldErr) {
+		return fieldErr.Err.Error()
+	}
+	return err.Error()
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// xlsxRows reads the cells of a worksheet of an Office Open XML workbook as
+// text, the named sheet or else the first. Only what a layout mapping needs is
+// read: shared and inline strings and the stored text of values. Formatting,
+// formulas and dates stored as serial numbers are not interpreted.
+func xlsxRows(r io.ReaderAt, size int64, sheet string) ([][]string, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("reading workbook: %w", err)
+	}
+
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := xlsxPart(archive, "xl/workbook.xml", &workbook); err != nil {
+		return nil, err
+	}
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := xlsxPart(archive, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return nil, err
+	}
+
+	var sheetPath string
+	for _, candidate := range workbook.Sheets {
+		if sheet != "" && candidate.Name != sheet {
+			continue
+		}
+		for _, rel := range rels.Relationships {
+			if rel.ID == candidate.ID {
+				sheetPath = rel.Target
+			}
+		}
+		break
+	}
+	if sheetPath == "" {
+		if sheet != "" {
+			return nil, fmt.Errorf("workbook has no sheet %q", sheet)
+		}
+		return nil, fmt.Errorf("workbook has no sheets")
+	}
+	if strings.HasPrefix(sheetPath, "/") {
+		sheetPath = strings.TrimPrefix(sheetPath, "/")
+	} else {
+		sheetPath = path.Join("xl", sheetPath)
+	}
+
+	type richText struct {
+		Text string `xml:"t"`
+		Runs []struct {
+			Text string `xml:"t"`
+		} `xml:"r"`
+	}
+	text := func(rt richText) string {
+		out := rt.Text
+		for _, run := range rt.Runs {
+			out += run.Text
+		}
+		return out
+	}
+
+	var shared struct {
+		Items []richText `xml:"si"`
+	}
+	// Workbooks without text cells have no shared strings.
+	if err := xlsxPart(archive, "xl/sharedStrings.xml", &shared); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	var worksheet struct {
+		Rows []struct {
+			Number int `xml:"r,attr"`
+			Cells  []struct {
+				Ref    string   `xml:"r,attr"`
+				Type   string   `xml:"t,attr"`
+				Value  string   `xml:"v"`
+				Inline richText `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xlsxPart(archive, sheetPath, &worksheet); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for _, row := range worksheet.Rows {
+		// Empty rows are left out of the sheet, but numbered rows keep their
+		// place so that row numbers match the spreadsheet.
+		for row.Number > len(rows)+1 {
+			rows = append(rows, nil)
+		}
+		var cells []string
+		for _, cell := range row.Cells {
+			column := xlsxColumn(cell.Ref)
+			if column < 0 {
+				column = len(cells)
+			}
+			for len(cells) <= column {
+				cells = append(cells, "")
+			}
+			switch cell.Type {
+			case "s":
+				idx, err := strconv.Atoi(cell.Value)
+				if err != nil || idx < 0 || idx >= len(shared.Items) {
+					return nil, fmt.Errorf("cell %s: invalid shared string %q", cell.Ref, cell.Value)
+				}
+				cells[column] = text(shared.Items[idx])
+			case "inlineStr":
+				cells[column] = text(cell.Inline)
+			default:
+				cells[column] = cell.Value
+			}
+		}
+		rows = append(rows, cells)
+	}
+	return rows, nil
+}
+
+func xlsxPart(archive *zip.Reader, name string, into any) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return fmt.Errorf("workbook part %s: %w", name, err)
+	}
+	defer file.Close()
+	if err := xml.NewDecoder(file).Decode(into); err != nil {
+		return fmt.Errorf("reading workbook part %s: %w", name, err)
+	}
+	return nil
+}
+
+// xlsxColumn returns the zero based column of a cell reference such as
+// "AB12", or -1 when there is none.
+func xlsxColumn(ref string) int {
+	column := 0
+	letters := 0
+	for _, c := range ref {
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		column = column*26 + int(c-'A'+1)
+		letters++
+	}
+	if letters == 0 {
+		return -1
+	}
+	return column - 1
+}
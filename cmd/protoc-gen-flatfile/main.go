@@ -0,0 +1,88 @@
+// protoc-gen-flatfile checks the flatfile annotations of the files being
+// generated and fails generation when they are inconsistent, so that broken
+// layouts are caught when the schema is built rather than on the first
+// record. It writes no files, and runs alongside the other plugins.
+//
+// Layout issues of the kinds given by the allow parameter, e.g.
+// allow=gap, are not reported.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func main() {
+	var flags flag.FlagSet
+	var allow []string
+	flags.Func("allow", "a layout issue kind not to report", func(kind string) error {
+		allow = append(allow, kind)
+		return nil
+	})
+
+	protogen.Options{
+		ParamFunc: flags.Set,
+	}.Run(func(gen *protogen.Plugin) error {
+		errs := []error{}
+		for _, file := range gen.Files {
+			if !file.Generate {
+				continue
+			}
+			errs = append(errs, checkFile(file.Desc, allow)...)
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// checkFile validates each message of the file, including nested messages,
+// with at least one fixed width field.
+func checkFile(file protoreflect.FileDescriptor, allow []string) []error {
+	errs := []error{}
+	var walk func(messages protoreflect.MessageDescriptors)
+	walk = func(messages protoreflect.MessageDescriptors) {
+		for i := range messages.Len() {
+			msgDesc := messages.Get(i)
+			if hasFixedWidth(msgDesc) {
+				errs = append(errs, checkMessage(file.Path(), msgDesc, allow)...)
+			}
+			walk(msgDesc.Messages())
+		}
+	}
+	walk(file.Messages())
+	return errs
+}
+
+func hasFixedWidth(msgDesc protoreflect.MessageDescriptor) bool {
+	fields := msgDesc.Fields()
+	for i := range fields.Len() {
+		tc, _ := proto.GetExtension(fields.Get(i).Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+		if tc.GetFixedWidth() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func checkMessage(path string, msgDesc protoreflect.MessageDescriptor, allow []string) []error {
+	errs := []error{}
+	if err := binfile.ValidateMessageDescriptor(msgDesc); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %w", path, err))
+	}
+	for _, issue := range binfile.ValidateLayout(msgDesc) {
+		kind := strings.ReplaceAll(issue.Kind.String(), " ", "-")
+		if slices.Contains(allow, kind) {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s: message %s: layout %s: %s", path, msgDesc.FullName(), kind, issue))
+	}
+	return errs
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+)
+
+func TestCheckFile(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package check.v1;
+
+		import "flatfile/v1/annotations.proto";
+		import "j5/types/date/v1/date.proto";
+
+		message Record {
+		  string name = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 10 } }];
+		  int32 count = 2 [(flatfile.v1.field) = { fixed_width: { offset: 12, length: 3 }, number: {} }];
+		}
+
+		message Detail {
+		  bool active = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 }, number: {} }];
+		}
+
+		message Trailer {
+		  j5.types.date.v1.Date due = 1 [(flatfile.v1.field) = {
+			fixed_width: { offset: 0, length: 8 }
+			date: { format: "YYYYMMDD" }
+		  }];
+		}
+
+		message Plain {
+		  string name = 1;
+		}`})
+
+	file := fileDesc.MessageByName(t, "check.v1.Record").ParentFile()
+
+	errs := checkFile(file, nil)
+	want := []string{
+		"test.proto: message check.v1.Record: layout gap: 2 unmapped bytes at offset 10",
+		"test.proto: field check.v1.Detail.active: number options on bool",
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for idx, err := range errs {
+		if err.Error() != want[idx] {
+			t.Errorf("error %d: got %q, want %q", idx, err, want[idx])
+		}
+	}
+
+	if errs := checkFile(file, []string{"gap"}); len(errs) != 1 {
+		t.Errorf("with gaps allowed, got %v", errs)
+	}
+}
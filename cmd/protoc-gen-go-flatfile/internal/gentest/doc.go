@@ -0,0 +1,5 @@
+// Package gentest holds the code generated for gentest.proto by protoc-gen-go
+// and protoc-gen-go-flatfile, checked in so that the generated methods are
+// compiled and tested against the reflection parser. TestGeneratedCode in the
+// plugin fails when gentest_flatfile.pb.go no longer matches the plugin.
+package gentest
@@ -0,0 +1,87 @@
+package gentest
+
+import (
+	"testing"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flowtest/prototest"
+	"github.com/pentops/j5/j5types/date_j5t"
+	"github.com/pentops/j5/j5types/decimal_j5t"
+	"google.golang.org/protobuf/proto"
+)
+
+// The generated methods must read and write records exactly as the
+// reflection based parser does.
+
+func TestAppendRecord(t *testing.T) {
+	parser, err := binfile.Compile((&Record{}).ProtoReflect().Descriptor())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, msg := range map[string]*Record{
+		"Full": {
+			Type:   Type_TYPE_DETAIL,
+			Name:   "WIDGET",
+			Count:  proto.Int32(0),
+			Total:  1234567,
+			Active: true,
+			Due:    &date_j5t.Date{Year: 2024, Month: 1, Day: 31},
+			Amount: &decimal_j5t.Decimal{Value: "12.34"},
+			Note:   "not written",
+		},
+		"Empty": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			want, err := parser.AppendRecord([]byte("prefix"), msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := msg.AppendRecord([]byte("prefix"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("got record %q, want %q", got, want)
+			}
+		})
+	}
+
+	if _, err := (&Record{Count: proto.Int32(1000)}).AppendRecord(nil); err == nil {
+		t.Error("expected an error writing a count wider than the field")
+	}
+}
+
+func TestParseRecord(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		record  string
+		wantErr bool
+	}{
+		{name: "Full", record: "DWIDGET    0070001234567Y202401310000001234e1f847f4"},
+		{name: "Blank", record: "                        N                  f807714f"},
+		{name: "Unknown Type", record: "XWIDGET    0070001234567Y202401310000001234e1f847f4", wantErr: true},
+		{name: "Checksum", record: "DWIDGET    0070001234567Y2024013100000012340000000", wantErr: true},
+		{name: "Short", record: "DWIDGET", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			want := &Record{}
+			wantErr := binfile.ParseMessage(want, []byte(tc.record))
+			if (wantErr != nil) != tc.wantErr {
+				t.Fatalf("binfile.ParseMessage: got error %v", wantErr)
+			}
+
+			got, err := ParseRecord([]byte(tc.record))
+			if wantErr != nil {
+				if err == nil || err.Error() != wantErr.Error() {
+					t.Fatalf("got error %v, want %v", err, wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			prototest.AssertEqualProto(t, want, got)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+// protoc-gen-go-flatfile generates ParseFlatFile and AppendRecord methods,
+// and a ParseXxx function, for messages with flatfile annotations, which read
+// and write each field directly on the generated struct rather than through
+// protoreflect.
+package main
+
+import (
+	"fmt"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const binfilePackage = protogen.GoImportPath("github.com/pentops/flatfile/binfile")
+
+func main() {
+	protogen.Options{}.Run(func(gen *protogen.Plugin) error {
+		for _, file := range gen.Files {
+			if !file.Generate {
+				continue
+			}
+			if err := generateFile(gen, file); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func generateFile(gen *protogen.Plugin, file *protogen.File) error {
+	messages := flatfileMessages(file.Messages)
+	if len(messages) == 0 {
+		return nil
+	}
+
+	g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+"_flatfile.pb.go", file.GoImportPath)
+	g.P("// Code generated by protoc-gen-go-flatfile. DO NOT EDIT.")
+	g.P("// source: ", file.Desc.Path())
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+
+	for _, message := range messages {
+		if err := generateMessage(g, message); err != nil {
+			return fmt.Errorf("message %s: %w", message.Desc.FullName(), err)
+		}
+	}
+	return nil
+}
+
+// flatfileMessages finds the messages, including nested messages, with at
+// least one fixed width field.
+func flatfileMessages(messages []*protogen.Message) []*protogen.Message {
+	found := []*protogen.Message{}
+	for _, message := range messages {
+		if len(flatfileFields(message)) > 0 {
+			found = append(found, message)
+		}
+		found = append(found, flatfileMessages(message.Messages)...)
+	}
+	return found
+}
+
+func flatfileFields(message *protogen.Message) []*protogen.Field {
+	fields := []*protogen.Field{}
+	for _, field := range message.Fields {
+		tc, _ := proto.GetExtension(field.Desc.Options(), flatfile_pb.E_Field).(*flatfile_pb.Field)
+		if tc.GetFixedWidth() != nil {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func generateMessage(g *protogen.GeneratedFile, message *protogen.Message) error {
+	fields := flatfileFields(message)
+	parserVar := "_" + message.GoIdent.GoName + "_flatfile"
+	onceValues := g.QualifiedGoIdent(protogen.GoIdent{GoName: "OnceValues", GoImportPath: "sync"})
+	generatedParser := g.QualifiedGoIdent(binfilePackage.Ident("GeneratedParser"))
+	newGeneratedParser := g.QualifiedGoIdent(binfilePackage.Ident("NewGeneratedParser"))
+
+	g.P("var ", parserVar, " = ", onceValues, "(func() (*", generatedParser, ", error) {")
+	g.P("return ", newGeneratedParser, "((*", message.GoIdent, ")(nil).ProtoReflect().Descriptor(),")
+	for _, field := range fields {
+		g.P("\"", field.Desc.Name(), "\",")
+	}
+	g.P(")")
+	g.P("})")
+	g.P()
+
+	fieldError := g.QualifiedGoIdent(binfilePackage.Ident("FieldError"))
+	g.P("// ParseFlatFile parses a fixed width record into x, as binfile.ParseMessage")
+	g.P("// does, without setting each field through protoreflect.")
+	g.P("func (x *", message.GoIdent, ") ParseFlatFile(data []byte) error {")
+	g.P("_, err := x.ParseFlatFileWithWarnings(data)")
+	g.P("return err")
+	g.P("}")
+	g.P()
+
+	g.P("// ParseFlatFileWithWarnings parses the record as ParseFlatFile does, also")
+	g.P("// returning the errors of fields annotated with SEVERITY_WARNING, as")
+	g.P("// binfile.ParseMessageWithWarnings does.")
+	g.P("func (x *", message.GoIdent, ") ParseFlatFileWithWarnings(data []byte) ([]*", fieldError, ", error) {")
+	g.P("parser, err := ", parserVar, "()")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("r, err := parser.Reader(data)")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	for idx, field := range fields {
+		conversion, err := fieldConversion(g, field)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Desc.Name(), err)
+		}
+		g.P("if val, err := parser.Read(r, ", idx, "); err != nil {")
+		g.P("return nil, err")
+		g.P("} else if val.IsValid() {")
+		if field.Desc.HasPresence() && field.Message == nil {
+			g.P("v := ", conversion)
+			g.P("x.", field.GoName, " = &v")
+		} else {
+			g.P("x.", field.GoName, " = ", conversion)
+		}
+		g.P("}")
+	}
+	g.P("return parser.FinishWithWarnings(x, r)")
+	g.P("}")
+	g.P()
+
+	g.P("// Parse", message.GoIdent.GoName, " parses a fixed width record as a new ", message.GoIdent.GoName, ".")
+	g.P("func Parse", message.GoIdent.GoName, "(data []byte) (*", message.GoIdent, ", error) {")
+	g.P("x := &", message.GoIdent, "{}")
+	g.P("if err := x.ParseFlatFile(data); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("return x, nil")
+	g.P("}")
+	g.P()
+
+	valueOf := func(name string) string {
+		return g.QualifiedGoIdent(protogen.GoIdent{GoName: name, GoImportPath: "google.golang.org/protobuf/reflect/protoreflect"})
+	}
+	g.P("// AppendRecord formats x as a fixed width record appended to buf, as")
+	g.P("// binfile.MessageParser.AppendRecord does, without reading each field")
+	g.P("// through protoreflect.")
+	g.P("func (x *", message.GoIdent, ") AppendRecord(buf []byte) ([]byte, error) {")
+	g.P("parser, err := ", parserVar, "()")
+	g.P("if err != nil {")
+	g.P("return buf, err")
+	g.P("}")
+	g.P("start := len(buf)")
+	g.P("buf, record := parser.Append(buf)")
+	for idx, field := range fields {
+		value, isSet := fieldValue(field, valueOf)
+		if isSet != "" {
+			g.P("if ", isSet, " {")
+		}
+		g.P("if err := parser.Write(record, ", idx, ", ", value, "); err != nil {")
+		g.P("return buf[:start], err")
+		g.P("}")
+		if isSet != "" {
+			g.P("}")
+		}
+	}
+	g.P("if err := parser.WriteChecksums(record); err != nil {")
+	g.P("return buf[:start], err")
+	g.P("}")
+	g.P("return buf, nil")
+	g.P("}")
+	g.P()
+	return nil
+}
+
+// fieldValue returns the expression converting the field of x to a
+// protoreflect.Value, and the condition under which the field is set, empty
+// when it is always written. Unset fields are left blank, other than bools,
+// which are written as false, matching AppendRecord.
+func fieldValue(field *protogen.Field, valueOf func(string) string) (string, string) {
+	get := "x." + field.GoName
+	isSet := ""
+	switch {
+	case field.Desc.Kind() == protoreflect.BoolKind:
+		get = "x.Get" + field.GoName + "()"
+	case field.Desc.HasPresence():
+		isSet = get + " != nil"
+		if field.Message == nil {
+			get = "*" + get
+		}
+	case field.Desc.Kind() == protoreflect.StringKind:
+		isSet = get + ` != ""`
+	default:
+		isSet = get + " != 0"
+	}
+
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return valueOf("ValueOfString") + "(" + get + ")", isSet
+	case protoreflect.BoolKind:
+		return valueOf("ValueOfBool") + "(" + get + ")", isSet
+	case protoreflect.Int32Kind:
+		return valueOf("ValueOfInt32") + "(" + get + ")", isSet
+	case protoreflect.Int64Kind:
+		return valueOf("ValueOfInt64") + "(" + get + ")", isSet
+	case protoreflect.Uint32Kind:
+		return valueOf("ValueOfUint32") + "(" + get + ")", isSet
+	case protoreflect.Uint64Kind:
+		return valueOf("ValueOfUint64") + "(" + get + ")", isSet
+	case protoreflect.EnumKind:
+		return valueOf("ValueOfEnum") + "(" + valueOf("EnumNumber") + "(" + get + "))", isSet
+	default:
+		return valueOf("ValueOfMessage") + "(" + get + ".ProtoReflect())", isSet
+	}
+}
+
+// fieldConversion returns the expression converting val, a
+// protoreflect.Value, to the Go type of the field.
+func fieldConversion(g *protogen.GeneratedFile, field *protogen.Field) (string, error) {
+	if field.Desc.Cardinality() == protoreflect.Repeated {
+		return "", fmt.Errorf("repeated fields are not supported")
+	}
+	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+		return "", fmt.Errorf("oneof fields are not supported")
+	}
+
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return "val.String()", nil
+	case protoreflect.BoolKind:
+		return "val.Bool()", nil
+	case protoreflect.Int32Kind:
+		return "int32(val.Int())", nil
+	case protoreflect.Int64Kind:
+		return "val.Int()", nil
+	case protoreflect.Uint32Kind:
+		return "uint32(val.Uint())", nil
+	case protoreflect.Uint64Kind:
+		return "val.Uint()", nil
+	case protoreflect.EnumKind:
+		return g.QualifiedGoIdent(field.Enum.GoIdent) + "(val.Enum())", nil
+	case protoreflect.MessageKind:
+		return "val.Message().Interface().(*" + g.QualifiedGoIdent(field.Message.GoIdent) + ")", nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", field.Desc.Kind())
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/cmd/protoc-gen-go-flatfile/internal/gentest"
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestGenerate(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": `
+		syntax = "proto3";
+		package gen.v1;
+
+		import "flatfile/v1/annotations.proto";
+		import "j5/types/date/v1/date.proto";
+
+		message Record {
+		  Type type = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 1 } }];
+		  string name = 2 [(flatfile.v1.field) = { fixed_width: { offset: 1, length: 10 } }];
+		  optional int32 count = 3 [(flatfile.v1.field) = { fixed_width: { offset: 11, length: 3 }, number: {} }];
+		  j5.types.date.v1.Date due = 4 [(flatfile.v1.field) = {
+			fixed_width: { offset: 14, length: 8 }
+			date: { format: "YYYYMMDD" }
+		  }];
+		  string note = 5;
+		}
+
+		message Plain {
+		  string name = 1;
+		}
+
+		enum Type {
+		  TYPE_UNSPECIFIED = 0;
+		  TYPE_DETAIL = 1 [(flatfile.v1.enum).key = "D"];
+		}`})
+
+	file := fileDesc.MessageByName(t, "gen.v1.Record").ParentFile()
+
+	protoFiles := withDependencies(file, map[string]bool{})
+	// prototest leaves file options uninterpreted, so go_package is set here.
+	protoFiles[len(protoFiles)-1].Options = &descriptorpb.FileOptions{
+		GoPackage: proto.String("example.com/gen/v1/gen_pb"),
+	}
+
+	content := runPlugin(t, file.Path(), protoFiles, "example.com/gen/v1/gen_pb/test_flatfile.pb.go")
+	for _, want := range []string{
+		`func (x *Record) ParseFlatFile(data []byte) error {`,
+		`func (x *Record) ParseFlatFileWithWarnings(data []byte) ([]*binfile.FieldError, error) {`,
+		`return parser.FinishWithWarnings(x, r)`,
+		`x.Type = Type(val.Enum())`,
+		`x.Name = val.String()`,
+		"v := int32(val.Int())\n\t\tx.Count = &v",
+		`x.Due = val.Message().Interface().(*date_j5t.Date)`,
+		`"type",`,
+		`func ParseRecord(data []byte) (*Record, error) {`,
+		`func (x *Record) AppendRecord(buf []byte) ([]byte, error) {`,
+		"if x.Type != 0 {\n\t\tif err := parser.Write(record, 0, protoreflect.ValueOfEnum(protoreflect.EnumNumber(x.Type))); err != nil {",
+		"if x.Count != nil {\n\t\tif err := parser.Write(record, 2, protoreflect.ValueOfInt32(*x.Count)); err != nil {",
+		`parser.Write(record, 3, protoreflect.ValueOfMessage(x.Due.ProtoReflect()))`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "Plain") || strings.Contains(content, `"note"`) {
+		t.Errorf("expected only flatfile fields to be generated, got:\n%s", content)
+	}
+}
+
+// TestGeneratedCode checks the code checked in for internal/gentest, which
+// is tested against the reflection parser, is what the plugin generates.
+func TestGeneratedCode(t *testing.T) {
+	file := gentest.File_gentest_proto
+	content := runPlugin(t, file.Path(), withDependencies(file, map[string]bool{}),
+		"github.com/pentops/flatfile/cmd/protoc-gen-go-flatfile/internal/gentest/gentest_flatfile.pb.go")
+
+	want, err := os.ReadFile("internal/gentest/gentest_flatfile.pb.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != string(want) {
+		t.Errorf("internal/gentest/gentest_flatfile.pb.go is out of date, regenerate it from gentest.proto. Generated:\n%s", content)
+	}
+}
+
+// runPlugin generates the file, returning the content of the one file the
+// plugin must write, named name.
+func runPlugin(t *testing.T, path string, protoFiles []*descriptorpb.FileDescriptorProto, name string) string {
+	t.Helper()
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{path},
+		ProtoFile:      protoFiles,
+	}
+	plugin, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+		if err := generateFile(plugin, file); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resp := plugin.Response()
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+	if len(resp.File) != 1 || resp.File[0].GetName() != name {
+		t.Fatalf("expected one generated file, got %v", resp.File)
+	}
+	return resp.File[0].GetContent()
+}
+
+// withDependencies lists the file and its imports, dependencies first, as
+// protoc sends them to plugins.
+func withDependencies(file protoreflect.FileDescriptor, seen map[string]bool) []*descriptorpb.FileDescriptorProto {
+	if seen[file.Path()] {
+		return nil
+	}
+	seen[file.Path()] = true
+
+	files := []*descriptorpb.FileDescriptorProto{}
+	imports := file.Imports()
+	for i := range imports.Len() {
+		files = append(files, withDependencies(imports.Get(i).FileDescriptor, seen)...)
+	}
+	return append(files, protodesc.ToFileDescriptorProto(file))
+}
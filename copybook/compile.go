@@ -0,0 +1,141 @@
+package copybook
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Descriptor builds a proto file in package pkg with a message for each
+// record of the layout, annotated as `flatfile copybook import` writes them,
+// so that copybooks can be loaded at runtime without generating code.
+// Messages are named after their records in upper camel case, and the enums
+// of 88 level conditions are nested in the message of their field.
+func (l *Layout) Descriptor(pkg protoreflect.FullName) (protoreflect.FileDescriptor, error) {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String(strings.ReplaceAll(string(pkg), ".", "/") + "/copybook.proto"),
+		Package:    proto.String(string(pkg)),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"flatfile/v1/annotations.proto"},
+	}
+
+	usesDecimal := false
+	names := map[string]bool{}
+	for _, record := range l.Flatten() {
+		name := typeName(record.Item.Name)
+		for n := 2; names[name]; n++ {
+			name = fmt.Sprintf("%s%d", typeName(record.Item.Name), n)
+		}
+		names[name] = true
+
+		msg := &descriptorpb.DescriptorProto{
+			Name:    proto.String(name),
+			Options: &descriptorpb.MessageOptions{},
+		}
+		proto.SetExtension(msg.Options, flatfile_pb.E_Message, &flatfile_pb.Message{
+			RecordLength: uint32(record.Length),
+			Filler:       record.Filler,
+		})
+
+		for _, field := range record.Fields {
+			if field.Options == nil {
+				continue
+			}
+			fieldDesc := &descriptorpb.FieldDescriptorProto{
+				Name:     proto.String(field.Name),
+				JsonName: proto.String(jsonName(field.Name)),
+				Number:   proto.Int32(int32(len(msg.Field) + 1)),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_Type(field.Kind).Enum(),
+				Options:  &descriptorpb.FieldOptions{},
+			}
+			proto.SetExtension(fieldDesc.Options, flatfile_pb.E_Field, field.Options)
+
+			switch field.Kind {
+			case protoreflect.MessageKind:
+				usesDecimal = true
+				fieldDesc.TypeName = proto.String(".j5.types.decimal.v1.Decimal")
+			case protoreflect.EnumKind:
+				enum := conditionEnum(field)
+				msg.EnumType = append(msg.EnumType, enum)
+				fieldDesc.TypeName = proto.String(fmt.Sprintf(".%s.%s.%s", pkg, name, enum.GetName()))
+			}
+			msg.Field = append(msg.Field, fieldDesc)
+		}
+		file.MessageType = append(file.MessageType, msg)
+	}
+	if usesDecimal {
+		file.Dependency = append(file.Dependency, "j5/types/decimal/v1/decimal.proto")
+	}
+
+	return protodesc.NewFile(file, protoregistry.GlobalFiles)
+}
+
+// Compile builds a parser for each record of the layout, in the order of the
+// copybook, from the messages of Descriptor. Parse records into
+// dynamicpb.NewMessage(parser.Descriptor()).
+func (l *Layout) Compile(pkg protoreflect.FullName) ([]*binfile.MessageParser, error) {
+	file, err := l.Descriptor(pkg)
+	if err != nil {
+		return nil, err
+	}
+	messages := file.Messages()
+	parsers := make([]*binfile.MessageParser, 0, messages.Len())
+	for i := range messages.Len() {
+		parser, err := binfile.Compile(messages.Get(i))
+		if err != nil {
+			return nil, fmt.Errorf("record %s: %w", messages.Get(i).Name(), err)
+		}
+		parsers = append(parsers, parser)
+	}
+	return parsers, nil
+}
+
+// conditionEnum builds the enum of the 88 level conditions of a field, keyed
+// by their values.
+func conditionEnum(field *Field) *descriptorpb.EnumDescriptorProto {
+	prefix := strings.ToUpper(field.Name) + "_"
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: proto.String(typeName(field.Name)),
+		Value: []*descriptorpb.EnumValueDescriptorProto{{
+			Name:   proto.String(prefix + "UNSPECIFIED"),
+			Number: proto.Int32(0),
+		}},
+	}
+	for idx, condition := range field.Item.Conditions {
+		value := &descriptorpb.EnumValueDescriptorProto{
+			Name:    proto.String(prefix + strings.ToUpper(fieldName(condition.Name))),
+			Number:  proto.Int32(int32(idx + 1)),
+			Options: &descriptorpb.EnumValueOptions{},
+		}
+		proto.SetExtension(value.Options, flatfile_pb.E_Enum, &flatfile_pb.Enum{Key: condition.Values[0]})
+		enum.Value = append(enum.Value, value)
+	}
+	return enum
+}
+
+// jsonName is the lower camel case name protoc gives a field.
+func jsonName(name string) string {
+	var sb strings.Builder
+	upper := false
+	for _, c := range name {
+		switch {
+		case c == '_':
+			upper = true
+		case upper && c >= 'a' && c <= 'z':
+			sb.WriteRune(c - 'a' + 'A')
+			upper = false
+		default:
+			sb.WriteRune(c)
+			upper = false
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,99 @@
+package copybook
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestFlatten(t *testing.T) {
+	layout, err := Parse(strings.NewReader(testCopybook))
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := layout.Flatten()
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+	record := records[0]
+	if record.Length != 69 {
+		t.Errorf("got length %d, want 69", record.Length)
+	}
+	if len(record.Filler) != 1 || record.Filler[0].Offset != 66 || record.Filler[0].Length != 3 {
+		t.Errorf("got filler %v", record.Filler)
+	}
+
+	var names []string
+	for _, field := range record.Fields {
+		if field.Options == nil {
+			names = append(names, "("+strings.Join(field.Notes, " ")+")")
+			continue
+		}
+		names = append(names, field.Name)
+	}
+	want := "cust_type cust_id cust_name cust_balance cust_limit addr_line_1 addr_line_2 addr_zip " +
+		"(CUST-ALT REDEFINES CUST-ADDR at offset 41, length 25, is not mapped.)"
+	if got := strings.Join(names, " "); got != want {
+		t.Errorf("got fields\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCompile(t *testing.T) {
+	layout, err := Parse(strings.NewReader(testCopybook))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsers, err := layout.Compile("custrec.v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsers) != 1 {
+		t.Fatalf("got %d parsers, want 1", len(parsers))
+	}
+	parser := parsers[0]
+	if name := parser.Descriptor().FullName(); name != "custrec.v1.CustomerRecord" {
+		t.Errorf("got message %s", name)
+	}
+
+	record := "W" + "00001234" + "ACME WHOLESALE      " +
+		"\x00\x01\x23\x45\x6c" + "000250{" +
+		"1 MAIN ST " + "SPRINGFLD " + "12345" + "   "
+	msg := dynamicpb.NewMessage(parser.Descriptor())
+	if err := parser.Parse(msg, []byte(record)); err != nil {
+		t.Fatal(err)
+	}
+
+	fields := parser.Descriptor().Fields()
+	get := func(name protoreflect.Name) protoreflect.Value {
+		return msg.Get(fields.ByName(name))
+	}
+	if got := get("cust_type").Enum(); got != 2 {
+		t.Errorf("got cust_type %d, want CUST_TYPE_CUST_WHOLESALE", got)
+	}
+	if got := get("cust_id").Uint(); got != 1234 {
+		t.Errorf("got cust_id %d", got)
+	}
+	if got := get("cust_name").String(); got != "ACME WHOLESALE" {
+		t.Errorf("got cust_name %q", got)
+	}
+	if got := get("addr_line_2").String(); got != "SPRINGFLD" {
+		t.Errorf("got addr_line_2 %q", got)
+	}
+	if got := get("addr_zip").Uint(); got != 12345 {
+		t.Errorf("got addr_zip %d", got)
+	}
+
+	// The V of the picture is the implied decimal point.
+	decimalValue := func(name protoreflect.Name) string {
+		inner := get(name).Message()
+		return inner.Get(inner.Descriptor().Fields().ByName("value")).String()
+	}
+	if got := decimalValue("cust_balance"); got != "1234.56" {
+		t.Errorf("got cust_balance %q, want 1234.56", got)
+	}
+	if got := decimalValue("cust_limit"); got != "25" {
+		t.Errorf("got cust_limit %q, want 25", got)
+	}
+}
@@ -0,0 +1,380 @@
+package copybook
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Layout is the records described by a copybook.
+type Layout struct {
+	// Records are the 01 and 77 level items, in the order of the copybook.
+	Records []*Item
+}
+
+// Usage is the storage of an item, normalised from the synonyms COBOL
+// allows, e.g. COMPUTATIONAL-3 and PACKED-DECIMAL are both UsagePacked.
+type Usage string
+
+const (
+	UsageDisplay Usage = ""
+	UsageBinary  Usage = "COMP"
+	UsagePacked  Usage = "COMP-3"
+	UsageFloat   Usage = "COMP-1"
+	UsageDouble  Usage = "COMP-2"
+)
+
+// Item is one data description entry of a copybook.
+type Item struct {
+	Level int
+	// Name is upper case as written, FILLER for unnamed items.
+	Name string
+	// Line is the line of the copybook the entry starts on.
+	Line int
+
+	Picture      string
+	Usage        Usage
+	Occurs       int
+	DependingOn  string
+	Redefines    string
+	SignLeading  bool
+	SignSeparate bool
+
+	// Values holds the literals of an 88 level condition.
+	Values []string
+
+	Children   []*Item
+	Conditions []*Item
+}
+
+// IsGroup is true for items made up of others rather than a picture.
+func (item *Item) IsGroup() bool {
+	return item.Picture == "" && len(item.Children) > 0
+}
+
+// Parse reads the data description entries of a copybook into a tree under
+// each 01 level record. Both fixed format source, with sequence numbers and
+// an indicator column, and free format source are read.
+func Parse(r io.Reader) (*Layout, error) {
+	sentences, err := sentences(r)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := &Layout{}
+	var stack []*Item
+	var last *Item
+	for _, sentence := range sentences {
+		item, err := parseEntry(sentence.tokens)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", sentence.line, err)
+		}
+		if item == nil {
+			continue
+		}
+		item.Line = sentence.line
+
+		switch {
+		case item.Level == 88:
+			if last == nil {
+				return nil, fmt.Errorf("line %d: 88 level %s has no item", item.Line, item.Name)
+			}
+			last.Conditions = append(last.Conditions, item)
+			continue
+
+		case item.Level == 1 || item.Level == 77:
+			layout.Records = append(layout.Records, item)
+			stack = []*Item{item}
+
+		default:
+			for len(stack) > 0 && stack[len(stack)-1].Level >= item.Level {
+				stack = stack[:len(stack)-1]
+			}
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: level %02d %s is not under an 01 record", item.Line, item.Level, item.Name)
+			}
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, item)
+			stack = append(stack, item)
+		}
+		last = item
+	}
+	if len(layout.Records) == 0 {
+		return nil, errors.New("no 01 level records")
+	}
+	return layout, nil
+}
+
+type sentence struct {
+	line   int
+	tokens []string
+}
+
+// sentences strips the sequence and indicator areas of fixed format lines,
+// drops comments, and splits the rest into period terminated sentences of
+// tokens.
+func sentences(r io.Reader) ([]sentence, error) {
+	var out []sentence
+	var current sentence
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		line, ok := code(line)
+		if !ok {
+			continue
+		}
+
+		for _, token := range tokenize(line) {
+			if current.tokens == nil {
+				current.line = lineNumber
+			}
+			// A period inside a literal is followed by the closing quote, so
+			// a token ending in one always ends the sentence.
+			end := strings.HasSuffix(token, ".")
+			if end {
+				token = strings.TrimSuffix(token, ".")
+			}
+			if token != "" {
+				current.tokens = append(current.tokens, token)
+			}
+			if end {
+				if len(current.tokens) > 0 {
+					out = append(out, current)
+				}
+				current = sentence{}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(current.tokens) > 0 {
+		out = append(out, current)
+	}
+	return out, nil
+}
+
+// code returns the code of a line, without the sequence area of fixed
+// format source. ok is false for comments and blank lines.
+func code(line string) (string, bool) {
+	if len(line) > 6 && isSequenceArea(line[:6]) {
+		switch line[6] {
+		case '*', '/':
+			return "", false
+		}
+		line = line[7:]
+		if len(line) > 65 {
+			line = line[:65]
+		}
+	} else if strings.HasPrefix(strings.TrimSpace(line), "*") {
+		return "", false
+	}
+	if strings.TrimSpace(line) == "" {
+		return "", false
+	}
+	return line, true
+}
+
+func isSequenceArea(area string) bool {
+	for _, c := range area {
+		if c != ' ' && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenize splits on spaces, keeping quoted literals whole.
+func tokenize(line string) []string {
+	var tokens []string
+	var token strings.Builder
+	var quote rune
+	for _, c := range line {
+		switch {
+		case quote != 0:
+			token.WriteRune(c)
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			token.WriteRune(c)
+		case unicode.IsSpace(c) || c == ',' && token.Len() == 0:
+			if token.Len() > 0 {
+				tokens = append(tokens, token.String())
+				token.Reset()
+			}
+		default:
+			token.WriteRune(c)
+		}
+	}
+	if token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+	return tokens
+}
+
+func isQuoted(token string) bool {
+	return len(token) >= 2 && (token[0] == '\'' || token[0] == '"') && token[len(token)-1] == token[0]
+}
+
+var usageWords = map[string]Usage{
+	"DISPLAY":         UsageDisplay,
+	"COMP":            UsageBinary,
+	"COMPUTATIONAL":   UsageBinary,
+	"COMP-1":          UsageFloat,
+	"COMPUTATIONAL-1": UsageFloat,
+	"COMP-2":          UsageDouble,
+	"COMPUTATIONAL-2": UsageDouble,
+	"COMP-3":          UsagePacked,
+	"COMPUTATIONAL-3": UsagePacked,
+	"PACKED-DECIMAL":  UsagePacked,
+	"COMP-4":          UsageBinary,
+	"COMPUTATIONAL-4": UsageBinary,
+	"COMP-5":          UsageBinary,
+	"COMPUTATIONAL-5": UsageBinary,
+	"BINARY":          UsageBinary,
+}
+
+// clauseWords start the clauses of an entry, so that lists such as VALUE
+// literals and INDEXED BY names end at the next of them.
+var clauseWords = map[string]bool{
+	"PIC": true, "PICTURE": true, "USAGE": true, "OCCURS": true, "REDEFINES": true,
+	"VALUE": true, "VALUES": true, "SIGN": true, "LEADING": true, "TRAILING": true,
+	"SYNC": true, "SYNCHRONIZED": true, "JUST": true, "JUSTIFIED": true, "BLANK": true,
+	"INDEXED": true, "ASCENDING": true, "DESCENDING": true, "DEPENDING": true,
+	"EXTERNAL": true, "GLOBAL": true,
+}
+
+func isClauseWord(token string) bool {
+	_, usage := usageWords[token]
+	return usage || clauseWords[token]
+}
+
+// parseEntry reads one data description entry, returning nil for entries
+// with no storage of their own, such as 66 level RENAMES.
+func parseEntry(tokens []string) (*Item, error) {
+	level, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("expected a level number, got %q", tokens[0])
+	}
+	if level == 66 {
+		return nil, nil
+	}
+	if level < 1 || level > 49 && level != 77 && level != 88 {
+		return nil, fmt.Errorf("invalid level number %d", level)
+	}
+
+	item := &Item{Level: level, Name: "FILLER"}
+	rest := tokens[1:]
+	if len(rest) > 0 && !isClauseWord(strings.ToUpper(rest[0])) {
+		item.Name = strings.ToUpper(rest[0])
+		rest = rest[1:]
+	}
+
+	next := func() string {
+		if len(rest) == 0 {
+			return ""
+		}
+		token := rest[0]
+		rest = rest[1:]
+		return token
+	}
+	skip := func(words ...string) {
+		for len(rest) > 0 && slices.Contains(words, strings.ToUpper(rest[0])) {
+			rest = rest[1:]
+		}
+	}
+
+	for len(rest) > 0 {
+		word := strings.ToUpper(next())
+		if usage, ok := usageWords[word]; ok {
+			item.Usage = usage
+			continue
+		}
+		switch word {
+		case "PIC", "PICTURE":
+			skip("IS")
+			item.Picture = strings.ToUpper(next())
+		case "USAGE":
+			skip("IS")
+			usage, ok := usageWords[strings.ToUpper(next())]
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown usage", item.Name)
+			}
+			item.Usage = usage
+		case "OCCURS":
+			count, err := strconv.Atoi(next())
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid OCCURS count", item.Name)
+			}
+			item.Occurs = count
+			if len(rest) > 1 && strings.ToUpper(rest[0]) == "TO" {
+				rest = rest[1:]
+				if count, err = strconv.Atoi(next()); err != nil {
+					return nil, fmt.Errorf("%s: invalid OCCURS maximum", item.Name)
+				}
+				item.Occurs = count
+			}
+			skip("TIMES")
+		case "DEPENDING":
+			skip("ON")
+			item.DependingOn = strings.ToUpper(next())
+		case "INDEXED", "ASCENDING", "DESCENDING":
+			for len(rest) > 0 && !isClauseWord(strings.ToUpper(rest[0])) {
+				rest = rest[1:]
+			}
+		case "REDEFINES":
+			item.Redefines = strings.ToUpper(next())
+		case "VALUE", "VALUES":
+			skip("IS", "ARE")
+			for len(rest) > 0 && !isClauseWord(strings.ToUpper(rest[0])) {
+				item.Values = append(item.Values, literal(next()))
+			}
+		case "SIGN":
+			skip("IS")
+		case "LEADING", "TRAILING":
+			item.SignLeading = word == "LEADING"
+			if len(rest) > 0 && strings.EqualFold(rest[0], "SEPARATE") {
+				item.SignSeparate = true
+				rest = rest[1:]
+				skip("CHARACTER")
+			}
+		case "SYNC", "SYNCHRONIZED":
+			skip("LEFT", "RIGHT")
+		case "JUST", "JUSTIFIED":
+			skip("RIGHT")
+		case "BLANK":
+			skip("WHEN", "ZERO", "ZEROS", "ZEROES")
+		case "EXTERNAL", "GLOBAL":
+		default:
+			return nil, fmt.Errorf("%s: unexpected %q", item.Name, word)
+		}
+	}
+	return item, nil
+}
+
+// literal returns the text of a VALUE literal, with figurative constants as
+// a single character.
+func literal(token string) string {
+	if isQuoted(token) {
+		return token[1 : len(token)-1]
+	}
+	switch strings.ToUpper(token) {
+	case "SPACE", "SPACES":
+		return " "
+	case "ZERO", "ZEROS", "ZEROES":
+		return "0"
+	case "THRU", "THROUGH":
+		return "THRU"
+	}
+	return token
+}
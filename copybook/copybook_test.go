@@ -0,0 +1,109 @@
+package copybook
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const testCopybook = `
+      * Customer master record
+       01  CUSTOMER-RECORD.
+           05  CUST-TYPE           PIC X.
+               88  CUST-RETAIL     VALUE 'R'.
+               88  CUST-WHOLESALE  VALUE 'W'.
+           05  CUST-ID             PIC 9(8).
+           05  CUST-NAME           PIC X(20).
+           05  CUST-BALANCE        PIC S9(7)V99 COMP-3.
+           05  CUST-LIMIT          PIC S9(5)V99.
+           05  CUST-ADDR.
+               10  ADDR-LINE       PIC X(10) OCCURS 2 TIMES.
+               10  ADDR-ZIP        PIC 9(5).
+           05  CUST-ALT REDEFINES CUST-ADDR PIC X(25).
+           05  FILLER              PIC X(3).
+`
+
+func TestParse(t *testing.T) {
+	layout, err := Parse(strings.NewReader(testCopybook))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(layout.Records) != 1 {
+		t.Fatalf("got %d records, want 1", len(layout.Records))
+	}
+	record := layout.Records[0]
+	if record.Name != "CUSTOMER-RECORD" || record.Line != 3 || len(record.Children) != 8 {
+		t.Errorf("got record %s at line %d with %d children", record.Name, record.Line, len(record.Children))
+	}
+	custType := record.Children[0]
+	if len(custType.Conditions) != 2 || custType.Conditions[1].Values[0] != "W" {
+		t.Errorf("got conditions %v", custType.Conditions)
+	}
+	if balance := record.Children[3]; balance.Usage != UsagePacked {
+		t.Errorf("got usage %q for %s", balance.Usage, balance.Name)
+	}
+	if size, err := record.Size(); err != nil || size != 69 {
+		t.Errorf("got size %d, %v, want 69", size, err)
+	}
+
+	for _, tc := range []struct {
+		source string
+		err    string
+	}{
+		{"05 A PIC X.", "not under an 01 record"},
+		{"01 A. 05 B PIC X. 05 C USAGE IS FLOATING.", "C: unknown usage"},
+		{"* only a comment", "no 01 level records"},
+	} {
+		_, err := Parse(strings.NewReader(tc.source))
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: got error %v, want %q", tc.source, err, tc.err)
+		}
+	}
+}
+
+func TestStorage(t *testing.T) {
+	for _, tc := range []struct {
+		entry string
+		size  int
+	}{
+		{"05 A PIC X(12).", 12},
+		{"05 A PIC 9(5)V99.", 7},
+		{"05 A PIC S9(5)V99 SIGN LEADING SEPARATE.", 8},
+		{"05 A PIC S9(7)V99 COMP-3.", 5},
+		{"05 A PIC S9(4) USAGE IS COMPUTATIONAL.", 2},
+		{"05 A PIC 9(9) BINARY.", 4},
+		{"05 A PIC 9(10) COMP-5.", 8},
+		{"05 A PIC ZZ,ZZ9.99-.", 10},
+	} {
+		sentences, err := sentences(strings.NewReader(tc.entry))
+		if err != nil {
+			t.Fatal(err)
+		}
+		item, err := parseEntry(sentences[0].tokens)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.entry, err)
+		}
+		if size, err := item.Size(); err != nil || size != tc.size {
+			t.Errorf("%s: got %d, %v, want %d", tc.entry, size, err, tc.size)
+		}
+	}
+}
+
+func TestCode(t *testing.T) {
+	for _, tc := range []struct {
+		line string
+		code string
+		ok   bool
+	}{
+		{fmt.Sprintf("%-72sSEQ00001", "000100 01  REC."), "01  REC.", true},
+		{"000200*    A COMMENT", "", false},
+		{"      /", "", false},
+		{"01 REC.", "01 REC.", true},
+		{"* free format comment", "", false},
+	} {
+		code, ok := code(tc.line)
+		if ok != tc.ok || strings.TrimRight(code, " ") != tc.code {
+			t.Errorf("%q: got %q, %v", tc.line, code, ok)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package copybook
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Picture is the meaning of a PIC string.
+type Picture struct {
+	Numeric bool
+	Signed  bool
+	// Digits counts the digits of numeric pictures.
+	Digits int
+	// Scale counts the digits after the implied decimal point.
+	Scale int
+	// Length counts display characters, including any edit characters.
+	Length int
+	// Edited pictures, such as ZZ9.99, are read as text.
+	Edited bool
+}
+
+// ParsePicture reads a PIC string such as S9(7)V99 or X(20).
+func ParsePicture(pic string) (Picture, error) {
+	var out Picture
+	var expanded []byte
+	for idx := 0; idx < len(pic); idx++ {
+		c := pic[idx]
+		count := 1
+		if idx+1 < len(pic) && pic[idx+1] == '(' {
+			end := strings.IndexByte(pic[idx:], ')')
+			if end < 0 {
+				return out, fmt.Errorf("unclosed repeat in PIC %s", pic)
+			}
+			n, err := strconv.Atoi(pic[idx+2 : idx+end])
+			if err != nil || n < 1 {
+				return out, fmt.Errorf("invalid repeat in PIC %s", pic)
+			}
+			count = n
+			idx += end
+		}
+		for range count {
+			expanded = append(expanded, c)
+		}
+	}
+
+	afterPoint := false
+	out.Numeric = true
+	for _, c := range expanded {
+		switch c {
+		case '9':
+			out.Digits++
+			out.Length++
+			if afterPoint {
+				out.Scale++
+			}
+		case 'S':
+			out.Signed = true
+		case 'V':
+			afterPoint = true
+		case 'P':
+			// Scaling positions hold no storage.
+		case 'X', 'A':
+			out.Numeric = false
+			out.Length++
+		case 'Z', '*', '.', ',', '+', '-', 'B', '0', '/', '$':
+			out.Edited = true
+			out.Length++
+		case 'C', 'D', 'R':
+			// CR and DB edit symbols.
+			out.Edited = true
+			out.Length++
+		default:
+			return out, fmt.Errorf("unsupported character %q in PIC %s", c, pic)
+		}
+	}
+	if out.Edited {
+		out.Numeric = false
+	}
+	return out, nil
+}
+
+// Storage returns the picture of an elementary item and the bytes it takes
+// in the record.
+func (item *Item) Storage() (Picture, int, error) {
+	pic, err := ParsePicture(item.Picture)
+	if err != nil {
+		return pic, 0, err
+	}
+	if !pic.Numeric {
+		return pic, pic.Length, nil
+	}
+	switch item.Usage {
+	case UsagePacked:
+		return pic, pic.Digits/2 + 1, nil
+	case UsageBinary:
+		switch {
+		case pic.Digits <= 4:
+			return pic, 2, nil
+		case pic.Digits <= 9:
+			return pic, 4, nil
+		default:
+			return pic, 8, nil
+		}
+	case UsageFloat:
+		return pic, 4, nil
+	case UsageDouble:
+		return pic, 8, nil
+	}
+	if pic.Signed && item.SignSeparate {
+		return pic, pic.Digits + 1, nil
+	}
+	return pic, pic.Digits, nil
+}
+
+// Size returns the bytes of one occurrence of the item.
+func (item *Item) Size() (int, error) {
+	if item.Picture == "" {
+		if item.Usage == UsageFloat {
+			return 4, nil
+		}
+		if item.Usage == UsageDouble {
+			return 8, nil
+		}
+		total := 0
+		for _, child := range item.Children {
+			if child.Redefines != "" {
+				continue
+			}
+			size, err := child.Size()
+			if err != nil {
+				return 0, err
+			}
+			total += size * max(child.Occurs, 1)
+		}
+		return total, nil
+	}
+	_, size, err := item.Storage()
+	return size, err
+}
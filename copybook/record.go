@@ -0,0 +1,274 @@
+package copybook
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Record is a record of the layout flattened into fields at fixed offsets:
+// groups are expanded into their elementary items and OCCURS into numbered
+// fields.
+type Record struct {
+	Item *Item
+	// Length is the bytes of the record, 0 when the size of an item is not
+	// known.
+	Length int
+	Fields []*Field
+	Filler []*flatfile_pb.FixedWidth
+}
+
+// Field is an elementary item at its place in a record. Items which cannot
+// be mapped, such as REDEFINES and floating point, are kept as fields with
+// no Options and a note of why, so that they can be reviewed.
+type Field struct {
+	Item *Item
+	// Name is a lower snake case identifier, unique in the record, numbered
+	// by occurrence for items under an OCCURS.
+	Name   string
+	Offset int
+	Length int
+
+	// Kind is the proto kind the item is read as. Alphanumeric items with
+	// single value 88 level conditions are enums keyed by the values, and
+	// numbers with implied decimal places are j5 decimal messages.
+	Kind    protoreflect.Kind
+	Options *flatfile_pb.Field
+
+	// Notes explain how the item is read, where it may not be as expected.
+	Notes []string
+}
+
+// Flatten lays out the fields of each record.
+func (l *Layout) Flatten() []*Record {
+	records := make([]*Record, 0, len(l.Records))
+	for _, item := range l.Records {
+		records = append(records, flattenRecord(item))
+	}
+	return records
+}
+
+type flattener struct {
+	record *Record
+	names  map[string]int
+	// offsets of named items, for REDEFINES notes.
+	offsets map[string]int
+}
+
+func flattenRecord(item *Item) *Record {
+	f := &flattener{
+		record:  &Record{Item: item},
+		names:   map[string]int{},
+		offsets: map[string]int{},
+	}
+	if size, err := item.Size(); err == nil {
+		f.record.Length = size
+	}
+
+	if item.Picture != "" || item.Usage != UsageDisplay {
+		// A 01 or 77 level elementary item is a record of one field.
+		f.item(item, 0, "")
+	} else {
+		offset := 0
+		for _, child := range item.Children {
+			offset = f.child(child, offset, "")
+		}
+	}
+	return f.record
+}
+
+// note adds an item which is not mapped to a field.
+func (f *flattener) note(item *Item, offset, length int, format string, args ...any) {
+	f.record.Fields = append(f.record.Fields, &Field{
+		Item:   item,
+		Offset: offset,
+		Length: length,
+		Notes:  []string{fmt.Sprintf(format, args...)},
+	})
+}
+
+// child lays out an item of a group from offset, returning the offset after
+// it.
+func (f *flattener) child(item *Item, offset int, suffix string) int {
+	size, err := item.Size()
+	if err != nil {
+		f.note(item, offset, 0, "%s: %s", item.Name, err)
+		return offset
+	}
+
+	if item.Redefines != "" {
+		at, ok := f.offsets[item.Redefines]
+		if ok {
+			f.note(item, at, size, "%s REDEFINES %s at offset %d, length %d, is not mapped.", item.Name, item.Redefines, at, size)
+		} else {
+			f.note(item, offset, size, "%s REDEFINES %s, length %d, is not mapped.", item.Name, item.Redefines, size)
+		}
+		return offset
+	}
+	f.offsets[item.Name] = offset
+
+	if item.DependingOn != "" {
+		f.note(item, offset, size*item.Occurs, "%s OCCURS DEPENDING ON %s, mapped at its maximum of %d.", item.Name, item.DependingOn, item.Occurs)
+	}
+
+	occurs := max(item.Occurs, 1)
+	for n := range occurs {
+		occurrence := suffix
+		if item.Occurs > 0 {
+			occurrence = fmt.Sprintf("%s_%d", suffix, n+1)
+		}
+		if item.IsGroup() {
+			childOffset := offset
+			for _, child := range item.Children {
+				childOffset = f.child(child, childOffset, occurrence)
+			}
+		} else {
+			f.item(item, offset, occurrence)
+		}
+		offset += size
+	}
+	return offset
+}
+
+// item lays out the field for an elementary item.
+func (f *flattener) item(item *Item, offset int, suffix string) {
+	pic, size, err := item.Storage()
+	if err != nil {
+		f.note(item, offset, 0, "%s: %s", item.Name, err)
+		return
+	}
+	if size == 0 {
+		f.note(item, offset, 0, "%s at offset %d has no PIC or storage.", item.Name, offset)
+		return
+	}
+	if item.Name == "FILLER" {
+		f.record.Filler = append(f.record.Filler, &flatfile_pb.FixedWidth{
+			Offset: uint32(offset),
+			Length: uint32(size),
+		})
+		return
+	}
+	if item.Usage == UsageFloat || item.Usage == UsageDouble {
+		f.note(item, offset, size, "%s at offset %d, length %d: floating point %s is not supported.", item.Name, offset, size, item.Usage)
+		return
+	}
+
+	name := fieldName(item.Name) + suffix
+	if count := f.names[name]; count > 0 {
+		f.names[name]++
+		name = fmt.Sprintf("%s_%d", name, count+1)
+	} else {
+		f.names[name] = 1
+	}
+
+	field := &Field{
+		Item:   item,
+		Name:   name,
+		Offset: offset,
+		Length: size,
+		Options: &flatfile_pb.Field{
+			FixedWidth: &flatfile_pb.FixedWidth{
+				Offset: uint32(offset),
+				Length: uint32(size),
+			},
+		},
+	}
+
+	if !pic.Numeric {
+		field.Kind = protoreflect.StringKind
+		field.Options.FieldType = &flatfile_pb.Field_String_{String_: &flatfile_pb.StringField{
+			Trim: flatfile_pb.Trim_TRIM_RIGHT,
+		}}
+		if hasConditionEnum(item, size) {
+			field.Kind = protoreflect.EnumKind
+			field.Options.FieldType = &flatfile_pb.Field_Enum{Enum: &flatfile_pb.EnumField{
+				Trim: flatfile_pb.Trim_TRIM_RIGHT,
+			}}
+		}
+		if pic.Edited {
+			field.Notes = append(field.Notes, fmt.Sprintf("PIC %s is edited, and read as text.", item.Picture))
+		}
+		f.record.Fields = append(f.record.Fields, field)
+		return
+	}
+
+	number := &flatfile_pb.NumberField{}
+	switch {
+	case item.Usage == UsagePacked:
+		number.Encoding = flatfile_pb.Encoding_ENCODING_PACKED_DECIMAL
+	case item.Usage == UsageBinary:
+		number.Encoding = flatfile_pb.Encoding_ENCODING_BINARY
+		if pic.Signed {
+			field.Notes = append(field.Notes, fmt.Sprintf("PIC %s is signed binary, which is read as unsigned.", item.Picture))
+		}
+	case pic.Signed && !item.SignSeparate:
+		if item.SignLeading {
+			field.Notes = append(field.Notes, fmt.Sprintf("PIC %s has a leading overpunch sign, which is read as trailing.", item.Picture))
+		}
+		number.Encoding = flatfile_pb.Encoding_ENCODING_OVERPUNCH
+	}
+	if pic.Scale > 0 {
+		field.Kind = protoreflect.MessageKind
+		number.FixedScale = int32(pic.Scale)
+	} else {
+		switch {
+		case pic.Signed && pic.Digits <= 9:
+			field.Kind = protoreflect.Int32Kind
+		case pic.Signed:
+			field.Kind = protoreflect.Int64Kind
+		case pic.Digits <= 9:
+			field.Kind = protoreflect.Uint32Kind
+		default:
+			field.Kind = protoreflect.Uint64Kind
+		}
+	}
+	field.Options.FieldType = &flatfile_pb.Field_Number{Number: number}
+	f.record.Fields = append(f.record.Fields, field)
+}
+
+// hasConditionEnum is true when every 88 level condition of an alphanumeric
+// item is a single value which fits it, so they can key an enum.
+func hasConditionEnum(item *Item, size int) bool {
+	if len(item.Conditions) == 0 {
+		return false
+	}
+	for _, condition := range item.Conditions {
+		if len(condition.Values) != 1 || len(condition.Values[0]) > size {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldName turns a COBOL name into a lower snake case identifier.
+func fieldName(name string) string {
+	var sb strings.Builder
+	for _, c := range strings.ToLower(name) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			sb.WriteRune(c)
+		default:
+			sb.WriteByte('_')
+		}
+	}
+	out := strings.Trim(sb.String(), "_")
+	if out == "" || out[0] >= '0' && out[0] <= '9' {
+		out = "f_" + out
+	}
+	return out
+}
+
+// typeName turns a COBOL name into an upper camel case type name.
+func typeName(name string) string {
+	var sb strings.Builder
+	for part := range strings.SplitSeq(fieldName(name), "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
@@ -0,0 +1,548 @@
+// Package bai2 reads BAI2 cash management balance reports into the record
+// messages of flatfile.bai2.v1, joining 88 continuation records and checking
+// the control totals and record counts of the account, group and file
+// trailers.
+//
+// BAI2 records are comma delimited, ending with a "/", rather than fixed
+// width, so they are split here rather than by binfile.
+package bai2
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/flatfile/gen/flatfile/bai2/v1/bai2_pb"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"github.com/pentops/j5/j5types/date_j5t"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrStructure is wrapped by errors for records out of order, such as a
+// transaction outside an account.
+var ErrStructure = errors.New("invalid BAI2 file structure")
+
+// File is a BAI2 file: a header, groups of accounts, and a trailer.
+type File struct {
+	Header  *bai2_pb.FileHeader
+	Groups  []*Group
+	Trailer *bai2_pb.FileTrailer
+
+	records uint32
+}
+
+// Group is the accounts of a group between its header and trailer.
+type Group struct {
+	Header   *bai2_pb.GroupHeader
+	Accounts []*Account
+	Trailer  *bai2_pb.GroupTrailer
+
+	records uint32
+}
+
+// Account is an account identifier record with its transactions.
+type Account struct {
+	Identifier   *bai2_pb.AccountIdentifier
+	Transactions []*bai2_pb.TransactionDetail
+	Trailer      *bai2_pb.AccountTrailer
+
+	records uint32
+}
+
+// IsStatus reports whether a type code is a status code, such as 010 for
+// the opening ledger balance, rather than a summary or transaction.
+func IsStatus(typeCode string) bool {
+	code, ok := parseTypeCode(typeCode)
+	return ok && code < 100
+}
+
+// IsCredit reports whether a type code is a credit summary or transaction,
+// 100 to 399.
+func IsCredit(typeCode string) bool {
+	code, ok := parseTypeCode(typeCode)
+	return ok && code >= 100 && code < 400
+}
+
+// IsDebit reports whether a type code is a debit summary or transaction,
+// 400 to 699. Loan codes, 700 to 799, and the bank defined 900 to 999 are
+// neither credits nor debits.
+func IsDebit(typeCode string) bool {
+	code, ok := parseTypeCode(typeCode)
+	return ok && code >= 400 && code < 700
+}
+
+func parseTypeCode(typeCode string) (int, bool) {
+	if len(typeCode) != 3 {
+		return 0, false
+	}
+	code, err := strconv.Atoi(typeCode)
+	return code, err == nil && code > 0
+}
+
+// fundsTypes maps the funds type keys of the flatfile.v1.enum annotations to
+// their values.
+var fundsTypes = func() map[string]bai2_pb.FundsType {
+	types := map[string]bai2_pb.FundsType{}
+	values := bai2_pb.FundsType(0).Descriptor().Values()
+	for idx := range values.Len() {
+		value := values.Get(idx)
+		if ext, ok := proto.GetExtension(value.Options(), flatfile_pb.E_Enum).(*flatfile_pb.Enum); ok && ext.GetKey() != "" {
+			types[ext.Key] = bai2_pb.FundsType(value.Number())
+		}
+	}
+	return types
+}()
+
+// logical is a record with its continuations joined.
+type logical struct {
+	data      string
+	line      int
+	physicals uint32
+}
+
+// Read parses a BAI2 file. Trailing spaces, which pad records to a fixed
+// physical length, and blank lines are ignored. Records out of order or
+// with malformed fields are an error; Validate checks the trailers.
+func Read(r io.Reader) (*File, error) {
+	scanner := bufio.NewScanner(r)
+	var records []*logical
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := strings.TrimRight(scanner.Text(), " \r")
+		if data == "" {
+			continue
+		}
+		if continuation, ok := strings.CutPrefix(data, "88,"); ok {
+			if len(records) == 0 {
+				return nil, fmt.Errorf("line %d: %w: continuation without a record", line, ErrStructure)
+			}
+			// The continuation begins with the next field of the record,
+			// whose last field may end with a "/" or a comma.
+			last := records[len(records)-1]
+			if data, ok := strings.CutSuffix(last.data, "/"); ok {
+				last.data = data + ","
+			} else if !strings.HasSuffix(last.data, ",") {
+				last.data += ","
+			}
+			last.data += continuation
+			last.physicals++
+			continue
+		}
+		records = append(records, &logical{data: data, line: line, physicals: 1})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	file := &File{}
+	var group *Group
+	var account *Account
+	for _, record := range records {
+		code, data, _ := strings.Cut(record.data, ",")
+		f := &fields{rest: data}
+		if file.Trailer != nil {
+			return nil, fmt.Errorf("line %d: %w: record after the file trailer", record.line, ErrStructure)
+		}
+		if file.Header == nil && code != "01" {
+			return nil, fmt.Errorf("line %d: %w: the first record is not a file header", record.line, ErrStructure)
+		}
+
+		var err error
+		switch code {
+		case "01":
+			if file.Header != nil {
+				return nil, fmt.Errorf("line %d: %w: second file header", record.line, ErrStructure)
+			}
+			file.Header, err = f.fileHeader()
+			file.records += record.physicals
+		case "02":
+			if group != nil {
+				return nil, fmt.Errorf("line %d: %w: group header before the trailer of the previous group", record.line, ErrStructure)
+			}
+			group = &Group{records: record.physicals}
+			group.Header, err = f.groupHeader()
+		case "03":
+			if group == nil || account != nil {
+				return nil, fmt.Errorf("line %d: %w: account identifier outside a group or inside an account", record.line, ErrStructure)
+			}
+			account = &Account{records: record.physicals}
+			account.Identifier, err = f.accountIdentifier()
+		case "16":
+			if account == nil {
+				return nil, fmt.Errorf("line %d: %w: transaction detail outside an account", record.line, ErrStructure)
+			}
+			var detail *bai2_pb.TransactionDetail
+			detail, err = f.transactionDetail()
+			account.Transactions = append(account.Transactions, detail)
+			account.records += record.physicals
+		case "49":
+			if account == nil {
+				return nil, fmt.Errorf("line %d: %w: account trailer without an account identifier", record.line, ErrStructure)
+			}
+			account.Trailer, err = f.accountTrailer()
+			account.records += record.physicals
+			group.Accounts = append(group.Accounts, account)
+			group.records += account.records
+			account = nil
+		case "98":
+			if group == nil || account != nil {
+				return nil, fmt.Errorf("line %d: %w: group trailer without a group header or inside an account", record.line, ErrStructure)
+			}
+			group.Trailer, err = f.groupTrailer()
+			group.records += record.physicals
+			file.Groups = append(file.Groups, group)
+			file.records += group.records
+			group = nil
+		case "99":
+			if group != nil {
+				return nil, fmt.Errorf("line %d: %w: file trailer before the trailer of a group", record.line, ErrStructure)
+			}
+			file.Trailer, err = f.fileTrailer()
+			file.records += record.physicals
+		default:
+			return nil, fmt.Errorf("line %d: %w: unknown record code %q", record.line, ErrStructure, code)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: record %s: %w", record.line, code, err)
+		}
+	}
+	if file.Trailer == nil {
+		return nil, fmt.Errorf("%w: no file trailer", ErrStructure)
+	}
+	return file, nil
+}
+
+// fields splits the fields of a record after its record code. A "/" ends
+// the record, and any fields not given are empty.
+type fields struct {
+	rest  string
+	ended bool
+}
+
+func (f *fields) next() string {
+	if f.ended {
+		return ""
+	}
+	idx := strings.IndexAny(f.rest, ",/")
+	if idx < 0 {
+		value := f.rest
+		f.rest, f.ended = "", true
+		return strings.TrimSpace(value)
+	}
+	value := f.rest[:idx]
+	f.ended = f.rest[idx] == '/'
+	f.rest = f.rest[idx+1:]
+	return strings.TrimSpace(value)
+}
+
+// text is the remainder of the record, which may contain commas and
+// slashes, less a final "/".
+func (f *fields) text() string {
+	if f.ended {
+		return ""
+	}
+	f.ended = true
+	return strings.TrimSuffix(f.rest, "/")
+}
+
+func (f *fields) amount(name string) (int64, error) {
+	value := f.next()
+	if value == "" {
+		return 0, nil
+	}
+	if strings.TrimLeft(value, "+-0123456789") != "" || strings.ContainsAny(value[1:], "+-") {
+		return 0, fmt.Errorf("%s: %q is not an amount", name, value)
+	}
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", name, err)
+	}
+	return amount, nil
+}
+
+func (f *fields) count(name string) (uint32, error) {
+	value := f.next()
+	if value == "" {
+		return 0, nil
+	}
+	count, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %q is not a number", name, value)
+	}
+	return uint32(count), nil
+}
+
+// date reads a YYMMDD date, in this century.
+func (f *fields) date(name string) (*date_j5t.Date, error) {
+	value := f.next()
+	if value == "" {
+		return nil, nil
+	}
+	if len(value) != 6 || strings.Trim(value, "0123456789") != "" {
+		return nil, fmt.Errorf("%s: %q is not a YYMMDD date", name, value)
+	}
+	year, _ := strconv.Atoi(value[0:2])
+	month, _ := strconv.Atoi(value[2:4])
+	day, _ := strconv.Atoi(value[4:6])
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return nil, fmt.Errorf("%s: %q is not a YYMMDD date", name, value)
+	}
+	return &date_j5t.Date{Year: int32(2000 + year), Month: int32(month), Day: int32(day)}, nil
+}
+
+func (f *fields) typeCode() (string, error) {
+	code := f.next()
+	if _, ok := parseTypeCode(code); !ok {
+		return "", fmt.Errorf("type code: %q is not three digits", code)
+	}
+	return code, nil
+}
+
+// funds reads a funds type and the fields which follow it: a value date
+// and time for V, three availability amounts for S, and a count of day and
+// amount pairs for D.
+func (f *fields) funds() (*bai2_pb.Funds, error) {
+	key := f.next()
+	if key == "" {
+		return nil, nil
+	}
+	fundsType, ok := fundsTypes[key]
+	if !ok {
+		return nil, fmt.Errorf("funds type: unknown key %q", key)
+	}
+	funds := &bai2_pb.Funds{Type: fundsType}
+	var err error
+	switch fundsType {
+	case bai2_pb.FundsType_VALUE_DATED:
+		if funds.ValueDate, err = f.date("value date"); err != nil {
+			return nil, err
+		}
+		funds.ValueTime = f.next()
+	case bai2_pb.FundsType_AVAILABILITY:
+		if funds.ImmediateAmount, err = f.amount("immediate availability"); err != nil {
+			return nil, err
+		}
+		if funds.OneDayAmount, err = f.amount("one day availability"); err != nil {
+			return nil, err
+		}
+		if funds.TwoOrMoreDayAmount, err = f.amount("two or more day availability"); err != nil {
+			return nil, err
+		}
+	case bai2_pb.FundsType_DISTRIBUTED:
+		count, err := f.count("number of distributions")
+		if err != nil {
+			return nil, err
+		}
+		for range count {
+			distribution := &bai2_pb.Distribution{}
+			if distribution.Days, err = f.count("availability days"); err != nil {
+				return nil, err
+			}
+			if distribution.Amount, err = f.amount("available amount"); err != nil {
+				return nil, err
+			}
+			funds.Distributions = append(funds.Distributions, distribution)
+		}
+	}
+	return funds, nil
+}
+
+func (f *fields) fileHeader() (*bai2_pb.FileHeader, error) {
+	header := &bai2_pb.FileHeader{
+		SenderIdentification:   f.next(),
+		ReceiverIdentification: f.next(),
+	}
+	var err error
+	if header.FileCreationDate, err = f.date("file creation date"); err != nil {
+		return nil, err
+	}
+	header.FileCreationTime = f.next()
+	header.FileIdentificationNumber = f.next()
+	if header.PhysicalRecordLength, err = f.count("physical record length"); err != nil {
+		return nil, err
+	}
+	if header.BlockSize, err = f.count("block size"); err != nil {
+		return nil, err
+	}
+	if header.VersionNumber, err = f.count("version number"); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func (f *fields) groupHeader() (*bai2_pb.GroupHeader, error) {
+	header := &bai2_pb.GroupHeader{
+		UltimateReceiverIdentification: f.next(),
+		OriginatorIdentification:       f.next(),
+	}
+	var err error
+	if header.GroupStatus, err = f.count("group status"); err != nil {
+		return nil, err
+	}
+	if header.AsOfDate, err = f.date("as of date"); err != nil {
+		return nil, err
+	}
+	header.AsOfTime = f.next()
+	header.CurrencyCode = f.next()
+	if header.AsOfDateModifier, err = f.count("as of date modifier"); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func (f *fields) accountIdentifier() (*bai2_pb.AccountIdentifier, error) {
+	identifier := &bai2_pb.AccountIdentifier{
+		CustomerAccountNumber: f.next(),
+		CurrencyCode:          f.next(),
+	}
+	// Each summary is a type code, amount, item count and funds type, the
+	// last two empty for status codes.
+	for !f.ended {
+		if strings.TrimSpace(f.rest) == "" || strings.TrimSpace(f.rest) == "/" {
+			break
+		}
+		summary := &bai2_pb.Summary{}
+		var err error
+		if summary.TypeCode, err = f.typeCode(); err != nil {
+			return nil, err
+		}
+		if summary.Amount, err = f.amount("amount"); err != nil {
+			return nil, err
+		}
+		if summary.ItemCount, err = f.count("item count"); err != nil {
+			return nil, err
+		}
+		if summary.Funds, err = f.funds(); err != nil {
+			return nil, err
+		}
+		identifier.Summaries = append(identifier.Summaries, summary)
+	}
+	return identifier, nil
+}
+
+func (f *fields) transactionDetail() (*bai2_pb.TransactionDetail, error) {
+	detail := &bai2_pb.TransactionDetail{}
+	var err error
+	if detail.TypeCode, err = f.typeCode(); err != nil {
+		return nil, err
+	}
+	if detail.Amount, err = f.amount("amount"); err != nil {
+		return nil, err
+	}
+	if detail.Funds, err = f.funds(); err != nil {
+		return nil, err
+	}
+	detail.BankReferenceNumber = f.next()
+	detail.CustomerReferenceNumber = f.next()
+	detail.Text = f.text()
+	return detail, nil
+}
+
+func (f *fields) accountTrailer() (*bai2_pb.AccountTrailer, error) {
+	trailer := &bai2_pb.AccountTrailer{}
+	var err error
+	if trailer.AccountControlTotal, err = f.amount("account control total"); err != nil {
+		return nil, err
+	}
+	if trailer.NumberOfRecords, err = f.count("number of records"); err != nil {
+		return nil, err
+	}
+	return trailer, nil
+}
+
+func (f *fields) groupTrailer() (*bai2_pb.GroupTrailer, error) {
+	trailer := &bai2_pb.GroupTrailer{}
+	var err error
+	if trailer.GroupControlTotal, err = f.amount("group control total"); err != nil {
+		return nil, err
+	}
+	if trailer.NumberOfAccounts, err = f.count("number of accounts"); err != nil {
+		return nil, err
+	}
+	if trailer.NumberOfRecords, err = f.count("number of records"); err != nil {
+		return nil, err
+	}
+	return trailer, nil
+}
+
+func (f *fields) fileTrailer() (*bai2_pb.FileTrailer, error) {
+	trailer := &bai2_pb.FileTrailer{}
+	var err error
+	if trailer.FileControlTotal, err = f.amount("file control total"); err != nil {
+		return nil, err
+	}
+	if trailer.NumberOfGroups, err = f.count("number of groups"); err != nil {
+		return nil, err
+	}
+	if trailer.NumberOfRecords, err = f.count("number of records"); err != nil {
+		return nil, err
+	}
+	return trailer, nil
+}
+
+// Validate checks the trailers against the records they cover, and the
+// type codes of transactions, returning every mismatch. Record counts
+// include continuation records, so are only checked for files from Read.
+func (f *File) Validate() error {
+	var errs []error
+	if f.Header.VersionNumber != 2 {
+		errs = append(errs, fmt.Errorf("file: version number is %d, not 2", f.Header.VersionNumber))
+	}
+
+	var fileTotal int64
+	for groupIdx, group := range f.Groups {
+		groupName := fmt.Sprintf("group %d", groupIdx+1)
+		var groupTotal int64
+		for _, account := range group.Accounts {
+			name := fmt.Sprintf("%s: account %s", groupName, account.Identifier.CustomerAccountNumber)
+			var total int64
+			for _, summary := range account.Identifier.Summaries {
+				total += summary.Amount
+			}
+			for _, detail := range account.Transactions {
+				total += detail.Amount
+				if IsStatus(detail.TypeCode) {
+					errs = append(errs, fmt.Errorf("%s: transaction with status type code %s", name, detail.TypeCode))
+				}
+			}
+			if got := account.Trailer.AccountControlTotal; got != total {
+				errs = append(errs, fmt.Errorf("%s: account control total is %d, records total %d", name, got, total))
+			}
+			errs = append(errs, checkRecords(name, account.Trailer.NumberOfRecords, account.records)...)
+			groupTotal += account.Trailer.AccountControlTotal
+		}
+
+		trailer := group.Trailer
+		if trailer.GroupControlTotal != groupTotal {
+			errs = append(errs, fmt.Errorf("%s: group control total is %d, accounts total %d", groupName, trailer.GroupControlTotal, groupTotal))
+		}
+		if got := uint32(len(group.Accounts)); trailer.NumberOfAccounts != got {
+			errs = append(errs, fmt.Errorf("%s: number of accounts is %d, group has %d", groupName, trailer.NumberOfAccounts, got))
+		}
+		errs = append(errs, checkRecords(groupName, trailer.NumberOfRecords, group.records)...)
+		fileTotal += trailer.GroupControlTotal
+	}
+
+	trailer := f.Trailer
+	if trailer.FileControlTotal != fileTotal {
+		errs = append(errs, fmt.Errorf("file: file control total is %d, groups total %d", trailer.FileControlTotal, fileTotal))
+	}
+	if got := uint32(len(f.Groups)); trailer.NumberOfGroups != got {
+		errs = append(errs, fmt.Errorf("file: number of groups is %d, file has %d", trailer.NumberOfGroups, got))
+	}
+	errs = append(errs, checkRecords("file", trailer.NumberOfRecords, f.records)...)
+	return errors.Join(errs...)
+}
+
+// checkRecords compares a record count to the records read, which are zero
+// when the file was not read.
+func checkRecords(name string, count, records uint32) []error {
+	if records == 0 || count == records {
+		return nil
+	}
+	return []error{fmt.Errorf("%s: number of records is %d, read %d", name, count, records)}
+}
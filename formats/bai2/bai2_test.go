@@ -0,0 +1,141 @@
+package bai2
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/gen/flatfile/bai2/v1/bai2_pb"
+	"github.com/pentops/j5/j5types/date_j5t"
+	"google.golang.org/protobuf/proto"
+)
+
+const testFile = `01,BANKID,CUSTID,240131,0800,1,80,10,2/
+02,CUSTID,BANKID,1,240130,2400,USD,2/
+03,123456789,USD,010,500000,,,015,-450000,,/
+88,100,75000,2,Z,400,125000,3,Z/
+16,165,50000,Z,REF1,CUST1,DEPOSIT/
+16,175,25000,V,240131,0900,REF2,,LOCKBOX/
+16,475,100000,S,50000,30000,20000,REF3,CUST3,CHECK PAID, NO 1234
+88,MORE/TEXT
+16,495,25000,D,2,1,15000,2,10000,REF4,,WIRE OUT/
+16,699,5000,0/
+49,455000,9/
+03,987654321,,010,100,,/
+49,100,2/
+98,455100,2,13/
+99,455100,1,15/
+`
+
+func TestRead(t *testing.T) {
+	file, err := Read(strings.NewReader(testFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !proto.Equal(file.Groups[0].Header.AsOfDate, &date_j5t.Date{Year: 2024, Month: 1, Day: 30}) {
+		t.Errorf("got as of date %v", file.Groups[0].Header.AsOfDate)
+	}
+
+	account := file.Groups[0].Accounts[0]
+	summaries := account.Identifier.Summaries
+	if len(summaries) != 4 {
+		t.Fatalf("got %d summaries, want 4 across the continuation", len(summaries))
+	}
+	if got := summaries[1]; got.TypeCode != "015" || got.Amount != -450000 || got.Funds != nil {
+		t.Errorf("got status %v", got)
+	}
+	if got := summaries[3]; got.TypeCode != "400" || got.ItemCount != 3 || got.Funds.Type != bai2_pb.FundsType_UNKNOWN {
+		t.Errorf("got summary %v", got)
+	}
+
+	details := account.Transactions
+	if len(details) != 5 {
+		t.Fatalf("got %d transactions", len(details))
+	}
+	if got := details[1].Funds; got.Type != bai2_pb.FundsType_VALUE_DATED || got.ValueDate.Day != 31 || got.ValueTime != "0900" || details[1].Text != "LOCKBOX" {
+		t.Errorf("got value dated transaction %v", details[1])
+	}
+	if got := details[2]; got.Funds.TwoOrMoreDayAmount != 20000 || got.CustomerReferenceNumber != "CUST3" || got.Text != "CHECK PAID, NO 1234,MORE/TEXT" {
+		t.Errorf("got availability transaction %v", got)
+	}
+	if got := details[3].Funds.Distributions; len(got) != 2 || got[1].Days != 2 || got[1].Amount != 10000 {
+		t.Errorf("got distributions %v", got)
+	}
+	if got := details[4]; got.Funds.Type != bai2_pb.FundsType_IMMEDIATE || got.BankReferenceNumber != "" || got.Text != "" {
+		t.Errorf("got immediate transaction %v", got)
+	}
+	if !IsCredit(details[1].TypeCode) || !IsDebit(details[2].TypeCode) || IsStatus(details[0].TypeCode) || !IsStatus(summaries[0].TypeCode) {
+		t.Error("type code classes")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		replace [2]string
+		want    string
+	}{{
+		name:    "account total",
+		replace: [2]string{"49,455000,9/", "49,455001,9/"},
+		want:    "group 1: account 123456789: account control total is 455001, records total 455000",
+	}, {
+		name:    "account records",
+		replace: [2]string{"49,455000,9/", "49,455000,7/"},
+		want:    "group 1: account 123456789: number of records is 7, read 9",
+	}, {
+		name:    "group accounts",
+		replace: [2]string{"98,455100,2,13/", "98,455100,3,13/"},
+		want:    "group 1: number of accounts is 3, group has 2",
+	}, {
+		name:    "file total",
+		replace: [2]string{"99,455100,1,15/", "99,0,1,15/"},
+		want:    "file: file control total is 0, groups total 455100",
+	}, {
+		name:    "status transaction",
+		replace: [2]string{"16,699,5000,0/", "16,010,5000,0/"},
+		want:    "transaction with status type code 010",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := Read(strings.NewReader(strings.Replace(testFile, tc.replace[0], tc.replace[1], 1)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := file.Validate(); err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("error %v does not contain %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	lines := strings.Split(strings.TrimSuffix(testFile, "\n"), "\n")
+	for name, records := range map[string][]string{
+		"no file header":         lines[1:],
+		"no file trailer":        lines[:len(lines)-1],
+		"transaction outside":    {lines[0], lines[1], lines[4]},
+		"continuation first":     {lines[3]},
+		"record after trailer":   append(append([]string{}, lines...), lines[1]),
+		"account outside groups": {lines[0], lines[2]},
+	} {
+		_, err := Read(strings.NewReader(strings.Join(records, "\n")))
+		if !errors.Is(err, ErrStructure) {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+
+	for name, record := range map[string]string{
+		"bad funds type": "16,165,50000,X,REF1,,/",
+		"bad type code":  "16,16,50000,Z/",
+		"bad amount":     "16,165,5.00,Z/",
+	} {
+		records := append(append([]string{}, lines[:3]...), record)
+		_, err := Read(strings.NewReader(strings.Join(records, "\n")))
+		if err == nil || errors.Is(err, ErrStructure) {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,479 @@
+// Package baseii reads and writes Visa Base II style clearing files with the
+// record messages of flatfile.baseii.v1, in ASCII or EBCDIC, with display or
+// packed amounts, on lines, as fixed length or RDW records, and in the 1014
+// byte blocks card networks send, checking the structure of transactions and
+// the totals of the batch and file trailers.
+package baseii
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/baseii/v1/baseii_pb"
+	"github.com/pentops/flatfile/recordio"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RecordLength is the length of every TCR.
+const RecordLength = 168
+
+// ErrStructure is wrapped by errors for records out of order, such as a TCR
+// 1 without a TCR 0.
+var ErrStructure = errors.New("invalid Base II file structure")
+
+// Format is how a file is encoded. The zero Format is ASCII on lines.
+type Format struct {
+	// Charset defaults to recordio.Latin1.
+	Charset recordio.Charset
+	// Framing defaults to recordio.Newline. Fixed framing uses RecordLength.
+	Framing recordio.Framing
+	// Blocked files are sent in blocks of recordio.BlockSize bytes, each
+	// followed by two pad bytes, the last filled with padding.
+	Blocked bool
+	// PackedAmounts files hold the amounts of draft TCR 0s and trailers as
+	// packed decimal, in the Packed records of flatfile.baseii.v1. They are
+	// read and written as the display records, and need Fixed or RDW
+	// framing.
+	PackedAmounts bool
+}
+
+func (f Format) charset() recordio.Charset {
+	if f.Charset == (recordio.Charset{}) {
+		return recordio.Latin1
+	}
+	return f.Charset
+}
+
+func (f Format) framing() recordio.Framing {
+	if f.Framing == "" {
+		return recordio.Newline
+	}
+	return f.Framing
+}
+
+// File is a clearing file: a header, batches of transactions, and a trailer.
+type File struct {
+	Header  *baseii_pb.FileHeader
+	Batches []*Batch
+	Trailer *baseii_pb.FileTrailer
+}
+
+// Batch is the transactions before a batch trailer.
+type Batch struct {
+	Transactions []*Transaction
+	Trailer      *baseii_pb.BatchTrailer
+}
+
+// Transaction is the TCRs of one transaction. Drafts have a Draft TCR 0 and
+// optionally an Additional TCR 1, then any later TCRs as Components; other
+// transactions are only Components, from their TCR 0.
+type Transaction struct {
+	Draft      *baseii_pb.DraftTCR0
+	Additional *baseii_pb.DraftTCR1
+	Components []*baseii_pb.TransactionComponent
+}
+
+// IsDraft reports whether a transaction code is a draft, and so monetary:
+// sales drafts (05), credit vouchers (06), cash disbursements (07), and
+// their reversals (25, 26, 27).
+func IsDraft(transactionCode string) bool {
+	switch transactionCode {
+	case "05", "06", "07", "25", "26", "27":
+		return true
+	}
+	return false
+}
+
+// SelectType picks the message of a record by its transaction code and TCR
+// number, for binfile.ReportFile and other readers of mixed records.
+func SelectType(record []byte) (protoreflect.MessageDescriptor, error) {
+	msg, err := newRecord(record)
+	if err != nil {
+		return nil, err
+	}
+	return msg.ProtoReflect().Descriptor(), nil
+}
+
+func newRecord(record []byte) (proto.Message, error) {
+	if len(record) < 4 {
+		return nil, fmt.Errorf("%w: record of %d bytes", ErrStructure, len(record))
+	}
+	code := string(record[0:2])
+	switch {
+	case code == "90":
+		return &baseii_pb.FileHeader{}, nil
+	case code == "91":
+		return &baseii_pb.BatchTrailer{}, nil
+	case code == "92":
+		return &baseii_pb.FileTrailer{}, nil
+	case IsDraft(code) && record[3] == '0':
+		return &baseii_pb.DraftTCR0{}, nil
+	case IsDraft(code) && record[3] == '1':
+		return &baseii_pb.DraftTCR1{}, nil
+	case isDigit(record[0]) && isDigit(record[1]) && isDigit(record[3]):
+		return &baseii_pb.TransactionComponent{}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown transaction code %q or TCR number %q", ErrStructure, code, record[3])
+}
+
+// packedRecord returns the packed variant of a record with amounts, or nil
+// for records without.
+func packedRecord(msg proto.Message) proto.Message {
+	switch msg.(type) {
+	case *baseii_pb.DraftTCR0:
+		return &baseii_pb.PackedDraftTCR0{}
+	case *baseii_pb.BatchTrailer:
+		return &baseii_pb.PackedBatchTrailer{}
+	case *baseii_pb.FileTrailer:
+		return &baseii_pb.PackedFileTrailer{}
+	}
+	return nil
+}
+
+// convert copies a record into its display or packed variant, which share
+// field numbers.
+func convert(dst, src proto.Message) error {
+	data, err := proto.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, dst)
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// Read parses a clearing file of the format. Records out of order are an
+// error; Validate checks the totals.
+func Read(r io.Reader, format Format) (*File, error) {
+	var opts []recordio.ReaderOption
+	if format.Blocked {
+		r = recordio.Unblock(r)
+		opts = append(opts, recordio.WithPadding(recordio.BlockPadding))
+	}
+	charset := format.charset()
+	reader, err := recordio.NewReader(r, format.framing(), charset, RecordLength, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{}
+	batch := &Batch{}
+	var transaction *Transaction
+	var lastCode string
+	var lastTCR uint32
+	for record := 1; ; record++ {
+		data, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("record %d: %w", record, err)
+		}
+		if file.Trailer != nil {
+			return nil, fmt.Errorf("record %d: %w: record after the file trailer", record, ErrStructure)
+		}
+		padded := bytes.Repeat([]byte{charset.EncodeByte(' ')}, max(RecordLength, len(data)))
+		copy(padded, data)
+		// The transaction code and TCR number pick the message, which then
+		// decodes the text of the record around any packed amounts.
+		text := bytes.Clone(padded[:4])
+		charset.Decode(text)
+
+		msg, err := newRecord(text)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", record, err)
+		}
+		if record == 1 {
+			if _, ok := msg.(*baseii_pb.FileHeader); !ok {
+				return nil, fmt.Errorf("record 1: %w: the first record is not a file header", ErrStructure)
+			}
+		}
+		parsed := msg
+		if packed := packedRecord(msg); format.PackedAmounts && packed != nil {
+			parsed = packed
+		}
+		if err := binfile.ParseMessage(parsed, padded, binfile.WithCharset(charset)); err != nil {
+			return nil, fmt.Errorf("record %d: %w", record, err)
+		}
+		if parsed != msg {
+			if err := convert(msg, parsed); err != nil {
+				return nil, fmt.Errorf("record %d: %w", record, err)
+			}
+		}
+
+		switch msg := msg.(type) {
+		case *baseii_pb.FileHeader:
+			if file.Header != nil {
+				return nil, fmt.Errorf("record %d: %w: second file header", record, ErrStructure)
+			}
+			file.Header = msg
+			continue
+		case *baseii_pb.BatchTrailer:
+			batch.Trailer = msg
+			file.Batches = append(file.Batches, batch)
+			batch, transaction = &Batch{}, nil
+			continue
+		case *baseii_pb.FileTrailer:
+			if len(batch.Transactions) > 0 {
+				return nil, fmt.Errorf("record %d: %w: file trailer before the trailer of a batch", record, ErrStructure)
+			}
+			file.Trailer = msg
+			continue
+		}
+
+		// The TCRs of a transaction share its transaction code and follow
+		// its TCR 0 in ascending order.
+		code := string(text[0:2])
+		tcr := uint32(text[3] - '0')
+		if tcr == 0 {
+			transaction = &Transaction{}
+			batch.Transactions = append(batch.Transactions, transaction)
+		} else if transaction == nil || code != lastCode || tcr <= lastTCR {
+			return nil, fmt.Errorf("record %d: %w: TCR %d of TC %s does not follow its TCR 0", record, ErrStructure, tcr, code)
+		}
+		lastCode, lastTCR = code, tcr
+
+		switch msg := msg.(type) {
+		case *baseii_pb.DraftTCR0:
+			transaction.Draft = msg
+		case *baseii_pb.DraftTCR1:
+			transaction.Additional = msg
+		case *baseii_pb.TransactionComponent:
+			transaction.Components = append(transaction.Components, msg)
+		}
+	}
+	if file.Trailer == nil {
+		return nil, fmt.Errorf("%w: no file trailer", ErrStructure)
+	}
+	return file, nil
+}
+
+// Validate checks the batch and file trailers against the transactions they
+// cover, returning every mismatch.
+func (f *File) Validate() error {
+	var errs []error
+	var fileTotals totals
+	for idx, batch := range f.Batches {
+		batchTotals := batch.totals()
+		fileTotals.add(batchTotals)
+
+		name := fmt.Sprintf("batch %d", idx+1)
+		trailer := batch.Trailer
+		errs = append(errs, batchTotals.check(name, trailer.NumberOfMonetaryTransactions, trailer.NumberOfTransactions, trailer.NumberOfTcrs, trailer.DestinationAmount, trailer.SourceAmount)...)
+		if trailer.BatchNumber != uint32(idx+1) {
+			errs = append(errs, fmt.Errorf("%s: batch number is %d", name, trailer.BatchNumber))
+		}
+	}
+
+	trailer := f.Trailer
+	// The file counts its header and trailer as well as the batches.
+	fileTotals.tcrs += 2
+	errs = append(errs, fileTotals.check("file", trailer.NumberOfMonetaryTransactions, trailer.NumberOfTransactions, trailer.NumberOfTcrs, trailer.DestinationAmount, trailer.SourceAmount)...)
+	if got := uint32(len(f.Batches)); trailer.BatchCount != got {
+		errs = append(errs, fmt.Errorf("file: batch count is %d, file has %d", trailer.BatchCount, got))
+	}
+	return errors.Join(errs...)
+}
+
+// totals are the figures trailers hold for the transactions they cover.
+type totals struct {
+	monetary     uint64
+	transactions uint64
+	tcrs         uint64
+	destination  uint64
+	source       uint64
+}
+
+func (b *Batch) totals() totals {
+	// The batch counts its trailer.
+	t := totals{tcrs: 1}
+	for _, transaction := range b.Transactions {
+		t.transactions++
+		t.tcrs += uint64(len(transaction.Components))
+		if transaction.Draft != nil {
+			t.monetary++
+			t.tcrs++
+			t.destination += transaction.Draft.DestinationAmount
+			t.source += transaction.Draft.SourceAmount
+		}
+		if transaction.Additional != nil {
+			t.tcrs++
+		}
+	}
+	return t
+}
+
+func (t *totals) add(other totals) {
+	t.monetary += other.monetary
+	t.transactions += other.transactions
+	t.tcrs += other.tcrs
+	t.destination += other.destination
+	t.source += other.source
+}
+
+func (t totals) check(name string, monetary, transactions, tcrs, destination, source uint64) []error {
+	var errs []error
+	if monetary != t.monetary {
+		errs = append(errs, fmt.Errorf("%s: number of monetary transactions is %d, records total %d", name, monetary, t.monetary))
+	}
+	if transactions != t.transactions {
+		errs = append(errs, fmt.Errorf("%s: number of transactions is %d, records total %d", name, transactions, t.transactions))
+	}
+	if tcrs != t.tcrs {
+		errs = append(errs, fmt.Errorf("%s: number of TCRs is %d, records total %d", name, tcrs, t.tcrs))
+	}
+	if destination != t.destination {
+		errs = append(errs, fmt.Errorf("%s: destination amount is %d, records total %d", name, destination, t.destination))
+	}
+	if source != t.source {
+		errs = append(errs, fmt.Errorf("%s: source amount is %d, records total %d", name, source, t.source))
+	}
+	return errs
+}
+
+// Finalize sets the header's transaction code, the transaction codes and TCR
+// numbers of TCR 1s, and the batch and file trailers, with the BIN and
+// processing date of the header, leaving callers to build the header and
+// transactions.
+func (f *File) Finalize() {
+	f.Header.TransactionCode = "90"
+	var fileTotals totals
+	for idx, batch := range f.Batches {
+		for _, transaction := range batch.Transactions {
+			if transaction.Draft != nil && transaction.Additional != nil {
+				transaction.Additional.TransactionCode = transaction.Draft.TransactionCode
+				transaction.Additional.TransactionCodeQualifier = transaction.Draft.TransactionCodeQualifier
+				transaction.Additional.TransactionComponentSequenceNumber = 1
+			}
+		}
+		batchTotals := batch.totals()
+		fileTotals.add(batchTotals)
+		batch.Trailer = &baseii_pb.BatchTrailer{
+			TransactionCode:              "91",
+			TransactionCodeQualifier:     "0",
+			Bin:                          f.Header.ProcessingBin,
+			ProcessingDate:               f.Header.ProcessingDate,
+			DestinationAmount:            batchTotals.destination,
+			NumberOfMonetaryTransactions: batchTotals.monetary,
+			BatchNumber:                  uint32(idx + 1),
+			NumberOfTcrs:                 batchTotals.tcrs,
+			NumberOfTransactions:         batchTotals.transactions,
+			SourceAmount:                 batchTotals.source,
+		}
+	}
+
+	f.Trailer = &baseii_pb.FileTrailer{
+		TransactionCode:              "92",
+		TransactionCodeQualifier:     "0",
+		Bin:                          f.Header.ProcessingBin,
+		ProcessingDate:               f.Header.ProcessingDate,
+		DestinationAmount:            fileTotals.destination,
+		NumberOfMonetaryTransactions: fileTotals.monetary,
+		BatchCount:                   uint32(len(f.Batches)),
+		NumberOfTcrs:                 fileTotals.tcrs + 2,
+		NumberOfTransactions:         fileTotals.transactions,
+		SourceAmount:                 fileTotals.source,
+	}
+}
+
+// WriteTo writes the records of the file in the format, with zero amounts and
+// counts written as zeros rather than left blank. Call Finalize first to
+// fill in the trailers.
+func (f *File) WriteTo(w io.Writer, format Format) (int64, error) {
+	if f.Header == nil || f.Trailer == nil {
+		return 0, errors.New("file has no header or trailer")
+	}
+	if format.PackedAmounts && format.framing() == recordio.Newline {
+		return 0, errors.New("packed amounts need Fixed or RDW framing")
+	}
+	counter := &countingWriter{w: w}
+	out := io.Writer(counter)
+	var blocks *recordio.BlockWriter
+	if format.Blocked {
+		blocks = recordio.NewBlockWriter(counter)
+		out = blocks
+	}
+	charset := format.charset()
+	writer, err := recordio.NewWriter(out, format.framing(), charset, RecordLength)
+	if err != nil {
+		return 0, err
+	}
+
+	write := func(msg proto.Message) error {
+		name := msg.ProtoReflect().Descriptor().Name()
+		if packed := packedRecord(msg); format.PackedAmounts && packed != nil {
+			if err := convert(packed, msg); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			msg = packed
+		}
+		record, err := binfile.MarshalRecord(msg)
+		if err == nil {
+			err = binfile.ZeroFill(record, msg)
+		}
+		if err == nil {
+			err = binfile.EncodeRecord(record, msg.ProtoReflect().Descriptor(), charset)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		return writer.Write(record)
+	}
+
+	if err := write(f.Header); err != nil {
+		return counter.n, err
+	}
+	for idx, batch := range f.Batches {
+		if batch.Trailer == nil {
+			return counter.n, fmt.Errorf("batch %d has no trailer", idx+1)
+		}
+		for _, transaction := range batch.Transactions {
+			if transaction.Draft != nil {
+				if err := write(transaction.Draft); err != nil {
+					return counter.n, err
+				}
+			}
+			if transaction.Additional != nil {
+				if err := write(transaction.Additional); err != nil {
+					return counter.n, err
+				}
+			}
+			for _, component := range transaction.Components {
+				if err := write(component); err != nil {
+					return counter.n, err
+				}
+			}
+		}
+		if err := write(batch.Trailer); err != nil {
+			return counter.n, err
+		}
+	}
+	if err := write(f.Trailer); err != nil {
+		return counter.n, err
+	}
+	if err := writer.Flush(); err != nil {
+		return counter.n, err
+	}
+	if blocks != nil {
+		if err := blocks.Close(); err != nil {
+			return counter.n, err
+		}
+	}
+	return counter.n, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
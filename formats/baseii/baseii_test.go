@@ -0,0 +1,252 @@
+package baseii
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/baseii/v1/baseii_pb"
+	"github.com/pentops/flatfile/recordio"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func testFile() *File {
+	draft := func(code, arn string, amount uint64) *Transaction {
+		return &Transaction{Draft: &baseii_pb.DraftTCR0{
+			TransactionCode:           code,
+			TransactionCodeQualifier:  "0",
+			AccountNumber:             "4111111111111111",
+			AcquirerReferenceNumber:   arn,
+			AcquirersBusinessId:       "12345678",
+			PurchaseDate:              "0131",
+			DestinationAmount:         amount,
+			DestinationCurrencyCode:   "840",
+			SourceAmount:              amount,
+			SourceCurrencyCode:        "840",
+			MerchantName:              "ACME STORE",
+			MerchantCity:              "ANYTOWN",
+			MerchantCountryCode:       "US",
+			MerchantCategoryCode:      "5411",
+			AuthorizationCode:         "A1B2C3",
+			PosEntryMode:              "05",
+			CentralProcessingDate:     "4032",
+			ReimbursementAttribute:    "0",
+			MerchantZipCode:           "90001",
+			UsageCode:                 "1",
+			SettlementFlag:            "9",
+			CardholderIdMethod:        "1",
+			MerchantStateProvinceCode: "CA",
+		}}
+	}
+	withAdditional := draft("05", "74123450031000000000019", 2550)
+	withAdditional.Additional = &baseii_pb.DraftTCR1{
+		CardAcceptorId: "ACME0001",
+		TerminalId:     "T0000001",
+		Cashback:       1000,
+	}
+	withAdditional.Components = []*baseii_pb.TransactionComponent{{
+		TransactionCode:                    "05",
+		TransactionCodeQualifier:           "0",
+		TransactionComponentSequenceNumber: 7,
+		Data:                               "CHIP DATA",
+	}}
+	fee := &Transaction{Components: []*baseii_pb.TransactionComponent{{
+		TransactionCode:          "10",
+		TransactionCodeQualifier: "0",
+		Data:                     "FEE COLLECTION",
+	}}}
+
+	return &File{
+		Header: &baseii_pb.FileHeader{
+			TransactionCodeQualifier: "0",
+			ProcessingBin:            "400000",
+			ProcessingDate:           "24032",
+		},
+		Batches: []*Batch{
+			{Transactions: []*Transaction{withAdditional, draft("06", "74123450031000000000027", 1000)}},
+			{Transactions: []*Transaction{draft("05", "74123450031000000000035", 99999), fee}},
+		},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	if trailer := file.Batches[0].Trailer; trailer.NumberOfTcrs != 5 || trailer.DestinationAmount != 3550 || trailer.NumberOfMonetaryTransactions != 2 {
+		t.Errorf("got batch trailer %v", trailer)
+	}
+	if trailer := file.Trailer; trailer.NumberOfTcrs != 10 || trailer.NumberOfTransactions != 4 || trailer.BatchCount != 2 {
+		t.Errorf("got file trailer %v", trailer)
+	}
+
+	for name, format := range map[string]Format{
+		"ascii lines":                 {},
+		"ebcdic fixed":                {Charset: recordio.EBCDIC, Framing: recordio.Fixed},
+		"ebcdic rdw":                  {Charset: recordio.EBCDIC, Framing: recordio.RDW},
+		"ebcdic fixed blocked":        {Charset: recordio.EBCDIC, Framing: recordio.Fixed, Blocked: true},
+		"ascii rdw blocked":           {Framing: recordio.RDW, Blocked: true},
+		"ebcdic rdw packed":           {Charset: recordio.EBCDIC, Framing: recordio.RDW, PackedAmounts: true},
+		"ebcdic fixed blocked packed": {Charset: recordio.EBCDIC, Framing: recordio.Fixed, Blocked: true, PackedAmounts: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			n, err := file.WriteTo(buf, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != int64(buf.Len()) {
+				t.Errorf("wrote %d bytes, counted %d", buf.Len(), n)
+			}
+			if format.Blocked && buf.Len()%(recordio.BlockSize+2) != 0 {
+				t.Errorf("%d bytes is not a whole number of blocks", buf.Len())
+			}
+			if format.Charset == recordio.EBCDIC && format.Framing == recordio.Fixed {
+				// "90" in EBCDIC.
+				if !bytes.HasPrefix(buf.Bytes(), []byte{0xf9, 0xf0}) {
+					t.Errorf("got header % x", buf.Bytes()[:4])
+				}
+			}
+
+			// 99999 as the 7 bytes of a packed destination amount.
+			if packed := []byte{0x00, 0x99, 0x99, 0x9c}; bytes.Contains(buf.Bytes(), packed) != format.PackedAmounts {
+				t.Errorf("packed amounts is %v, but a packed 99999 found is %v", format.PackedAmounts, !format.PackedAmounts)
+			}
+
+			read, err := Read(bytes.NewReader(buf.Bytes()), format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := read.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			transaction := read.Batches[0].Transactions[0]
+			if transaction.Additional.Cashback != 1000 || transaction.Components[0].Data != "CHIP DATA" {
+				t.Errorf("got transaction %v", transaction)
+			}
+			if got := read.Batches[1].Transactions[0].Draft; got.DestinationAmount != 99999 || got.SourceAmount != 99999 {
+				t.Errorf("got draft %v", got)
+			}
+			if got := read.Batches[1].Transactions[1]; got.Draft != nil || got.Components[0].Data != "FEE COLLECTION" {
+				t.Errorf("got fee collection %v", got)
+			}
+			if !proto.Equal(read.Trailer, file.Trailer) {
+				t.Errorf("got trailer %v, want %v", read.Trailer, file.Trailer)
+			}
+		})
+	}
+}
+
+func TestWritePackedLines(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	if _, err := file.WriteTo(&bytes.Buffer{}, Format{PackedAmounts: true}); err == nil {
+		t.Error("expected an error for packed amounts on lines")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	file.Batches[0].Transactions[0].Draft.DestinationAmount++
+	file.Batches[1].Transactions = file.Batches[1].Transactions[:1]
+	file.Batches[1].Trailer.BatchNumber = 3
+
+	err := file.Validate()
+	for _, want := range []string{
+		"batch 1: destination amount is 3550, records total 3551",
+		"batch 2: number of transactions is 2, records total 1",
+		"batch 2: number of TCRs is 3, records total 2",
+		"batch 2: batch number is 3",
+		"file: number of TCRs is 10, records total 9",
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("error %v does not contain %q", err, want)
+		}
+	}
+}
+
+func TestReadStructure(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf, Format{}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+
+	for name, records := range map[string][]string{
+		"no file header":              lines[1:],
+		"no file trailer":             lines[:len(lines)-1],
+		"TCR 1 without a TCR 0":       {lines[0], lines[2]},
+		"TCR out of order":            {lines[0], lines[1], lines[3], lines[2]},
+		"file trailer in a batch":     {lines[0], lines[1], lines[len(lines)-1]},
+		"record after file trailer":   append(append([]string{}, lines...), lines[1]),
+		"unknown transaction code":    {lines[0], "XX00"},
+		"TCR 1 of another draft's TC": {lines[0], lines[4], lines[2]},
+	} {
+		_, err := Read(strings.NewReader(strings.Join(records, "\n")), Format{})
+		if !errors.Is(err, ErrStructure) {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+}
+
+func TestControlTotals(t *testing.T) {
+	// The control_totals annotations check the amounts and monetary counts of
+	// the trailers, as flatfile check-totals does.
+	file := testFile()
+	file.Finalize()
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf, Format{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var descs []protoreflect.MessageDescriptor
+	for _, prefix := range []string{"9000", "0500", "0501", "1000", "9100", "9200"} {
+		desc, err := SelectType([]byte(prefix))
+		if err != nil {
+			t.Fatal(err)
+		}
+		descs = append(descs, desc)
+	}
+	checker, err := binfile.NewTotalsChecker(descs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := 0
+	for line := range strings.Lines(buf.String()) {
+		record := []byte(strings.TrimSuffix(line, "\n"))
+		msg, err := newRecord(record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := binfile.ParseMessage(msg, record); err != nil {
+			t.Fatal(err)
+		}
+		for _, result := range checker.Add(msg) {
+			results++
+			if result.Err != nil {
+				t.Error(result.Err)
+			}
+		}
+	}
+	if results != 9 {
+		t.Errorf("got %d results, want 9", results)
+	}
+}
+
+func TestLayouts(t *testing.T) {
+	// Every position of every record is a field or declared filler, so the
+	// schema passes flatfile lint.
+	messages := baseii_pb.File_flatfile_baseii_v1_baseii_proto.Messages()
+	for i := range messages.Len() {
+		desc := messages.Get(i)
+		if issues := binfile.ValidateLayout(desc); len(issues) > 0 {
+			t.Errorf("%s: %v", desc.FullName(), issues)
+		}
+	}
+}
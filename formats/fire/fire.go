@@ -0,0 +1,460 @@
+// Package fire reads and writes IRS FIRE information return files with the
+// record messages of flatfile.fire.v1, checking the structure of payers and
+// the totals of their C and K records.
+package fire
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/fire/v1/fire_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RecordLength is the length of every record of a FIRE file, including the
+// CR/LF or blanks of its last two positions.
+const RecordLength = 750
+
+// AmountCodes are the codes of the payment amounts of B records, and of the
+// control totals of C and K records, in record order.
+const AmountCodes = "123456789ABCDEFGHJ"
+
+// ErrStructure is wrapped by errors for records out of order, such as a
+// payee outside a payer.
+var ErrStructure = errors.New("invalid FIRE file structure")
+
+// File is a FIRE file: a transmitter record, payers, and an end of
+// transmission record.
+type File struct {
+	Transmitter *fire_pb.Transmitter
+	Payers      []*Payer
+	End         *fire_pb.EndOfTransmission
+}
+
+// Payer is the B records of a payer and type of return between its A and C
+// records, and the K records which follow for combined federal/state filers.
+type Payer struct {
+	Record      *fire_pb.Payer
+	Payees      []*fire_pb.Payee
+	End         *fire_pb.EndOfPayer
+	StateTotals []*fire_pb.StateTotals
+}
+
+// PaymentAmount returns a payment amount of a B record by its amount code,
+// or the control total of a C or K record, and zero for other codes.
+func PaymentAmount(msg proto.Message, code byte) int64 {
+	field := amountField(msg.ProtoReflect().Descriptor(), code)
+	if field == nil {
+		return 0
+	}
+	return msg.ProtoReflect().Get(field).Int()
+}
+
+// SetPaymentAmount sets a payment amount of a B record by its amount code,
+// or the control total of a C or K record.
+func SetPaymentAmount(msg proto.Message, code byte, amount int64) error {
+	field := amountField(msg.ProtoReflect().Descriptor(), code)
+	if field == nil {
+		return fmt.Errorf("%s has no amount %q", msg.ProtoReflect().Descriptor().Name(), code)
+	}
+	msg.ProtoReflect().Set(field, protoreflect.ValueOfInt64(amount))
+	return nil
+}
+
+func amountField(desc protoreflect.MessageDescriptor, code byte) protoreflect.FieldDescriptor {
+	if strings.IndexByte(AmountCodes, code) < 0 {
+		return nil
+	}
+	suffix := strings.ToLower(string(code))
+	if field := desc.Fields().ByName(protoreflect.Name("payment_amount_" + suffix)); field != nil {
+		return field
+	}
+	return desc.Fields().ByName(protoreflect.Name("control_total_" + suffix))
+}
+
+// SelectType picks the message of a record by its record type, for
+// binfile.ReportFile and other readers of mixed records.
+func SelectType(record []byte) (protoreflect.MessageDescriptor, error) {
+	msg, err := newRecord(record)
+	if err != nil {
+		return nil, err
+	}
+	return msg.ProtoReflect().Descriptor(), nil
+}
+
+func newRecord(record []byte) (proto.Message, error) {
+	if len(record) == 0 {
+		return nil, fmt.Errorf("%w: empty record", ErrStructure)
+	}
+	switch record[0] {
+	case 'T':
+		return &fire_pb.Transmitter{}, nil
+	case 'A':
+		return &fire_pb.Payer{}, nil
+	case 'B':
+		return &fire_pb.Payee{}, nil
+	case 'C':
+		return &fire_pb.EndOfPayer{}, nil
+	case 'K':
+		return &fire_pb.StateTotals{}, nil
+	case 'F':
+		return &fire_pb.EndOfTransmission{}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown record type %q", ErrStructure, record[0])
+}
+
+// Read parses a FIRE file, with records ending in a CR/LF or, without line
+// endings, every 750 bytes. Records out of order are an error; Validate
+// checks the totals.
+func Read(r io.Reader) (*File, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(scanRecords)
+
+	file := &File{}
+	var payer *Payer
+	record := 0
+	for scanner.Scan() {
+		record++
+		data := scanner.Bytes()
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		if file.End != nil {
+			return nil, fmt.Errorf("record %d: %w: record after the end of transmission", record, ErrStructure)
+		}
+		if record == 1 && data[0] != 'T' {
+			return nil, fmt.Errorf("record 1: %w: the first record is not a transmitter record", ErrStructure)
+		}
+		// Records ending in a CR/LF are read without their last two bytes,
+		// and padded back to length in a copy, leaving the scanner's buffer.
+		if len(data) < RecordLength {
+			padded := bytes.Repeat([]byte(" "), RecordLength)
+			copy(padded, data)
+			data = padded
+		}
+
+		msg, err := newRecord(data)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", record, err)
+		}
+		if err := binfile.ParseMessage(msg, data); err != nil {
+			return nil, fmt.Errorf("record %d: %w", record, err)
+		}
+
+		switch msg := msg.(type) {
+		case *fire_pb.Transmitter:
+			if file.Transmitter != nil {
+				return nil, fmt.Errorf("record %d: %w: second transmitter record", record, ErrStructure)
+			}
+			file.Transmitter = msg
+		case *fire_pb.Payer:
+			if payer != nil && payer.End == nil {
+				return nil, fmt.Errorf("record %d: %w: payer record before the end of the previous payer", record, ErrStructure)
+			}
+			payer = &Payer{Record: msg}
+			file.Payers = append(file.Payers, payer)
+		case *fire_pb.Payee:
+			if payer == nil || payer.End != nil {
+				return nil, fmt.Errorf("record %d: %w: payee outside a payer", record, ErrStructure)
+			}
+			payer.Payees = append(payer.Payees, msg)
+		case *fire_pb.EndOfPayer:
+			if payer == nil || payer.End != nil {
+				return nil, fmt.Errorf("record %d: %w: end of payer without a payer record", record, ErrStructure)
+			}
+			payer.End = msg
+		case *fire_pb.StateTotals:
+			if payer == nil || payer.End == nil {
+				return nil, fmt.Errorf("record %d: %w: state totals before the end of payer", record, ErrStructure)
+			}
+			payer.StateTotals = append(payer.StateTotals, msg)
+		case *fire_pb.EndOfTransmission:
+			if payer != nil && payer.End == nil {
+				return nil, fmt.Errorf("record %d: %w: end of transmission before the end of a payer", record, ErrStructure)
+			}
+			file.End = msg
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if file.End == nil {
+		return nil, fmt.Errorf("%w: no end of transmission", ErrStructure)
+	}
+	return file, nil
+}
+
+// scanRecords splits records at line endings, or every 750 bytes for files
+// without them.
+func scanRecords(data []byte, atEOF bool) (int, []byte, error) {
+	start := 0
+	for start < len(data) && (data[start] == '\n' || data[start] == '\r') {
+		start++
+	}
+	rest := data[start:]
+	if idx := bytes.IndexAny(rest[:min(len(rest), RecordLength+1)], "\r\n"); idx >= 0 {
+		return start + idx + 1, rest[:idx], nil
+	}
+	if len(rest) >= RecordLength {
+		return start + RecordLength, rest[:RecordLength], nil
+	}
+	if atEOF && len(rest) > 0 {
+		return len(data), rest, nil
+	}
+	if atEOF {
+		return len(data), nil, nil
+	}
+	return start, nil, nil
+}
+
+// Validate checks the C, K and F records against the records they cover,
+// the amount codes of payers, the payment years, and the record sequence
+// numbers, returning every mismatch.
+func (f *File) Validate() error {
+	var errs []error
+	year := f.Transmitter.PaymentYear
+	payees := 0
+	for _, payer := range f.Payers {
+		name := fmt.Sprintf("payer %s", payer.Record.PayerTin)
+		if payer.Record.PaymentYear != year {
+			errs = append(errs, fmt.Errorf("%s: payment year %d, transmitter %d", name, payer.Record.PaymentYear, year))
+		}
+		payees += len(payer.Payees)
+
+		var total totals
+		states := map[string]*totals{}
+		for _, payee := range payer.Payees {
+			if payee.PaymentYear != year {
+				errs = append(errs, fmt.Errorf("%s: payee %s: payment year %d, transmitter %d", name, payee.PayerAccountNumber, payee.PaymentYear, year))
+			}
+			for _, code := range []byte(AmountCodes) {
+				if PaymentAmount(payee, code) != 0 && strings.IndexByte(payer.Record.AmountCodes, code) < 0 {
+					errs = append(errs, fmt.Errorf("%s: payee %s: amount %c is not in the amount codes %s", name, payee.PayerAccountNumber, code, payer.Record.AmountCodes))
+				}
+			}
+			total.add(payee)
+			if state := payee.CombinedFederalStateCode; state != "" {
+				if states[state] == nil {
+					states[state] = &totals{}
+				}
+				states[state].add(payee)
+			}
+		}
+		errs = append(errs, total.check(name, payer.End, payer.End.NumberOfPayees)...)
+
+		for _, stateTotals := range payer.StateTotals {
+			state := stateTotals.CombinedFederalStateCode
+			stateName := fmt.Sprintf("%s: state %s", name, state)
+			got := states[state]
+			if got == nil {
+				got = &totals{}
+			}
+			delete(states, state)
+			errs = append(errs, got.check(stateName, stateTotals, stateTotals.NumberOfPayees)...)
+			if stateTotals.StateIncomeTaxWithheldTotal != got.stateWithheld {
+				errs = append(errs, fmt.Errorf("%s: state income tax withheld total is %d, records total %d", stateName, stateTotals.StateIncomeTaxWithheldTotal, got.stateWithheld))
+			}
+			if stateTotals.LocalIncomeTaxWithheldTotal != got.localWithheld {
+				errs = append(errs, fmt.Errorf("%s: local income tax withheld total is %d, records total %d", stateName, stateTotals.LocalIncomeTaxWithheldTotal, got.localWithheld))
+			}
+		}
+		if payer.Record.CombinedFederalStateFiler {
+			for _, state := range slices.Sorted(maps.Keys(states)) {
+				errs = append(errs, fmt.Errorf("%s: state %s: no state totals record", name, state))
+			}
+		}
+	}
+
+	if got := uint32(len(f.Payers)); f.End.NumberOfARecords != got {
+		errs = append(errs, fmt.Errorf("file: number of A records is %d, file has %d", f.End.NumberOfARecords, got))
+	}
+	for _, count := range []uint32{f.Transmitter.TotalNumberOfPayees, f.End.TotalNumberOfPayees} {
+		if count != 0 && count != uint32(payees) {
+			errs = append(errs, fmt.Errorf("file: total number of payees is %d, file has %d", count, payees))
+			break
+		}
+	}
+
+	for idx, msg := range f.records() {
+		if got := sequenceNumber(msg); got != uint32(idx+1) {
+			errs = append(errs, fmt.Errorf("record %d: record sequence number is %d", idx+1, got))
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// totals are the figures C and K records hold for the B records they cover.
+type totals struct {
+	payees        uint32
+	amounts       [len(AmountCodes)]int64
+	stateWithheld int64
+	localWithheld int64
+}
+
+func (t *totals) add(payee *fire_pb.Payee) {
+	t.payees++
+	for idx, code := range []byte(AmountCodes) {
+		t.amounts[idx] += PaymentAmount(payee, code)
+	}
+	t.stateWithheld += payee.StateIncomeTaxWithheld
+	t.localWithheld += payee.LocalIncomeTaxWithheld
+}
+
+func (t *totals) check(name string, control proto.Message, payees uint32) []error {
+	var errs []error
+	if payees != t.payees {
+		errs = append(errs, fmt.Errorf("%s: number of payees is %d, records total %d", name, payees, t.payees))
+	}
+	for idx, code := range []byte(AmountCodes) {
+		if got := PaymentAmount(control, code); got != t.amounts[idx] {
+			errs = append(errs, fmt.Errorf("%s: control total %c is %d, records total %d", name, code, got, t.amounts[idx]))
+		}
+	}
+	return errs
+}
+
+func (t *totals) setControl(control proto.Message) {
+	for idx, code := range []byte(AmountCodes) {
+		_ = SetPaymentAmount(control, code, t.amounts[idx])
+	}
+}
+
+// records lists the records of the file in order.
+func (f *File) records() []proto.Message {
+	records := []proto.Message{f.Transmitter}
+	for _, payer := range f.Payers {
+		records = append(records, payer.Record)
+		for _, payee := range payer.Payees {
+			records = append(records, payee)
+		}
+		records = append(records, payer.End)
+		for _, stateTotals := range payer.StateTotals {
+			records = append(records, stateTotals)
+		}
+	}
+	return append(records, f.End)
+}
+
+func sequenceField(msg proto.Message) protoreflect.FieldDescriptor {
+	return msg.ProtoReflect().Descriptor().Fields().ByName("record_sequence_number")
+}
+
+func sequenceNumber(msg proto.Message) uint32 {
+	return uint32(msg.ProtoReflect().Get(sequenceField(msg)).Uint())
+}
+
+// Finalize derives the parts of the file Publication 1220 defines in terms of
+// the others: record types, payment years, amount codes left empty, the C
+// records, K records for each state of combined federal/state filers, the F
+// record, and the record sequence numbers. Callers build the transmitter,
+// payer and payee records.
+func (f *File) Finalize() {
+	f.Transmitter.RecordType = "T"
+	year := f.Transmitter.PaymentYear
+	payees := 0
+	for _, payer := range f.Payers {
+		payer.Record.RecordType = "A"
+		payer.Record.PaymentYear = year
+		payees += len(payer.Payees)
+
+		var total totals
+		states := map[string]*totals{}
+		for _, payee := range payer.Payees {
+			payee.RecordType = "B"
+			payee.PaymentYear = year
+			total.add(payee)
+			if state := payee.CombinedFederalStateCode; state != "" && payer.Record.CombinedFederalStateFiler {
+				if states[state] == nil {
+					states[state] = &totals{}
+				}
+				states[state].add(payee)
+			}
+		}
+
+		if payer.Record.AmountCodes == "" {
+			var codes []byte
+			for _, code := range []byte(AmountCodes) {
+				if slices.ContainsFunc(payer.Payees, func(payee *fire_pb.Payee) bool {
+					return PaymentAmount(payee, code) != 0
+				}) {
+					codes = append(codes, code)
+				}
+			}
+			payer.Record.AmountCodes = string(codes)
+		}
+
+		payer.End = &fire_pb.EndOfPayer{
+			RecordType:     "C",
+			NumberOfPayees: total.payees,
+		}
+		total.setControl(payer.End)
+
+		payer.StateTotals = nil
+		for _, state := range slices.Sorted(maps.Keys(states)) {
+			stateTotals := &fire_pb.StateTotals{
+				RecordType:                  "K",
+				NumberOfPayees:              states[state].payees,
+				StateIncomeTaxWithheldTotal: states[state].stateWithheld,
+				LocalIncomeTaxWithheldTotal: states[state].localWithheld,
+				CombinedFederalStateCode:    state,
+			}
+			states[state].setControl(stateTotals)
+			payer.StateTotals = append(payer.StateTotals, stateTotals)
+		}
+	}
+
+	f.Transmitter.TotalNumberOfPayees = uint32(payees)
+	f.End = &fire_pb.EndOfTransmission{
+		RecordType:          "F",
+		NumberOfARecords:    uint32(len(f.Payers)),
+		Zero:                strings.Repeat("0", 21),
+		TotalNumberOfPayees: uint32(payees),
+	}
+
+	for idx, msg := range f.records() {
+		msg.ProtoReflect().Set(sequenceField(msg), protoreflect.ValueOfUint32(uint32(idx+1)))
+	}
+}
+
+// WriteTo writes the records of the file, each ending with a CR/LF in its
+// last two positions. Amount and count fields are zero filled, as
+// Publication 1220 requires, including those which are zero. Call Finalize
+// first to fill in the C, K and F records.
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	if f.Transmitter == nil || f.End == nil {
+		return 0, errors.New("file has no transmitter or end of transmission")
+	}
+	for _, payer := range f.Payers {
+		if payer.End == nil {
+			return 0, fmt.Errorf("payer %s has no end of payer", payer.Record.PayerTin)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	var written int64
+	for _, msg := range f.records() {
+		record, err := binfile.MarshalRecord(msg)
+		if err == nil {
+			err = binfile.ZeroFill(record, msg)
+		}
+		if err != nil {
+			return written, fmt.Errorf("%s: %w", msg.ProtoReflect().Descriptor().Name(), err)
+		}
+		copy(record[RecordLength-2:], "\r\n")
+		n, err := bw.Write(record)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, bw.Flush()
+}
@@ -0,0 +1,248 @@
+package fire
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/fire/v1/fire_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func testFile() *File {
+	payee := func(account, name string, nec int64, state string, withheld int64) *fire_pb.Payee {
+		return &fire_pb.Payee{
+			TypeOfTin:                "2",
+			PayeeTin:                 "123456789",
+			PayerAccountNumber:       account,
+			PaymentAmount_1:          nec,
+			FirstPayeeNameLine:       name,
+			PayeeMailingAddress:      "1 MAIN ST",
+			PayeeCity:                "ANYTOWN",
+			PayeeState:               "CA",
+			PayeeZipCode:             "90001",
+			StateIncomeTaxWithheld:   withheld,
+			CombinedFederalStateCode: state,
+		}
+	}
+	withheld := payee("ACCT3", "JANE DOE", 120000, "06", 5000)
+	withheld.PaymentAmount_4 = 24000
+
+	return &File{
+		Transmitter: &fire_pb.Transmitter{
+			PaymentYear:            2024,
+			TransmitterTin:         "987654321",
+			TransmitterControlCode: "12ABC",
+			TestFileIndicator:      true,
+			TransmitterName:        "ACME CORP",
+			CompanyName:            "ACME CORP",
+			CompanyMailingAddress:  "2 HIGH ST",
+			CompanyCity:            "ANYTOWN",
+			CompanyState:           "CA",
+			CompanyZipCode:         "90001",
+			ContactName:            "PAT JONES",
+			VendorIndicator:        "I",
+		},
+		Payers: []*Payer{{
+			Record: &fire_pb.Payer{
+				CombinedFederalStateFiler: true,
+				PayerTin:                  "987654321",
+				PayerNameControl:          "ACME",
+				TypeOfReturn:              "NE",
+				FirstPayerNameLine:        "ACME CORP",
+				PayerShippingAddress:      "2 HIGH ST",
+				PayerCity:                 "ANYTOWN",
+				PayerState:                "CA",
+				PayerZipCode:              "90001",
+			},
+			Payees: []*fire_pb.Payee{
+				payee("ACCT1", "JOHN SMITH", 250000, "06", 0),
+				payee("ACCT2", "SAM LEE", 90050, "", 0),
+				withheld,
+			},
+		}},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	payer := file.Payers[0]
+	if payer.Record.AmountCodes != "14" {
+		t.Errorf("got amount codes %q", payer.Record.AmountCodes)
+	}
+	if end := payer.End; end.NumberOfPayees != 3 || end.ControlTotal_1 != 460050 || end.ControlTotal_4 != 24000 {
+		t.Errorf("got end of payer %v", end)
+	}
+	if len(payer.StateTotals) != 1 || payer.StateTotals[0].NumberOfPayees != 2 || payer.StateTotals[0].StateIncomeTaxWithheldTotal != 5000 {
+		t.Errorf("got state totals %v", payer.StateTotals)
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	records := strings.SplitAfter(buf.String(), "\r\n")
+	records = records[:len(records)-1]
+	if len(records) != 8 {
+		t.Fatalf("got %d records, want T, A, 3 B, C, K and F", len(records))
+	}
+	for idx, record := range records {
+		if len(record) != RecordLength {
+			t.Errorf("record %d is %d bytes", idx+1, len(record))
+		}
+	}
+	// Amounts are zero filled, including those not reported.
+	if amounts := records[2][54:78]; amounts != "000000250000000000000000" {
+		t.Errorf("got payee amounts %q", amounts)
+	}
+	if sequence := records[7][499:507]; sequence != "00000008" {
+		t.Errorf("got F record sequence number %q", sequence)
+	}
+
+	read, err := Read(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := read.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(read.Payers[0].StateTotals[0], payer.StateTotals[0]) {
+		t.Errorf("got state totals %v, want %v", read.Payers[0].StateTotals[0], payer.StateTotals[0])
+	}
+
+	// Files without line endings are read as 750 byte records.
+	if _, err := Read(strings.NewReader(strings.ReplaceAll(buf.String(), "\r\n", "  "))); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNegativeAmount(t *testing.T) {
+	file := testFile()
+	file.Payers[0].Payees[1].PaymentAmount_1 = -1500
+	file.Finalize()
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	record := strings.Split(buf.String(), "\r\n")[3]
+	if amount := record[54:66]; amount != "-00000001500" {
+		t.Errorf("got amount %q", amount)
+	}
+
+	read, err := Read(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := PaymentAmount(read.Payers[0].Payees[1], '1'); got != -1500 {
+		t.Errorf("got amount %d", got)
+	}
+	if got := read.Payers[0].End.ControlTotal_1; got != 368500 {
+		t.Errorf("got control total %d", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	payer := file.Payers[0]
+	payer.Payees[0].PaymentAmount_1++
+	payer.Payees[1].PaymentAmountB = 100
+	payer.StateTotals = nil
+	file.End.NumberOfARecords = 2
+
+	err := file.Validate()
+	for _, want := range []string{
+		"payer 987654321: control total 1 is 460050, records total 460051",
+		"payer 987654321: payee ACCT2: amount B is not in the amount codes 14",
+		"payer 987654321: state 06: no state totals record",
+		"file: number of A records is 2, file has 1",
+		"record 7: record sequence number is 8",
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("error %v does not contain %q", err, want)
+		}
+	}
+}
+
+func TestReadStructure(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	records := strings.SplitAfter(buf.String(), "\r\n")
+
+	for name, records := range map[string][]string{
+		"payee outside a payer":  {records[0], records[2]},
+		"no end of transmission": records[:6],
+		"state totals in payer":  {records[0], records[1], records[6]},
+		"record after the end":   append(append([]string{}, records[:8]...), records[2]),
+		"no transmitter":         records[1:],
+	} {
+		_, err := Read(strings.NewReader(strings.Join(records, "")))
+		if !errors.Is(err, ErrStructure) {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+}
+
+func TestControlTotals(t *testing.T) {
+	// The control_totals annotations check the C and F records, as flatfile
+	// check-totals does.
+	file := testFile()
+	file.Finalize()
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var descs []protoreflect.MessageDescriptor
+	for _, code := range "TABCKF" {
+		desc, err := SelectType([]byte{byte(code)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		descs = append(descs, desc)
+	}
+	checker, err := binfile.NewTotalsChecker(descs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := 0
+	for record := range strings.SplitSeq(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		msg, err := newRecord([]byte(record))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := binfile.ParseMessage(msg, []byte(record+"  ")); err != nil {
+			t.Fatal(err)
+		}
+		for _, result := range checker.Add(msg) {
+			results++
+			if result.Err != nil {
+				t.Error(result.Err)
+			}
+		}
+	}
+	if results != 20 {
+		t.Errorf("got %d results, want 20", results)
+	}
+}
+
+func TestLayouts(t *testing.T) {
+	// Every position of every record is a field or declared filler, so the
+	// schema passes flatfile lint.
+	messages := fire_pb.File_flatfile_fire_v1_fire_proto.Messages()
+	for i := range messages.Len() {
+		desc := messages.Get(i)
+		if issues := binfile.ValidateLayout(desc); len(issues) > 0 {
+			t.Errorf("%s: %v", desc.FullName(), issues)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+// Package nacha reads and writes NACHA ACH files with the record messages of
+// flatfile.nacha.v1, checking the structure of batches and the totals of
+// their control records.
+package nacha
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/nacha/v1/nacha_pb"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RecordLength is the length of every record of an ACH file.
+const RecordLength = 94
+
+// BlockingFactor is the records in a block. Files are padded to whole blocks
+// with records of all 9s.
+const BlockingFactor = 10
+
+// ErrStructure is wrapped by errors for records out of order, such as an
+// entry outside a batch.
+var ErrStructure = errors.New("invalid ACH file structure")
+
+// File is an ACH file: a header, batches of entries, and a control record.
+type File struct {
+	Header  *nacha_pb.FileHeader
+	Batches []*Batch
+	Control *nacha_pb.FileControl
+}
+
+// Batch is the entries of a batch between its header and control.
+type Batch struct {
+	Header  *nacha_pb.BatchHeader
+	Entries []*Entry
+	Control *nacha_pb.BatchControl
+}
+
+// Entry is an entry detail record with its addenda.
+type Entry struct {
+	Detail  *nacha_pb.EntryDetail
+	Addenda []*nacha_pb.Addenda
+}
+
+// IsDebit reports whether a transaction code debits the receiver's account.
+// Codes ending 0 to 4 are credits, and 5 to 9 debits.
+func IsDebit(transactionCode uint32) bool {
+	return transactionCode%10 >= 5
+}
+
+// SelectType picks the message of a record by its record type code, for
+// binfile.ReportFile and other readers of mixed records. Records of all 9s
+// are Padding rather than file controls.
+func SelectType(record []byte) (protoreflect.MessageDescriptor, error) {
+	msg, err := newRecord(record)
+	if err != nil {
+		return nil, err
+	}
+	return msg.ProtoReflect().Descriptor(), nil
+}
+
+func newRecord(record []byte) (proto.Message, error) {
+	if len(record) == 0 {
+		return nil, fmt.Errorf("%w: empty record", ErrStructure)
+	}
+	switch record[0] {
+	case '1':
+		return &nacha_pb.FileHeader{}, nil
+	case '5':
+		return &nacha_pb.BatchHeader{}, nil
+	case '6':
+		return &nacha_pb.EntryDetail{}, nil
+	case '7':
+		return &nacha_pb.Addenda{}, nil
+	case '8':
+		return &nacha_pb.BatchControl{}, nil
+	case '9':
+		if isPadding(record) {
+			return &nacha_pb.Padding{}, nil
+		}
+		return &nacha_pb.FileControl{}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown record type code %q", ErrStructure, record[0])
+}
+
+// Read parses an ACH file, with records on lines or, as some banks send
+// them, one after another without line endings. Records out of order are
+// an error; Validate checks the totals.
+func Read(r io.Reader) (*File, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(scanRecords)
+
+	file := &File{}
+	var batch *Batch
+	var entry *Entry
+	record := 0
+	padding := false
+	for scanner.Scan() {
+		record++
+		data := scanner.Bytes()
+		if padding || file.Control != nil {
+			if !isPadding(data) {
+				return nil, fmt.Errorf("record %d: %w: record after the file control", record, ErrStructure)
+			}
+			padding = true
+			continue
+		}
+		if record == 1 && (len(data) == 0 || data[0] != '1') {
+			return nil, fmt.Errorf("record 1: %w: the first record is not a file header", ErrStructure)
+		}
+
+		msg, err := newRecord(data)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", record, err)
+		}
+		if err := binfile.ParseMessage(msg, data); err != nil {
+			return nil, fmt.Errorf("record %d: %w", record, err)
+		}
+
+		switch msg := msg.(type) {
+		case *nacha_pb.FileHeader:
+			if file.Header != nil {
+				return nil, fmt.Errorf("record %d: %w: second file header", record, ErrStructure)
+			}
+			file.Header = msg
+		case *nacha_pb.BatchHeader:
+			if batch != nil {
+				return nil, fmt.Errorf("record %d: %w: batch header before the control of batch %d", record, ErrStructure, batch.Header.BatchNumber)
+			}
+			batch = &Batch{Header: msg}
+		case *nacha_pb.EntryDetail:
+			if batch == nil {
+				return nil, fmt.Errorf("record %d: %w: entry outside a batch", record, ErrStructure)
+			}
+			entry = &Entry{Detail: msg}
+			batch.Entries = append(batch.Entries, entry)
+		case *nacha_pb.Addenda:
+			if batch == nil || entry == nil {
+				return nil, fmt.Errorf("record %d: %w: addenda without an entry", record, ErrStructure)
+			}
+			entry.Addenda = append(entry.Addenda, msg)
+		case *nacha_pb.BatchControl:
+			if batch == nil {
+				return nil, fmt.Errorf("record %d: %w: batch control without a batch header", record, ErrStructure)
+			}
+			batch.Control = msg
+			file.Batches = append(file.Batches, batch)
+			batch, entry = nil, nil
+		case *nacha_pb.FileControl:
+			if batch != nil {
+				return nil, fmt.Errorf("record %d: %w: file control before the control of batch %d", record, ErrStructure, batch.Header.BatchNumber)
+			}
+			file.Control = msg
+		case *nacha_pb.Padding:
+			return nil, fmt.Errorf("record %d: %w: padding before the file control", record, ErrStructure)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if file.Control == nil {
+		return nil, fmt.Errorf("%w: no file control", ErrStructure)
+	}
+	return file, nil
+}
+
+// scanRecords splits records at line endings, or every 94 bytes for files
+// without them.
+func scanRecords(data []byte, atEOF bool) (int, []byte, error) {
+	start := 0
+	for start < len(data) && (data[start] == '\n' || data[start] == '\r') {
+		start++
+	}
+	rest := data[start:]
+	if idx := bytes.IndexAny(rest[:min(len(rest), RecordLength+1)], "\r\n"); idx >= 0 {
+		return start + idx + 1, rest[:idx], nil
+	}
+	if len(rest) >= RecordLength {
+		return start + RecordLength, rest[:RecordLength], nil
+	}
+	if atEOF && len(rest) > 0 {
+		return len(data), rest, nil
+	}
+	if atEOF {
+		return len(data), nil, nil
+	}
+	return start, nil, nil
+}
+
+func isPadding(record []byte) bool {
+	return len(record) > 0 && len(bytes.Trim(record, "9")) == 0
+}
+
+// Validate checks the control records against the entries they cover, and
+// the routing number check digits of the entries, returning every mismatch.
+func (f *File) Validate() error {
+	var errs []error
+	var fileTotals totals
+	for _, batch := range f.Batches {
+		batchTotals := batch.totals()
+		fileTotals.add(batchTotals)
+
+		name := fmt.Sprintf("batch %d", batch.Header.BatchNumber)
+		control := batch.Control
+		errs = append(errs, batchTotals.check(name, control.EntryAddendaCount, control.EntryHash, control.TotalDebitAmount, control.TotalCreditAmount)...)
+		if control.ServiceClassCode != batch.Header.ServiceClassCode {
+			errs = append(errs, fmt.Errorf("%s: control service class code %d, header %d", name, control.ServiceClassCode, batch.Header.ServiceClassCode))
+		}
+		if control.BatchNumber != batch.Header.BatchNumber {
+			errs = append(errs, fmt.Errorf("%s: control batch number %d", name, control.BatchNumber))
+		}
+		switch {
+		case batch.Header.ServiceClassCode == 220 && batchTotals.debit > 0:
+			errs = append(errs, fmt.Errorf("%s: debits in a credits only batch", name))
+		case batch.Header.ServiceClassCode == 225 && batchTotals.credit > 0:
+			errs = append(errs, fmt.Errorf("%s: credits in a debits only batch", name))
+		}
+
+		for _, entry := range batch.Entries {
+			detail := entry.Detail
+			routing := detail.ReceivingDfiIdentification + detail.CheckDigit
+			if ok, err := binfile.ValidCheckDigit(flatfile_pb.CheckDigit_CHECK_DIGIT_ABA, routing); !ok || err != nil {
+				errs = append(errs, fmt.Errorf("%s: entry %s: routing number %s has an invalid check digit", name, detail.TraceNumber, routing))
+			}
+			if detail.AddendaRecordIndicator != (len(entry.Addenda) > 0) {
+				errs = append(errs, fmt.Errorf("%s: entry %s: addenda record indicator does not match its %d addenda", name, detail.TraceNumber, len(entry.Addenda)))
+			}
+		}
+	}
+
+	control := f.Control
+	errs = append(errs, fileTotals.check("file", control.EntryAddendaCount, control.EntryHash, control.TotalDebitAmount, control.TotalCreditAmount)...)
+	if got := uint32(len(f.Batches)); control.BatchCount != got {
+		errs = append(errs, fmt.Errorf("file: batch count is %d, file has %d", control.BatchCount, got))
+	}
+	if got := f.blockCount(); control.BlockCount != got {
+		errs = append(errs, fmt.Errorf("file: block count is %d, file has %d", control.BlockCount, got))
+	}
+	return errors.Join(errs...)
+}
+
+// totals are the figures control records hold for the entries they cover.
+type totals struct {
+	entryAddenda uint32
+	hash         uint64
+	debit        uint64
+	credit       uint64
+}
+
+// hashModulus keeps the last ten digits of entry hashes.
+const hashModulus = 10_000_000_000
+
+func (b *Batch) totals() totals {
+	var t totals
+	for _, entry := range b.Entries {
+		detail := entry.Detail
+		t.entryAddenda += 1 + uint32(len(entry.Addenda))
+		dfi, _ := strconv.ParseUint(detail.ReceivingDfiIdentification, 10, 64)
+		t.hash = (t.hash + dfi) % hashModulus
+		if IsDebit(detail.TransactionCode) {
+			t.debit += detail.Amount
+		} else {
+			t.credit += detail.Amount
+		}
+	}
+	return t
+}
+
+func (t *totals) add(other totals) {
+	t.entryAddenda += other.entryAddenda
+	t.hash = (t.hash + other.hash) % hashModulus
+	t.debit += other.debit
+	t.credit += other.credit
+}
+
+func (t totals) check(name string, entryAddenda uint32, hash, debit, credit uint64) []error {
+	var errs []error
+	if entryAddenda != t.entryAddenda {
+		errs = append(errs, fmt.Errorf("%s: entry/addenda count is %d, records total %d", name, entryAddenda, t.entryAddenda))
+	}
+	if hash != t.hash {
+		errs = append(errs, fmt.Errorf("%s: entry hash is %d, records total %d", name, hash, t.hash))
+	}
+	if debit != t.debit {
+		errs = append(errs, fmt.Errorf("%s: total debit amount is %d, records total %d", name, debit, t.debit))
+	}
+	if credit != t.credit {
+		errs = append(errs, fmt.Errorf("%s: total credit amount is %d, records total %d", name, credit, t.credit))
+	}
+	return errs
+}
+
+// records counts the records of the file before padding.
+func (f *File) records() int {
+	count := 2
+	for _, batch := range f.Batches {
+		count += 2
+		for _, entry := range batch.Entries {
+			count += 1 + len(entry.Addenda)
+		}
+	}
+	return count
+}
+
+func (f *File) blockCount() uint32 {
+	return uint32((f.records() + BlockingFactor - 1) / BlockingFactor)
+}
+
+// Finalize fills in what follows from the rest of the file, so that only the
+// header, batch headers and entries need to be built: record type codes, the
+// fixed values of the file header, addenda indicators and sequence numbers,
+// and the batch and file control records.
+func (f *File) Finalize() {
+	header := f.Header
+	header.RecordTypeCode = "1"
+	if header.PriorityCode == 0 {
+		header.PriorityCode = 1
+	}
+	header.RecordSize = RecordLength
+	header.BlockingFactor = BlockingFactor
+	header.FormatCode = "1"
+
+	var fileTotals totals
+	for _, batch := range f.Batches {
+		batch.Header.RecordTypeCode = "5"
+		for _, entry := range batch.Entries {
+			entry.Detail.RecordTypeCode = "6"
+			entry.Detail.AddendaRecordIndicator = len(entry.Addenda) > 0
+			sequence, _ := strconv.ParseUint(entry.Detail.TraceNumber[max(0, len(entry.Detail.TraceNumber)-7):], 10, 32)
+			for idx, addenda := range entry.Addenda {
+				addenda.RecordTypeCode = "7"
+				addenda.AddendaSequenceNumber = uint32(idx + 1)
+				addenda.EntryDetailSequenceNumber = uint32(sequence)
+			}
+		}
+
+		batchTotals := batch.totals()
+		fileTotals.add(batchTotals)
+		batch.Control = &nacha_pb.BatchControl{
+			RecordTypeCode:               "8",
+			ServiceClassCode:             batch.Header.ServiceClassCode,
+			EntryAddendaCount:            batchTotals.entryAddenda,
+			EntryHash:                    batchTotals.hash,
+			TotalDebitAmount:             batchTotals.debit,
+			TotalCreditAmount:            batchTotals.credit,
+			CompanyIdentification:        batch.Header.CompanyIdentification,
+			OriginatingDfiIdentification: batch.Header.OriginatingDfiIdentification,
+			BatchNumber:                  batch.Header.BatchNumber,
+		}
+	}
+
+	f.Control = &nacha_pb.FileControl{
+		RecordTypeCode:    "9",
+		BatchCount:        uint32(len(f.Batches)),
+		BlockCount:        f.blockCount(),
+		EntryAddendaCount: fileTotals.entryAddenda,
+		EntryHash:         fileTotals.hash,
+		TotalDebitAmount:  fileTotals.debit,
+		TotalCreditAmount: fileTotals.credit,
+	}
+}
+
+// WriteTo writes the records of the file on lines, padded to a whole block.
+// Numeric fields are zero filled, as ACH requires, including those which are
+// zero. Call Finalize first to fill in the controls.
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	if f.Header == nil || f.Control == nil {
+		return 0, errors.New("file has no header or control")
+	}
+	bw := bufio.NewWriter(w)
+	var written int64
+	write := func(msg proto.Message) error {
+		record, err := binfile.MarshalRecord(msg)
+		if err == nil {
+			err = binfile.ZeroFill(record, msg)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg.ProtoReflect().Descriptor().Name(), err)
+		}
+		n, err := bw.Write(append(record, '\n'))
+		written += int64(n)
+		return err
+	}
+
+	if err := write(f.Header); err != nil {
+		return written, err
+	}
+	for _, batch := range f.Batches {
+		if batch.Control == nil {
+			return written, fmt.Errorf("batch %d has no control", batch.Header.BatchNumber)
+		}
+		if err := write(batch.Header); err != nil {
+			return written, err
+		}
+		for _, entry := range batch.Entries {
+			if err := write(entry.Detail); err != nil {
+				return written, err
+			}
+			for _, addenda := range entry.Addenda {
+				if err := write(addenda); err != nil {
+					return written, err
+				}
+			}
+		}
+		if err := write(batch.Control); err != nil {
+			return written, err
+		}
+	}
+	if err := write(f.Control); err != nil {
+		return written, err
+	}
+
+	padding := append(bytes.Repeat([]byte("9"), RecordLength), '\n')
+	for range (BlockingFactor - f.records()%BlockingFactor) % BlockingFactor {
+		n, err := bw.Write(padding)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, bw.Flush()
+}
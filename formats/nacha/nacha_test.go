@@ -0,0 +1,223 @@
+package nacha
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/nacha/v1/nacha_pb"
+	"github.com/pentops/j5/j5types/date_j5t"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func testFile() *File {
+	entry := func(code uint32, trace string, amount uint64, name string) *Entry {
+		return &Entry{Detail: &nacha_pb.EntryDetail{
+			TransactionCode:            code,
+			ReceivingDfiIdentification: "09100001",
+			CheckDigit:                 "9",
+			DfiAccountNumber:           "12345678",
+			Amount:                     amount,
+			IndividualName:             name,
+			TraceNumber:                trace,
+		}}
+	}
+	withAddenda := entry(22, "011000010000002", 250000, "JANE DOE")
+	withAddenda.Addenda = []*nacha_pb.Addenda{{
+		AddendaTypeCode:           5,
+		PaymentRelatedInformation: "BONUS",
+	}}
+
+	batch := func(number, serviceClass uint32, entries ...*Entry) *Batch {
+		return &Batch{
+			Header: &nacha_pb.BatchHeader{
+				ServiceClassCode:             serviceClass,
+				CompanyName:                  "ACME CORP",
+				CompanyIdentification:        "1234567890",
+				StandardEntryClassCode:       "PPD",
+				CompanyEntryDescription:      "PAYROLL",
+				EffectiveEntryDate:           &date_j5t.Date{Year: 2024, Month: 1, Day: 31},
+				OriginatorStatusCode:         "1",
+				OriginatingDfiIdentification: "01100001",
+				BatchNumber:                  number,
+			},
+			Entries: entries,
+		}
+	}
+
+	return &File{
+		Header: &nacha_pb.FileHeader{
+			ImmediateDestination:     " 091000019",
+			ImmediateOrigin:          " 011000015",
+			FileCreationDate:         &date_j5t.Date{Year: 2024, Month: 1, Day: 30},
+			FileIdModifier:           "A",
+			ImmediateDestinationName: "DEST BANK",
+			ImmediateOriginName:      "ACME CORP",
+		},
+		Batches: []*Batch{
+			batch(1, 220,
+				entry(22, "011000010000001", 100000, "JOHN SMITH"),
+				withAddenda),
+			batch(2, 225,
+				entry(27, "011000010000003", 5000, "JOHN SMITH"),
+				entry(27, "011000010000004", 7500, "JANE DOE")),
+		},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	if control := file.Batches[0].Control; control.EntryAddendaCount != 3 || control.TotalCreditAmount != 350000 || control.EntryHash != 18200002 {
+		t.Errorf("got batch control %v", control)
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 20 {
+		t.Fatalf("got %d lines, want two blocks of 10", len(lines))
+	}
+	for idx, line := range lines {
+		if len(line) != RecordLength {
+			t.Errorf("line %d is %d bytes", idx+1, len(line))
+		}
+	}
+	// Zero totals are written as zeros.
+	if debit := lines[5][20:32]; debit != "000000000000" {
+		t.Errorf("got batch 1 debit total %q", debit)
+	}
+	if addenda := lines[4]; !strings.HasPrefix(addenda, "705BONUS ") || !strings.HasSuffix(addenda, "00010000002") {
+		t.Errorf("got addenda %q", addenda)
+	}
+	if lines[19] != strings.Repeat("9", RecordLength) {
+		t.Errorf("got last line %q", lines[19])
+	}
+
+	read, err := Read(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := read.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if len(read.Batches) != 2 || len(read.Batches[0].Entries[1].Addenda) != 1 {
+		t.Errorf("got %d batches", len(read.Batches))
+	}
+	if !proto.Equal(read.Control, file.Control) {
+		t.Errorf("got control %v, want %v", read.Control, file.Control)
+	}
+
+	// Files without line endings are read as 94 byte records.
+	if _, err := Read(strings.NewReader(strings.ReplaceAll(buf.String(), "\n", ""))); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	file.Batches[0].Entries[0].Detail.Amount++
+	file.Batches[1].Entries[0].Detail.CheckDigit = "1"
+	file.Batches[1].Entries[1].Detail.TransactionCode = 22
+
+	err := file.Validate()
+	for _, want := range []string{
+		"batch 1: total credit amount is 350000, records total 350001",
+		"batch 2: entry 011000010000003: routing number 091000011 has an invalid check digit",
+		"batch 2: credits in a debits only batch",
+		"file: total credit amount is 350000, records total 357501",
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("error %v does not contain %q", err, want)
+		}
+	}
+}
+
+func TestReadStructure(t *testing.T) {
+	file := testFile()
+	file.Finalize()
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+
+	for name, records := range map[string][]string{
+		"entry outside a batch":    {lines[0], lines[2]},
+		"no file control":          lines[:5],
+		"batch header in a batch":  {lines[0], lines[1], lines[1]},
+		"record after the control": append(append([]string{}, lines[:12]...), lines[2]),
+		"padding before the control": {lines[0], strings.Repeat("9", RecordLength)},
+	} {
+		_, err := Read(strings.NewReader(strings.Join(records, "\n")))
+		if !errors.Is(err, ErrStructure) {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+}
+
+func TestControlTotals(t *testing.T) {
+	// The control_totals annotations check the entry hashes and batch count
+	// of files, padding included, as flatfile check-totals does.
+	file := testFile()
+	file.Finalize()
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var descs []protoreflect.MessageDescriptor
+	for _, record := range []string{"1", "5", "6", "7", "8", "9", strings.Repeat("9", RecordLength)} {
+		desc, err := SelectType([]byte(record))
+		if err != nil {
+			t.Fatal(err)
+		}
+		descs = append(descs, desc)
+	}
+	checker, err := binfile.NewTotalsChecker(descs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, padding := 0, 0
+	for line := range strings.Lines(buf.String()) {
+		record := []byte(strings.TrimSuffix(line, "\n"))
+		msg, err := newRecord(record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := msg.(*nacha_pb.Padding); ok {
+			padding++
+		}
+		if err := binfile.ParseMessage(msg, record); err != nil {
+			t.Fatal(err)
+		}
+		for _, result := range checker.Add(msg) {
+			results++
+			if result.Err != nil {
+				t.Error(result.Err)
+			}
+		}
+	}
+	if results != 4 || padding == 0 {
+		t.Errorf("got %d results, want 4, and %d padding records", results, padding)
+	}
+}
+
+func TestLayouts(t *testing.T) {
+	// Every position of every record is a field or declared filler, so the
+	// schema passes flatfile lint.
+	messages := nacha_pb.File_flatfile_nacha_v1_nacha_proto.Messages()
+	for i := range messages.Len() {
+		desc := messages.Get(i)
+		if issues := binfile.ValidateLayout(desc); len(issues) > 0 {
+			t.Errorf("%s: %v", desc.FullName(), issues)
+		}
+	}
+}
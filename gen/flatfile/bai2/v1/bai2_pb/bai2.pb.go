@@ -0,0 +1,1235 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: flatfile/bai2/v1/bai2.proto
+
+package bai2_pb
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	date_j5t "github.com/pentops/j5/j5types/date_j5t"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FundsType int32
+
+const (
+	FundsType_FUNDS_TYPE_UNSPECIFIED      FundsType = 0
+	FundsType_FUNDS_TYPE_UNKNOWN          FundsType = 1
+	FundsType_FUNDS_TYPE_IMMEDIATE        FundsType = 2
+	FundsType_FUNDS_TYPE_ONE_DAY          FundsType = 3
+	FundsType_FUNDS_TYPE_TWO_OR_MORE_DAYS FundsType = 4
+	FundsType_FUNDS_TYPE_VALUE_DATED      FundsType = 5
+	FundsType_FUNDS_TYPE_AVAILABILITY     FundsType = 6
+	FundsType_FUNDS_TYPE_DISTRIBUTED      FundsType = 7
+)
+
+// Enum value maps for FundsType.
+var (
+	FundsType_name = map[int32]string{
+		0: "FUNDS_TYPE_UNSPECIFIED",
+		1: "FUNDS_TYPE_UNKNOWN",
+		2: "FUNDS_TYPE_IMMEDIATE",
+		3: "FUNDS_TYPE_ONE_DAY",
+		4: "FUNDS_TYPE_TWO_OR_MORE_DAYS",
+		5: "FUNDS_TYPE_VALUE_DATED",
+		6: "FUNDS_TYPE_AVAILABILITY",
+		7: "FUNDS_TYPE_DISTRIBUTED",
+	}
+	FundsType_value = map[string]int32{
+		"FUNDS_TYPE_UNSPECIFIED":      0,
+		"FUNDS_TYPE_UNKNOWN":          1,
+		"FUNDS_TYPE_IMMEDIATE":        2,
+		"FUNDS_TYPE_ONE_DAY":          3,
+		"FUNDS_TYPE_TWO_OR_MORE_DAYS": 4,
+		"FUNDS_TYPE_VALUE_DATED":      5,
+		"FUNDS_TYPE_AVAILABILITY":     6,
+		"FUNDS_TYPE_DISTRIBUTED":      7,
+	}
+)
+
+func (x FundsType) Enum() *FundsType {
+	p := new(FundsType)
+	*p = x
+	return p
+}
+
+func (x FundsType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FundsType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_bai2_v1_bai2_proto_enumTypes[0].Descriptor()
+}
+
+func (FundsType) Type() protoreflect.EnumType {
+	return &file_flatfile_bai2_v1_bai2_proto_enumTypes[0]
+}
+
+func (x FundsType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FundsType.Descriptor instead.
+func (FundsType) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{0}
+}
+
+// Record 01.
+type FileHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderIdentification   string         `protobuf:"bytes,1,opt,name=sender_identification,json=senderIdentification,proto3" json:"sender_identification,omitempty"`
+	ReceiverIdentification string         `protobuf:"bytes,2,opt,name=receiver_identification,json=receiverIdentification,proto3" json:"receiver_identification,omitempty"`
+	FileCreationDate       *date_j5t.Date `protobuf:"bytes,3,opt,name=file_creation_date,json=fileCreationDate,proto3" json:"file_creation_date,omitempty"`
+	// HHMM, 2400 for the end of the day.
+	FileCreationTime         string `protobuf:"bytes,4,opt,name=file_creation_time,json=fileCreationTime,proto3" json:"file_creation_time,omitempty"`
+	FileIdentificationNumber string `protobuf:"bytes,5,opt,name=file_identification_number,json=fileIdentificationNumber,proto3" json:"file_identification_number,omitempty"`
+	PhysicalRecordLength     uint32 `protobuf:"varint,6,opt,name=physical_record_length,json=physicalRecordLength,proto3" json:"physical_record_length,omitempty"`
+	BlockSize                uint32 `protobuf:"varint,7,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	// Always 2.
+	VersionNumber uint32 `protobuf:"varint,8,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+}
+
+func (x *FileHeader) Reset() {
+	*x = FileHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileHeader) ProtoMessage() {}
+
+func (x *FileHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileHeader.ProtoReflect.Descriptor instead.
+func (*FileHeader) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FileHeader) GetSenderIdentification() string {
+	if x != nil {
+		return x.SenderIdentification
+	}
+	return ""
+}
+
+func (x *FileHeader) GetReceiverIdentification() string {
+	if x != nil {
+		return x.ReceiverIdentification
+	}
+	return ""
+}
+
+func (x *FileHeader) GetFileCreationDate() *date_j5t.Date {
+	if x != nil {
+		return x.FileCreationDate
+	}
+	return nil
+}
+
+func (x *FileHeader) GetFileCreationTime() string {
+	if x != nil {
+		return x.FileCreationTime
+	}
+	return ""
+}
+
+func (x *FileHeader) GetFileIdentificationNumber() string {
+	if x != nil {
+		return x.FileIdentificationNumber
+	}
+	return ""
+}
+
+func (x *FileHeader) GetPhysicalRecordLength() uint32 {
+	if x != nil {
+		return x.PhysicalRecordLength
+	}
+	return 0
+}
+
+func (x *FileHeader) GetBlockSize() uint32 {
+	if x != nil {
+		return x.BlockSize
+	}
+	return 0
+}
+
+func (x *FileHeader) GetVersionNumber() uint32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+// Record 02.
+type GroupHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UltimateReceiverIdentification string `protobuf:"bytes,1,opt,name=ultimate_receiver_identification,json=ultimateReceiverIdentification,proto3" json:"ultimate_receiver_identification,omitempty"`
+	OriginatorIdentification       string `protobuf:"bytes,2,opt,name=originator_identification,json=originatorIdentification,proto3" json:"originator_identification,omitempty"`
+	// 1 update, 2 deletion, 3 correction, 4 test only.
+	GroupStatus uint32         `protobuf:"varint,3,opt,name=group_status,json=groupStatus,proto3" json:"group_status,omitempty"`
+	AsOfDate    *date_j5t.Date `protobuf:"bytes,4,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	AsOfTime    string         `protobuf:"bytes,5,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`
+	// ISO 4217, USD when empty.
+	CurrencyCode string `protobuf:"bytes,6,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// 1 interim previous day, 2 final previous day, 3 interim same day, 4
+	// final same day.
+	AsOfDateModifier uint32 `protobuf:"varint,7,opt,name=as_of_date_modifier,json=asOfDateModifier,proto3" json:"as_of_date_modifier,omitempty"`
+}
+
+func (x *GroupHeader) Reset() {
+	*x = GroupHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupHeader) ProtoMessage() {}
+
+func (x *GroupHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupHeader.ProtoReflect.Descriptor instead.
+func (*GroupHeader) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupHeader) GetUltimateReceiverIdentification() string {
+	if x != nil {
+		return x.UltimateReceiverIdentification
+	}
+	return ""
+}
+
+func (x *GroupHeader) GetOriginatorIdentification() string {
+	if x != nil {
+		return x.OriginatorIdentification
+	}
+	return ""
+}
+
+func (x *GroupHeader) GetGroupStatus() uint32 {
+	if x != nil {
+		return x.GroupStatus
+	}
+	return 0
+}
+
+func (x *GroupHeader) GetAsOfDate() *date_j5t.Date {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return nil
+}
+
+func (x *GroupHeader) GetAsOfTime() string {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return ""
+}
+
+func (x *GroupHeader) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GroupHeader) GetAsOfDateModifier() uint32 {
+	if x != nil {
+		return x.AsOfDateModifier
+	}
+	return 0
+}
+
+// Record 03, with its balances and summaries.
+type AccountIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerAccountNumber string `protobuf:"bytes,1,opt,name=customer_account_number,json=customerAccountNumber,proto3" json:"customer_account_number,omitempty"`
+	// Overrides the currency of the group.
+	CurrencyCode string     `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Summaries    []*Summary `protobuf:"bytes,3,rep,name=summaries,proto3" json:"summaries,omitempty"`
+}
+
+func (x *AccountIdentifier) Reset() {
+	*x = AccountIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountIdentifier) ProtoMessage() {}
+
+func (x *AccountIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountIdentifier.ProtoReflect.Descriptor instead.
+func (*AccountIdentifier) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountIdentifier) GetCustomerAccountNumber() string {
+	if x != nil {
+		return x.CustomerAccountNumber
+	}
+	return ""
+}
+
+func (x *AccountIdentifier) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *AccountIdentifier) GetSummaries() []*Summary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+// A status (balance) or summary amount of an account. Status type codes are
+// 001 to 099, and have no item count or funds type.
+type Summary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Three digits, e.g. 010 for the opening ledger balance or 400 for total
+	// debits.
+	TypeCode string `protobuf:"bytes,1,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	// Balances may be negative.
+	Amount    int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ItemCount uint32 `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	Funds     *Funds `protobuf:"bytes,4,opt,name=funds,proto3" json:"funds,omitempty"`
+}
+
+func (x *Summary) Reset() {
+	*x = Summary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Summary) ProtoMessage() {}
+
+func (x *Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Summary.ProtoReflect.Descriptor instead.
+func (*Summary) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Summary) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Summary) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Summary) GetItemCount() uint32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Summary) GetFunds() *Funds {
+	if x != nil {
+		return x.Funds
+	}
+	return nil
+}
+
+// When the funds of an amount become available.
+type Funds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type FundsType `protobuf:"varint,1,opt,name=type,proto3,enum=flatfile.bai2.v1.FundsType" json:"type,omitempty"`
+	// For FUNDS_TYPE_VALUE_DATED.
+	ValueDate *date_j5t.Date `protobuf:"bytes,2,opt,name=value_date,json=valueDate,proto3" json:"value_date,omitempty"`
+	ValueTime string         `protobuf:"bytes,3,opt,name=value_time,json=valueTime,proto3" json:"value_time,omitempty"`
+	// For FUNDS_TYPE_AVAILABILITY.
+	ImmediateAmount    int64 `protobuf:"varint,4,opt,name=immediate_amount,json=immediateAmount,proto3" json:"immediate_amount,omitempty"`
+	OneDayAmount       int64 `protobuf:"varint,5,opt,name=one_day_amount,json=oneDayAmount,proto3" json:"one_day_amount,omitempty"`
+	TwoOrMoreDayAmount int64 `protobuf:"varint,6,opt,name=two_or_more_day_amount,json=twoOrMoreDayAmount,proto3" json:"two_or_more_day_amount,omitempty"`
+	// For FUNDS_TYPE_DISTRIBUTED.
+	Distributions []*Distribution `protobuf:"bytes,7,rep,name=distributions,proto3" json:"distributions,omitempty"`
+}
+
+func (x *Funds) Reset() {
+	*x = Funds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Funds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Funds) ProtoMessage() {}
+
+func (x *Funds) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Funds.ProtoReflect.Descriptor instead.
+func (*Funds) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Funds) GetType() FundsType {
+	if x != nil {
+		return x.Type
+	}
+	return FundsType_FUNDS_TYPE_UNSPECIFIED
+}
+
+func (x *Funds) GetValueDate() *date_j5t.Date {
+	if x != nil {
+		return x.ValueDate
+	}
+	return nil
+}
+
+func (x *Funds) GetValueTime() string {
+	if x != nil {
+		return x.ValueTime
+	}
+	return ""
+}
+
+func (x *Funds) GetImmediateAmount() int64 {
+	if x != nil {
+		return x.ImmediateAmount
+	}
+	return 0
+}
+
+func (x *Funds) GetOneDayAmount() int64 {
+	if x != nil {
+		return x.OneDayAmount
+	}
+	return 0
+}
+
+func (x *Funds) GetTwoOrMoreDayAmount() int64 {
+	if x != nil {
+		return x.TwoOrMoreDayAmount
+	}
+	return 0
+}
+
+func (x *Funds) GetDistributions() []*Distribution {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+type Distribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days   uint32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Amount int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Distribution) Reset() {
+	*x = Distribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Distribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Distribution) ProtoMessage() {}
+
+func (x *Distribution) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Distribution.ProtoReflect.Descriptor instead.
+func (*Distribution) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Distribution) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *Distribution) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Record 16, one transaction of an account.
+type TransactionDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Three digits, 100 to 399 for credits and 400 to 699 for debits.
+	TypeCode                string `protobuf:"bytes,1,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	Amount                  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Funds                   *Funds `protobuf:"bytes,3,opt,name=funds,proto3" json:"funds,omitempty"`
+	BankReferenceNumber     string `protobuf:"bytes,4,opt,name=bank_reference_number,json=bankReferenceNumber,proto3" json:"bank_reference_number,omitempty"`
+	CustomerReferenceNumber string `protobuf:"bytes,5,opt,name=customer_reference_number,json=customerReferenceNumber,proto3" json:"customer_reference_number,omitempty"`
+	// Free text, which may contain commas, to the end of the record.
+	Text string `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *TransactionDetail) Reset() {
+	*x = TransactionDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDetail) ProtoMessage() {}
+
+func (x *TransactionDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDetail.ProtoReflect.Descriptor instead.
+func (*TransactionDetail) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TransactionDetail) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionDetail) GetFunds() *Funds {
+	if x != nil {
+		return x.Funds
+	}
+	return nil
+}
+
+func (x *TransactionDetail) GetBankReferenceNumber() string {
+	if x != nil {
+		return x.BankReferenceNumber
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetCustomerReferenceNumber() string {
+	if x != nil {
+		return x.CustomerReferenceNumber
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Record 49.
+type AccountTrailer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sum of the amounts of the account's 03 and 16 records.
+	AccountControlTotal int64 `protobuf:"varint,1,opt,name=account_control_total,json=accountControlTotal,proto3" json:"account_control_total,omitempty"`
+	// The records of the account, from its 03 to this 49, including
+	// continuations.
+	NumberOfRecords uint32 `protobuf:"varint,2,opt,name=number_of_records,json=numberOfRecords,proto3" json:"number_of_records,omitempty"`
+}
+
+func (x *AccountTrailer) Reset() {
+	*x = AccountTrailer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountTrailer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTrailer) ProtoMessage() {}
+
+func (x *AccountTrailer) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTrailer.ProtoReflect.Descriptor instead.
+func (*AccountTrailer) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AccountTrailer) GetAccountControlTotal() int64 {
+	if x != nil {
+		return x.AccountControlTotal
+	}
+	return 0
+}
+
+func (x *AccountTrailer) GetNumberOfRecords() uint32 {
+	if x != nil {
+		return x.NumberOfRecords
+	}
+	return 0
+}
+
+// Record 98.
+type GroupTrailer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sum of the account control totals of the group.
+	GroupControlTotal int64  `protobuf:"varint,1,opt,name=group_control_total,json=groupControlTotal,proto3" json:"group_control_total,omitempty"`
+	NumberOfAccounts  uint32 `protobuf:"varint,2,opt,name=number_of_accounts,json=numberOfAccounts,proto3" json:"number_of_accounts,omitempty"`
+	// The records of the group, from its 02 to this 98.
+	NumberOfRecords uint32 `protobuf:"varint,3,opt,name=number_of_records,json=numberOfRecords,proto3" json:"number_of_records,omitempty"`
+}
+
+func (x *GroupTrailer) Reset() {
+	*x = GroupTrailer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupTrailer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupTrailer) ProtoMessage() {}
+
+func (x *GroupTrailer) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupTrailer.ProtoReflect.Descriptor instead.
+func (*GroupTrailer) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GroupTrailer) GetGroupControlTotal() int64 {
+	if x != nil {
+		return x.GroupControlTotal
+	}
+	return 0
+}
+
+func (x *GroupTrailer) GetNumberOfAccounts() uint32 {
+	if x != nil {
+		return x.NumberOfAccounts
+	}
+	return 0
+}
+
+func (x *GroupTrailer) GetNumberOfRecords() uint32 {
+	if x != nil {
+		return x.NumberOfRecords
+	}
+	return 0
+}
+
+// Record 99.
+type FileTrailer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sum of the group control totals of the file.
+	FileControlTotal int64  `protobuf:"varint,1,opt,name=file_control_total,json=fileControlTotal,proto3" json:"file_control_total,omitempty"`
+	NumberOfGroups   uint32 `protobuf:"varint,2,opt,name=number_of_groups,json=numberOfGroups,proto3" json:"number_of_groups,omitempty"`
+	// The records of the file, from its 01 to this 99.
+	NumberOfRecords uint32 `protobuf:"varint,3,opt,name=number_of_records,json=numberOfRecords,proto3" json:"number_of_records,omitempty"`
+}
+
+func (x *FileTrailer) Reset() {
+	*x = FileTrailer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileTrailer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTrailer) ProtoMessage() {}
+
+func (x *FileTrailer) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_bai2_v1_bai2_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTrailer.ProtoReflect.Descriptor instead.
+func (*FileTrailer) Descriptor() ([]byte, []int) {
+	return file_flatfile_bai2_v1_bai2_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FileTrailer) GetFileControlTotal() int64 {
+	if x != nil {
+		return x.FileControlTotal
+	}
+	return 0
+}
+
+func (x *FileTrailer) GetNumberOfGroups() uint32 {
+	if x != nil {
+		return x.NumberOfGroups
+	}
+	return 0
+}
+
+func (x *FileTrailer) GetNumberOfRecords() uint32 {
+	if x != nil {
+		return x.NumberOfRecords
+	}
+	return 0
+}
+
+var File_flatfile_bai2_v1_bai2_proto protoreflect.FileDescriptor
+
+var file_flatfile_bai2_v1_bai2_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x62, 0x61, 0x69, 0x32, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x61, 0x69, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x66,
+	0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x62, 0x61, 0x69, 0x32, 0x2e, 0x76, 0x31, 0x1a,
+	0x1d, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x6a, 0x35, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x03, 0x0a, 0x0a,
+	0x46, 0x69, 0x6c, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x37, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x16, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x10, 0x66, 0x69,
+	0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x1a,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x18, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x70, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xdf, 0x02, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x20, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1e, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3b, 0x0a, 0x19, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x34, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x08, 0x61, 0x73,
+	0x4f, 0x66, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x61, 0x73, 0x5f,
+	0x6f, 0x66, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x61, 0x73, 0x4f, 0x66, 0x44, 0x61, 0x74, 0x65,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x17, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x62, 0x61, 0x69, 0x32, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x62,
+	0x61, 0x69, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x05, 0x66, 0x75,
+	0x6e, 0x64, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x05, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66, 0x6c,
+	0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x62, 0x61, 0x69, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x75, 0x6e, 0x64, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x79, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x16, 0x74, 0x77, 0x6f, 0x5f, 0x6f, 0x72, 0x5f,
+	0x6d, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x74, 0x77, 0x6f, 0x4f, 0x72, 0x4d, 0x6f, 0x72, 0x65,
+	0x44, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x62, 0x61, 0x69, 0x32,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x3a, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64,
+	0x61, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfb, 0x01, 0x0a, 0x11,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x62, 0x61, 0x69, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x05,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x70, 0x0a, 0x0e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x2a, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x4f, 0x66, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f,
+	0x66, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x4f, 0x66, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2a, 0xb4, 0x02, 0x0a, 0x09, 0x46,
+	0x75, 0x6e, 0x64, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x55, 0x4e, 0x44,
+	0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x12, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x1a, 0x09, 0xaa, 0x9a,
+	0x9b, 0xe1, 0x02, 0x03, 0x0a, 0x01, 0x5a, 0x12, 0x23, 0x0a, 0x14, 0x46, 0x55, 0x4e, 0x44, 0x53,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x10,
+	0x02, 0x1a, 0x09, 0xaa, 0x9a, 0x9b, 0xe1, 0x02, 0x03, 0x0a, 0x01, 0x30, 0x12, 0x21, 0x0a, 0x12,
+	0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x44,
+	0x41, 0x59, 0x10, 0x03, 0x1a, 0x09, 0xaa, 0x9a, 0x9b, 0xe1, 0x02, 0x03, 0x0a, 0x01, 0x31, 0x12,
+	0x2a, 0x0a, 0x1b, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x57,
+	0x4f, 0x5f, 0x4f, 0x52, 0x5f, 0x4d, 0x4f, 0x52, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x04,
+	0x1a, 0x09, 0xaa, 0x9a, 0x9b, 0xe1, 0x02, 0x03, 0x0a, 0x01, 0x32, 0x12, 0x25, 0x0a, 0x16, 0x46,
+	0x55, 0x4e, 0x44, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x05, 0x1a, 0x09, 0xaa, 0x9a, 0x9b, 0xe1, 0x02, 0x03, 0x0a,
+	0x01, 0x56, 0x12, 0x26, 0x0a, 0x17, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x06, 0x1a,
+	0x09, 0xaa, 0x9a, 0x9b, 0xe1, 0x02, 0x03, 0x0a, 0x01, 0x53, 0x12, 0x25, 0x0a, 0x16, 0x46, 0x55,
+	0x4e, 0x44, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42,
+	0x55, 0x54, 0x45, 0x44, 0x10, 0x07, 0x1a, 0x09, 0xaa, 0x9a, 0x9b, 0xe1, 0x02, 0x03, 0x0a, 0x01,
+	0x44, 0x42, 0x53, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x62, 0x61,
+	0x69, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x69, 0x32, 0x5f, 0x70, 0x62, 0xf2, 0x85, 0x8f,
+	0x02, 0x14, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2f, 0x6c, 0x69, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_flatfile_bai2_v1_bai2_proto_rawDescOnce sync.Once
+	file_flatfile_bai2_v1_bai2_proto_rawDescData = file_flatfile_bai2_v1_bai2_proto_rawDesc
+)
+
+func file_flatfile_bai2_v1_bai2_proto_rawDescGZIP() []byte {
+	file_flatfile_bai2_v1_bai2_proto_rawDescOnce.Do(func() {
+		file_flatfile_bai2_v1_bai2_proto_rawDescData = protoimpl.X.CompressGZIP(file_flatfile_bai2_v1_bai2_proto_rawDescData)
+	})
+	return file_flatfile_bai2_v1_bai2_proto_rawDescData
+}
+
+var file_flatfile_bai2_v1_bai2_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_flatfile_bai2_v1_bai2_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_flatfile_bai2_v1_bai2_proto_goTypes = []any{
+	(FundsType)(0),            // 0: flatfile.bai2.v1.FundsType
+	(*FileHeader)(nil),        // 1: flatfile.bai2.v1.FileHeader
+	(*GroupHeader)(nil),       // 2: flatfile.bai2.v1.GroupHeader
+	(*AccountIdentifier)(nil), // 3: flatfile.bai2.v1.AccountIdentifier
+	(*Summary)(nil),           // 4: flatfile.bai2.v1.Summary
+	(*Funds)(nil),             // 5: flatfile.bai2.v1.Funds
+	(*Distribution)(nil),      // 6: flatfile.bai2.v1.Distribution
+	(*TransactionDetail)(nil), // 7: flatfile.bai2.v1.TransactionDetail
+	(*AccountTrailer)(nil),    // 8: flatfile.bai2.v1.AccountTrailer
+	(*GroupTrailer)(nil),      // 9: flatfile.bai2.v1.GroupTrailer
+	(*FileTrailer)(nil),       // 10: flatfile.bai2.v1.FileTrailer
+	(*date_j5t.Date)(nil),     // 11: j5.types.date.v1.Date
+}
+var file_flatfile_bai2_v1_bai2_proto_depIdxs = []int32{
+	11, // 0: flatfile.bai2.v1.FileHeader.file_creation_date:type_name -> j5.types.date.v1.Date
+	11, // 1: flatfile.bai2.v1.GroupHeader.as_of_date:type_name -> j5.types.date.v1.Date
+	4,  // 2: flatfile.bai2.v1.AccountIdentifier.summaries:type_name -> flatfile.bai2.v1.Summary
+	5,  // 3: flatfile.bai2.v1.Summary.funds:type_name -> flatfile.bai2.v1.Funds
+	0,  // 4: flatfile.bai2.v1.Funds.type:type_name -> flatfile.bai2.v1.FundsType
+	11, // 5: flatfile.bai2.v1.Funds.value_date:type_name -> j5.types.date.v1.Date
+	6,  // 6: flatfile.bai2.v1.Funds.distributions:type_name -> flatfile.bai2.v1.Distribution
+	5,  // 7: flatfile.bai2.v1.TransactionDetail.funds:type_name -> flatfile.bai2.v1.Funds
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_flatfile_bai2_v1_bai2_proto_init() }
+func file_flatfile_bai2_v1_bai2_proto_init() {
+	if File_flatfile_bai2_v1_bai2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*FileHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Summary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Funds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Distribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountTrailer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupTrailer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_bai2_v1_bai2_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*FileTrailer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_flatfile_bai2_v1_bai2_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_flatfile_bai2_v1_bai2_proto_goTypes,
+		DependencyIndexes: file_flatfile_bai2_v1_bai2_proto_depIdxs,
+		EnumInfos:         file_flatfile_bai2_v1_bai2_proto_enumTypes,
+		MessageInfos:      file_flatfile_bai2_v1_bai2_proto_msgTypes,
+	}.Build()
+	File_flatfile_bai2_v1_bai2_proto = out.File
+	file_flatfile_bai2_v1_bai2_proto_rawDesc = nil
+	file_flatfile_bai2_v1_bai2_proto_goTypes = nil
+	file_flatfile_bai2_v1_bai2_proto_depIdxs = nil
+}
@@ -22,6 +22,575 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ControlTotalKind int32
+
+const (
+	ControlTotalKind_CONTROL_TOTAL_KIND_UNSPECIFIED ControlTotalKind = 0
+	ControlTotalKind_CONTROL_TOTAL_KIND_COUNT       ControlTotalKind = 1 // The number of records
+	ControlTotalKind_CONTROL_TOTAL_KIND_SUM         ControlTotalKind = 2 // The sum of a numeric field
+	// The sum of a field's digits read as an integer, truncated to the digits
+	// which fit the total's field, e.g. the NACHA entry hash of routing numbers.
+	ControlTotalKind_CONTROL_TOTAL_KIND_HASH ControlTotalKind = 3
+)
+
+// Enum value maps for ControlTotalKind.
+var (
+	ControlTotalKind_name = map[int32]string{
+		0: "CONTROL_TOTAL_KIND_UNSPECIFIED",
+		1: "CONTROL_TOTAL_KIND_COUNT",
+		2: "CONTROL_TOTAL_KIND_SUM",
+		3: "CONTROL_TOTAL_KIND_HASH",
+	}
+	ControlTotalKind_value = map[string]int32{
+		"CONTROL_TOTAL_KIND_UNSPECIFIED": 0,
+		"CONTROL_TOTAL_KIND_COUNT":       1,
+		"CONTROL_TOTAL_KIND_SUM":         2,
+		"CONTROL_TOTAL_KIND_HASH":        3,
+	}
+)
+
+func (x ControlTotalKind) Enum() *ControlTotalKind {
+	p := new(ControlTotalKind)
+	*p = x
+	return p
+}
+
+func (x ControlTotalKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ControlTotalKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[0].Descriptor()
+}
+
+func (ControlTotalKind) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[0]
+}
+
+func (x ControlTotalKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ControlTotalKind.Descriptor instead.
+func (ControlTotalKind) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+type TrailingIs int32
+
+const (
+	TrailingIs_TRAILING_IS_UNSPECIFIED TrailingIs = 0 // Meaning ignored
+	TrailingIs_TRAILING_IS_IGNORED     TrailingIs = 1
+	TrailingIs_TRAILING_IS_ERROR       TrailingIs = 2 // Trailing bytes other than spaces and NULs fail the record
+	TrailingIs_TRAILING_IS_CAPTURED    TrailingIs = 3 // Set into trailing_field
+)
+
+// Enum value maps for TrailingIs.
+var (
+	TrailingIs_name = map[int32]string{
+		0: "TRAILING_IS_UNSPECIFIED",
+		1: "TRAILING_IS_IGNORED",
+		2: "TRAILING_IS_ERROR",
+		3: "TRAILING_IS_CAPTURED",
+	}
+	TrailingIs_value = map[string]int32{
+		"TRAILING_IS_UNSPECIFIED": 0,
+		"TRAILING_IS_IGNORED":     1,
+		"TRAILING_IS_ERROR":       2,
+		"TRAILING_IS_CAPTURED":    3,
+	}
+)
+
+func (x TrailingIs) Enum() *TrailingIs {
+	p := new(TrailingIs)
+	*p = x
+	return p
+}
+
+func (x TrailingIs) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrailingIs) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[1].Descriptor()
+}
+
+func (TrailingIs) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[1]
+}
+
+func (x TrailingIs) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrailingIs.Descriptor instead.
+func (TrailingIs) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{1}
+}
+
+type Coverage int32
+
+const (
+	Coverage_COVERAGE_UNSPECIFIED Coverage = 0 // Unmapped bytes are not checked
+	Coverage_COVERAGE_ERROR       Coverage = 1 // Unmapped data fails the record
+	Coverage_COVERAGE_WARNING     Coverage = 2 // Unmapped data is returned as a warning
+)
+
+// Enum value maps for Coverage.
+var (
+	Coverage_name = map[int32]string{
+		0: "COVERAGE_UNSPECIFIED",
+		1: "COVERAGE_ERROR",
+		2: "COVERAGE_WARNING",
+	}
+	Coverage_value = map[string]int32{
+		"COVERAGE_UNSPECIFIED": 0,
+		"COVERAGE_ERROR":       1,
+		"COVERAGE_WARNING":     2,
+	}
+)
+
+func (x Coverage) Enum() *Coverage {
+	p := new(Coverage)
+	*p = x
+	return p
+}
+
+func (x Coverage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Coverage) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[2].Descriptor()
+}
+
+func (Coverage) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[2]
+}
+
+func (x Coverage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Coverage.Descriptor instead.
+func (Coverage) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{2}
+}
+
+type LengthMode int32
+
+const (
+	LengthMode_LENGTH_MODE_UNSPECIFIED LengthMode = 0 // Meaning exact
+	LengthMode_LENGTH_MODE_EXACT       LengthMode = 1
+	LengthMode_LENGTH_MODE_MINIMUM     LengthMode = 2 // Records may be longer
+	LengthMode_LENGTH_MODE_MAXIMUM     LengthMode = 3 // Records may be shorter
+)
+
+// Enum value maps for LengthMode.
+var (
+	LengthMode_name = map[int32]string{
+		0: "LENGTH_MODE_UNSPECIFIED",
+		1: "LENGTH_MODE_EXACT",
+		2: "LENGTH_MODE_MINIMUM",
+		3: "LENGTH_MODE_MAXIMUM",
+	}
+	LengthMode_value = map[string]int32{
+		"LENGTH_MODE_UNSPECIFIED": 0,
+		"LENGTH_MODE_EXACT":       1,
+		"LENGTH_MODE_MINIMUM":     2,
+		"LENGTH_MODE_MAXIMUM":     3,
+	}
+)
+
+func (x LengthMode) Enum() *LengthMode {
+	p := new(LengthMode)
+	*p = x
+	return p
+}
+
+func (x LengthMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LengthMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[3].Descriptor()
+}
+
+func (LengthMode) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[3]
+}
+
+func (x LengthMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LengthMode.Descriptor instead.
+func (LengthMode) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{3}
+}
+
+type Severity int32
+
+const (
+	Severity_SEVERITY_UNSPECIFIED Severity = 0 // Meaning error
+	Severity_SEVERITY_ERROR       Severity = 1 // The record fails to parse
+	Severity_SEVERITY_WARNING     Severity = 2 // The record parses without the field
+)
+
+// Enum value maps for Severity.
+var (
+	Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "SEVERITY_ERROR",
+		2: "SEVERITY_WARNING",
+	}
+	Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"SEVERITY_ERROR":       1,
+		"SEVERITY_WARNING":     2,
+	}
+)
+
+func (x Severity) Enum() *Severity {
+	p := new(Severity)
+	*p = x
+	return p
+}
+
+func (x Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[4].Descriptor()
+}
+
+func (Severity) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[4]
+}
+
+func (x Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Severity.Descriptor instead.
+func (Severity) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{4}
+}
+
+type ShortIs int32
+
+const (
+	ShortIs_SHORT_IS_UNSPECIFIED ShortIs = 0 // Meaning error
+	ShortIs_SHORT_IS_ERROR       ShortIs = 1
+	ShortIs_SHORT_IS_UNSET       ShortIs = 2 // Leave the field unset
+	ShortIs_SHORT_IS_PADDED      ShortIs = 3 // Read the missing bytes as spaces, not for binary or packed fields
+)
+
+// Enum value maps for ShortIs.
+var (
+	ShortIs_name = map[int32]string{
+		0: "SHORT_IS_UNSPECIFIED",
+		1: "SHORT_IS_ERROR",
+		2: "SHORT_IS_UNSET",
+		3: "SHORT_IS_PADDED",
+	}
+	ShortIs_value = map[string]int32{
+		"SHORT_IS_UNSPECIFIED": 0,
+		"SHORT_IS_ERROR":       1,
+		"SHORT_IS_UNSET":       2,
+		"SHORT_IS_PADDED":      3,
+	}
+)
+
+func (x ShortIs) Enum() *ShortIs {
+	p := new(ShortIs)
+	*p = x
+	return p
+}
+
+func (x ShortIs) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShortIs) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[5].Descriptor()
+}
+
+func (ShortIs) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[5]
+}
+
+func (x ShortIs) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShortIs.Descriptor instead.
+func (ShortIs) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{5}
+}
+
+type CheckDigit int32
+
+const (
+	CheckDigit_CHECK_DIGIT_UNSPECIFIED CheckDigit = 0 // No check digit
+	CheckDigit_CHECK_DIGIT_LUHN        CheckDigit = 1 // Card PANs, IMEIs
+	CheckDigit_CHECK_DIGIT_ABA         CheckDigit = 2 // US routing numbers, mod 10 with weights 3, 7, 1
+	CheckDigit_CHECK_DIGIT_MOD11       CheckDigit = 3 // Weights 2, 3, 4... from the right, 'X' for 10
+)
+
+// Enum value maps for CheckDigit.
+var (
+	CheckDigit_name = map[int32]string{
+		0: "CHECK_DIGIT_UNSPECIFIED",
+		1: "CHECK_DIGIT_LUHN",
+		2: "CHECK_DIGIT_ABA",
+		3: "CHECK_DIGIT_MOD11",
+	}
+	CheckDigit_value = map[string]int32{
+		"CHECK_DIGIT_UNSPECIFIED": 0,
+		"CHECK_DIGIT_LUHN":        1,
+		"CHECK_DIGIT_ABA":         2,
+		"CHECK_DIGIT_MOD11":       3,
+	}
+)
+
+func (x CheckDigit) Enum() *CheckDigit {
+	p := new(CheckDigit)
+	*p = x
+	return p
+}
+
+func (x CheckDigit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CheckDigit) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[6].Descriptor()
+}
+
+func (CheckDigit) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[6]
+}
+
+func (x CheckDigit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CheckDigit.Descriptor instead.
+func (CheckDigit) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{6}
+}
+
+type ChecksumAlgorithm int32
+
+const (
+	ChecksumAlgorithm_CHECKSUM_ALGORITHM_UNSPECIFIED ChecksumAlgorithm = 0
+	ChecksumAlgorithm_CHECKSUM_ALGORITHM_CRC16       ChecksumAlgorithm = 1 // CRC-16/CCITT-FALSE, poly 0x1021, init 0xFFFF
+	ChecksumAlgorithm_CHECKSUM_ALGORITHM_CRC32       ChecksumAlgorithm = 2 // IEEE, as hash/crc32
+	ChecksumAlgorithm_CHECKSUM_ALGORITHM_SUM         ChecksumAlgorithm = 3 // Sum of the bytes, mod modulus
+)
+
+// Enum value maps for ChecksumAlgorithm.
+var (
+	ChecksumAlgorithm_name = map[int32]string{
+		0: "CHECKSUM_ALGORITHM_UNSPECIFIED",
+		1: "CHECKSUM_ALGORITHM_CRC16",
+		2: "CHECKSUM_ALGORITHM_CRC32",
+		3: "CHECKSUM_ALGORITHM_SUM",
+	}
+	ChecksumAlgorithm_value = map[string]int32{
+		"CHECKSUM_ALGORITHM_UNSPECIFIED": 0,
+		"CHECKSUM_ALGORITHM_CRC16":       1,
+		"CHECKSUM_ALGORITHM_CRC32":       2,
+		"CHECKSUM_ALGORITHM_SUM":         3,
+	}
+)
+
+func (x ChecksumAlgorithm) Enum() *ChecksumAlgorithm {
+	p := new(ChecksumAlgorithm)
+	*p = x
+	return p
+}
+
+func (x ChecksumAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChecksumAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[7].Descriptor()
+}
+
+func (ChecksumAlgorithm) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[7]
+}
+
+func (x ChecksumAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChecksumAlgorithm.Descriptor instead.
+func (ChecksumAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{7}
+}
+
+type BlankIs int32
+
+const (
+	// Plain strings are set to "", google.protobuf.StringValue is left unset.
+	BlankIs_BLANK_IS_UNSPECIFIED BlankIs = 0
+	BlankIs_BLANK_IS_UNSET       BlankIs = 1 // Leave the field unset, for optional strings
+	BlankIs_BLANK_IS_EMPTY       BlankIs = 2 // Set the field to ""
+)
+
+// Enum value maps for BlankIs.
+var (
+	BlankIs_name = map[int32]string{
+		0: "BLANK_IS_UNSPECIFIED",
+		1: "BLANK_IS_UNSET",
+		2: "BLANK_IS_EMPTY",
+	}
+	BlankIs_value = map[string]int32{
+		"BLANK_IS_UNSPECIFIED": 0,
+		"BLANK_IS_UNSET":       1,
+		"BLANK_IS_EMPTY":       2,
+	}
+)
+
+func (x BlankIs) Enum() *BlankIs {
+	p := new(BlankIs)
+	*p = x
+	return p
+}
+
+func (x BlankIs) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlankIs) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[8].Descriptor()
+}
+
+func (BlankIs) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[8]
+}
+
+func (x BlankIs) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlankIs.Descriptor instead.
+func (BlankIs) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{8}
+}
+
+type Normalize int32
+
+const (
+	Normalize_NORMALIZE_UNSPECIFIED Normalize = 0 // Leave the case as it is
+	Normalize_NORMALIZE_UPPER       Normalize = 1
+	Normalize_NORMALIZE_LOWER       Normalize = 2
+	Normalize_NORMALIZE_TITLE       Normalize = 3 // First letter of each word upper, the rest lower
+)
+
+// Enum value maps for Normalize.
+var (
+	Normalize_name = map[int32]string{
+		0: "NORMALIZE_UNSPECIFIED",
+		1: "NORMALIZE_UPPER",
+		2: "NORMALIZE_LOWER",
+		3: "NORMALIZE_TITLE",
+	}
+	Normalize_value = map[string]int32{
+		"NORMALIZE_UNSPECIFIED": 0,
+		"NORMALIZE_UPPER":       1,
+		"NORMALIZE_LOWER":       2,
+		"NORMALIZE_TITLE":       3,
+	}
+)
+
+func (x Normalize) Enum() *Normalize {
+	p := new(Normalize)
+	*p = x
+	return p
+}
+
+func (x Normalize) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Normalize) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[9].Descriptor()
+}
+
+func (Normalize) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[9]
+}
+
+func (x Normalize) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Normalize.Descriptor instead.
+func (Normalize) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{9}
+}
+
+type CharsetClass int32
+
+const (
+	CharsetClass_CHARSET_CLASS_UNSPECIFIED  CharsetClass = 0 // Any characters
+	CharsetClass_CHARSET_CLASS_NUMERIC      CharsetClass = 1 // 0-9 only, like COBOL PIC 9
+	CharsetClass_CHARSET_CLASS_ALPHA        CharsetClass = 2 // A-Z, a-z and space, like COBOL PIC A
+	CharsetClass_CHARSET_CLASS_ALPHANUMERIC CharsetClass = 3 // A-Z, a-z, 0-9 and space
+	CharsetClass_CHARSET_CLASS_PRINTABLE    CharsetClass = 4 // Printable ASCII, 0x20 to 0x7E
+)
+
+// Enum value maps for CharsetClass.
+var (
+	CharsetClass_name = map[int32]string{
+		0: "CHARSET_CLASS_UNSPECIFIED",
+		1: "CHARSET_CLASS_NUMERIC",
+		2: "CHARSET_CLASS_ALPHA",
+		3: "CHARSET_CLASS_ALPHANUMERIC",
+		4: "CHARSET_CLASS_PRINTABLE",
+	}
+	CharsetClass_value = map[string]int32{
+		"CHARSET_CLASS_UNSPECIFIED":  0,
+		"CHARSET_CLASS_NUMERIC":      1,
+		"CHARSET_CLASS_ALPHA":        2,
+		"CHARSET_CLASS_ALPHANUMERIC": 3,
+		"CHARSET_CLASS_PRINTABLE":    4,
+	}
+)
+
+func (x CharsetClass) Enum() *CharsetClass {
+	p := new(CharsetClass)
+	*p = x
+	return p
+}
+
+func (x CharsetClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CharsetClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[10].Descriptor()
+}
+
+func (CharsetClass) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[10]
+}
+
+func (x CharsetClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CharsetClass.Descriptor instead.
+func (CharsetClass) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{10}
+}
+
 type Trim int32
 
 const (
@@ -29,6 +598,9 @@ const (
 	Trim_TRIM_LEFT        Trim = 1
 	Trim_TRIM_RIGHT       Trim = 2
 	Trim_TRIM_BOTH        Trim = 3
+	// Right trims padding of either spaces or NULs, for files which pad
+	// inconsistently. Ignores trim_chars.
+	Trim_TRIM_RIGHT_PADDING Trim = 4
 )
 
 // Enum value maps for Trim.
@@ -38,12 +610,14 @@ var (
 		1: "TRIM_LEFT",
 		2: "TRIM_RIGHT",
 		3: "TRIM_BOTH",
+		4: "TRIM_RIGHT_PADDING",
 	}
 	Trim_value = map[string]int32{
-		"TRIM_UNSPECIFIED": 0,
-		"TRIM_LEFT":        1,
-		"TRIM_RIGHT":       2,
-		"TRIM_BOTH":        3,
+		"TRIM_UNSPECIFIED":   0,
+		"TRIM_LEFT":          1,
+		"TRIM_RIGHT":         2,
+		"TRIM_BOTH":          3,
+		"TRIM_RIGHT_PADDING": 4,
 	}
 )
 
@@ -58,11 +632,11 @@ func (x Trim) String() string {
 }
 
 func (Trim) Descriptor() protoreflect.EnumDescriptor {
-	return file_flatfile_v1_annotations_proto_enumTypes[0].Descriptor()
+	return file_flatfile_v1_annotations_proto_enumTypes[11].Descriptor()
 }
 
 func (Trim) Type() protoreflect.EnumType {
-	return &file_flatfile_v1_annotations_proto_enumTypes[0]
+	return &file_flatfile_v1_annotations_proto_enumTypes[11]
 }
 
 func (x Trim) Number() protoreflect.EnumNumber {
@@ -71,7 +645,7 @@ func (x Trim) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Trim.Descriptor instead.
 func (Trim) EnumDescriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{0}
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{11}
 }
 
 type MissingIs int32
@@ -105,104 +679,462 @@ func (x MissingIs) Enum() *MissingIs {
 	return p
 }
 
-func (x MissingIs) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x MissingIs) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MissingIs) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[12].Descriptor()
+}
+
+func (MissingIs) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[12]
+}
+
+func (x MissingIs) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MissingIs.Descriptor instead.
+func (MissingIs) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{12}
+}
+
+type NumberPolicy int32
+
+const (
+	NumberPolicy_NUMBER_POLICY_UNSPECIFIED NumberPolicy = 0 // Defaults to strict
+	NumberPolicy_NUMBER_POLICY_STRICT      NumberPolicy = 1 // Any stray character is an error
+	// Extract the digits, the first decimal point and any '-' sign, ignoring
+	// everything else. Only applies to string represented numbers.
+	NumberPolicy_NUMBER_POLICY_SALVAGE NumberPolicy = 2
+)
+
+// Enum value maps for NumberPolicy.
+var (
+	NumberPolicy_name = map[int32]string{
+		0: "NUMBER_POLICY_UNSPECIFIED",
+		1: "NUMBER_POLICY_STRICT",
+		2: "NUMBER_POLICY_SALVAGE",
+	}
+	NumberPolicy_value = map[string]int32{
+		"NUMBER_POLICY_UNSPECIFIED": 0,
+		"NUMBER_POLICY_STRICT":      1,
+		"NUMBER_POLICY_SALVAGE":     2,
+	}
+)
+
+func (x NumberPolicy) Enum() *NumberPolicy {
+	p := new(NumberPolicy)
+	*p = x
+	return p
+}
+
+func (x NumberPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NumberPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[13].Descriptor()
+}
+
+func (NumberPolicy) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[13]
+}
+
+func (x NumberPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NumberPolicy.Descriptor instead.
+func (NumberPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{13}
+}
+
+type Encoding int32
+
+const (
+	Encoding_ENCODING_UNSPECIFIED    Encoding = 0 // The number is represented as a string
+	Encoding_ENCODING_PACKED_DECIMAL Encoding = 1
+	Encoding_ENCODING_OVERPUNCH      Encoding = 2
+	Encoding_ENCODING_BINARY         Encoding = 3
+)
+
+// Enum value maps for Encoding.
+var (
+	Encoding_name = map[int32]string{
+		0: "ENCODING_UNSPECIFIED",
+		1: "ENCODING_PACKED_DECIMAL",
+		2: "ENCODING_OVERPUNCH",
+		3: "ENCODING_BINARY",
+	}
+	Encoding_value = map[string]int32{
+		"ENCODING_UNSPECIFIED":    0,
+		"ENCODING_PACKED_DECIMAL": 1,
+		"ENCODING_OVERPUNCH":      2,
+		"ENCODING_BINARY":         3,
+	}
+)
+
+func (x Encoding) Enum() *Encoding {
+	p := new(Encoding)
+	*p = x
+	return p
+}
+
+func (x Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[14].Descriptor()
+}
+
+func (Encoding) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[14]
+}
+
+func (x Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Encoding.Descriptor instead.
+func (Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{14}
+}
+
+type UnknownIs int32
+
+const (
+	UnknownIs_UNKNOWN_IS_UNSPECIFIED UnknownIs = 0 // Meaning error
+	UnknownIs_UNKNOWN_IS_ERROR       UnknownIs = 1
+	UnknownIs_UNKNOWN_IS_ZERO        UnknownIs = 2 // Leave the field as the zero (UNSPECIFIED) value
+	UnknownIs_UNKNOWN_IS_FALLBACK    UnknownIs = 3 // Use the fallback_value
+)
+
+// Enum value maps for UnknownIs.
+var (
+	UnknownIs_name = map[int32]string{
+		0: "UNKNOWN_IS_UNSPECIFIED",
+		1: "UNKNOWN_IS_ERROR",
+		2: "UNKNOWN_IS_ZERO",
+		3: "UNKNOWN_IS_FALLBACK",
+	}
+	UnknownIs_value = map[string]int32{
+		"UNKNOWN_IS_UNSPECIFIED": 0,
+		"UNKNOWN_IS_ERROR":       1,
+		"UNKNOWN_IS_ZERO":        2,
+		"UNKNOWN_IS_FALLBACK":    3,
+	}
+)
+
+func (x UnknownIs) Enum() *UnknownIs {
+	p := new(UnknownIs)
+	*p = x
+	return p
+}
+
+func (x UnknownIs) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnknownIs) Descriptor() protoreflect.EnumDescriptor {
+	return file_flatfile_v1_annotations_proto_enumTypes[15].Descriptor()
+}
+
+func (UnknownIs) Type() protoreflect.EnumType {
+	return &file_flatfile_v1_annotations_proto_enumTypes[15]
+}
+
+func (x UnknownIs) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnknownIs.Descriptor instead.
+func (UnknownIs) EnumDescriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{15}
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OneBased bool `protobuf:"varint,1,opt,name=one_based,json=oneBased,proto3" json:"one_based,omitempty"` // If true, the first column is numbered 1
+	// The expected length of each record in bytes, checked according to
+	// record_length_mode. 0 disables the check.
+	RecordLength     uint32     `protobuf:"varint,2,opt,name=record_length,json=recordLength,proto3" json:"record_length,omitempty"`
+	RecordLengthMode LengthMode `protobuf:"varint,3,opt,name=record_length_mode,json=recordLengthMode,proto3,enum=flatfile.v1.LengthMode" json:"record_length_mode,omitempty"`
+	// Byte ranges which are deliberately not mapped to a field, so that layout
+	// validation doesn't report them as gaps.
+	Filler []*FixedWidth `protobuf:"bytes,4,rep,name=filler,proto3" json:"filler,omitempty"`
+	// Checks that bytes between fields which are not declared as filler are
+	// blank (spaces or NULs) when parsing, to detect new columns which are not
+	// yet modeled. Bytes after the last field are not checked.
+	Coverage Coverage `protobuf:"varint,5,opt,name=coverage,proto3,enum=flatfile.v1.Coverage" json:"coverage,omitempty"`
+	// What to do with bytes after the end of the last field or filler.
+	TreatTrailingAs TrailingIs `protobuf:"varint,6,opt,name=treat_trailing_as,json=treatTrailingAs,proto3,enum=flatfile.v1.TrailingIs" json:"treat_trailing_as,omitempty"`
+	// The name of a string field which receives the trailing bytes for
+	// TRAILING_IS_CAPTURED.
+	TrailingField string `protobuf:"bytes,7,opt,name=trailing_field,json=trailingField,proto3" json:"trailing_field,omitempty"`
+	// Record level checks, evaluated after all fields are parsed.
+	Rules []*Rule `protobuf:"bytes,8,rep,name=rules,proto3" json:"rules,omitempty"`
+	// Names of fields which together identify a record. FileReader checks that
+	// no two records in a file share the same key.
+	KeyFields []string `protobuf:"bytes,9,rep,name=key_fields,json=keyFields,proto3" json:"key_fields,omitempty"`
+	// How a duplicate key affects the record. Warnings are available from the
+	// FileReader after the duplicate is read.
+	OnDuplicateKey Severity `protobuf:"varint,10,opt,name=on_duplicate_key,json=onDuplicateKey,proto3,enum=flatfile.v1.Severity" json:"on_duplicate_key,omitempty"`
+	// Totals held by this message, typically a batch or file trailer, which
+	// must match the records before it. Each covers the records since the
+	// previous record of this message type, or the start of the file.
+	ControlTotals []*ControlTotal `protobuf:"bytes,11,rep,name=control_totals,json=controlTotals,proto3" json:"control_totals,omitempty"`
+	// The date format of date fields which don't give their own, as most
+	// layouts use one format for every date.
+	DefaultDateFormat string `protobuf:"bytes,12,opt,name=default_date_format,json=defaultDateFormat,proto3" json:"default_date_format,omitempty"`
+	// The trim of string and enum fields which leave theirs unspecified.
+	DefaultTrim Trim `protobuf:"varint,13,opt,name=default_trim,json=defaultTrim,proto3,enum=flatfile.v1.Trim" json:"default_trim,omitempty"`
+	// The version of these annotations the message is written against. Parsers
+	// reject messages of a newer version than they support, rather than
+	// ignoring the options they don't understand. 0 is version 1.
+	AnnotationsVersion uint32 `protobuf:"varint,14,opt,name=annotations_version,json=annotationsVersion,proto3" json:"annotations_version,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Message) GetOneBased() bool {
+	if x != nil {
+		return x.OneBased
+	}
+	return false
+}
+
+func (x *Message) GetRecordLength() uint32 {
+	if x != nil {
+		return x.RecordLength
+	}
+	return 0
+}
+
+func (x *Message) GetRecordLengthMode() LengthMode {
+	if x != nil {
+		return x.RecordLengthMode
+	}
+	return LengthMode_LENGTH_MODE_UNSPECIFIED
+}
+
+func (x *Message) GetFiller() []*FixedWidth {
+	if x != nil {
+		return x.Filler
+	}
+	return nil
+}
+
+func (x *Message) GetCoverage() Coverage {
+	if x != nil {
+		return x.Coverage
+	}
+	return Coverage_COVERAGE_UNSPECIFIED
+}
+
+func (x *Message) GetTreatTrailingAs() TrailingIs {
+	if x != nil {
+		return x.TreatTrailingAs
+	}
+	return TrailingIs_TRAILING_IS_UNSPECIFIED
+}
+
+func (x *Message) GetTrailingField() string {
+	if x != nil {
+		return x.TrailingField
+	}
+	return ""
+}
+
+func (x *Message) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Message) GetKeyFields() []string {
+	if x != nil {
+		return x.KeyFields
+	}
+	return nil
+}
+
+func (x *Message) GetOnDuplicateKey() Severity {
+	if x != nil {
+		return x.OnDuplicateKey
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *Message) GetControlTotals() []*ControlTotal {
+	if x != nil {
+		return x.ControlTotals
+	}
+	return nil
+}
+
+func (x *Message) GetDefaultDateFormat() string {
+	if x != nil {
+		return x.DefaultDateFormat
+	}
+	return ""
+}
+
+func (x *Message) GetDefaultTrim() Trim {
+	if x != nil {
+		return x.DefaultTrim
+	}
+	return Trim_TRIM_UNSPECIFIED
 }
 
-func (MissingIs) Descriptor() protoreflect.EnumDescriptor {
-	return file_flatfile_v1_annotations_proto_enumTypes[1].Descriptor()
+func (x *Message) GetAnnotationsVersion() uint32 {
+	if x != nil {
+		return x.AnnotationsVersion
+	}
+	return 0
 }
 
-func (MissingIs) Type() protoreflect.EnumType {
-	return &file_flatfile_v1_annotations_proto_enumTypes[1]
-}
+type ControlTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x MissingIs) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+	// The field of this message holding the expected total.
+	Field string           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Kind  ControlTotalKind `protobuf:"varint,2,opt,name=kind,proto3,enum=flatfile.v1.ControlTotalKind" json:"kind,omitempty"`
+	// For COUNT, the full name of the message type counted, empty to count
+	// every record. For SUM and HASH, the full name of the field summed, e.g.
+	// "ach.v1.Entry.amount".
+	Of string `protobuf:"bytes,3,opt,name=of,proto3" json:"of,omitempty"`
+	// Describes the total in reports, defaults to the field name.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-// Deprecated: Use MissingIs.Descriptor instead.
-func (MissingIs) EnumDescriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{1}
+func (x *ControlTotal) Reset() {
+	*x = ControlTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Encoding int32
+func (x *ControlTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	Encoding_ENCODING_UNSPECIFIED    Encoding = 0 // The number is represented as a string
-	Encoding_ENCODING_PACKED_DECIMAL Encoding = 1
-	Encoding_ENCODING_OVERPUNCH      Encoding = 2
-	Encoding_ENCODING_BINARY         Encoding = 3
-)
+func (*ControlTotal) ProtoMessage() {}
 
-// Enum value maps for Encoding.
-var (
-	Encoding_name = map[int32]string{
-		0: "ENCODING_UNSPECIFIED",
-		1: "ENCODING_PACKED_DECIMAL",
-		2: "ENCODING_OVERPUNCH",
-		3: "ENCODING_BINARY",
-	}
-	Encoding_value = map[string]int32{
-		"ENCODING_UNSPECIFIED":    0,
-		"ENCODING_PACKED_DECIMAL": 1,
-		"ENCODING_OVERPUNCH":      2,
-		"ENCODING_BINARY":         3,
+func (x *ControlTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-)
-
-func (x Encoding) Enum() *Encoding {
-	p := new(Encoding)
-	*p = x
-	return p
+	return mi.MessageOf(x)
 }
 
-func (x Encoding) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+// Deprecated: Use ControlTotal.ProtoReflect.Descriptor instead.
+func (*ControlTotal) Descriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{1}
 }
 
-func (Encoding) Descriptor() protoreflect.EnumDescriptor {
-	return file_flatfile_v1_annotations_proto_enumTypes[2].Descriptor()
+func (x *ControlTotal) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
 }
 
-func (Encoding) Type() protoreflect.EnumType {
-	return &file_flatfile_v1_annotations_proto_enumTypes[2]
+func (x *ControlTotal) GetKind() ControlTotalKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ControlTotalKind_CONTROL_TOTAL_KIND_UNSPECIFIED
 }
 
-func (x Encoding) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ControlTotal) GetOf() string {
+	if x != nil {
+		return x.Of
+	}
+	return ""
 }
 
-// Deprecated: Use Encoding.Descriptor instead.
-func (Encoding) EnumDescriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{2}
+func (x *ControlTotal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-type Message struct {
+type Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OneBased bool `protobuf:"varint,1,opt,name=one_based,json=oneBased,proto3" json:"one_based,omitempty"` // If true, the first column is numbered 1
+	// A CEL expression which must evaluate to true, with the fields of the
+	// message in scope by name, e.g. "settlement_date >= trade_date".
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Describes the rule in errors, defaults to the expression.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *Rule) Reset() {
+	*x = Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flatfile_v1_annotations_proto_msgTypes[0]
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Message) String() string {
+func (x *Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*Rule) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_flatfile_v1_annotations_proto_msgTypes[0]
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,16 +1145,23 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Message) GetOneBased() bool {
+func (x *Rule) GetExpression() string {
 	if x != nil {
-		return x.OneBased
+		return x.Expression
 	}
-	return false
+	return ""
+}
+
+func (x *Rule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
 type FixedWidth struct {
@@ -237,7 +1176,7 @@ type FixedWidth struct {
 func (x *FixedWidth) Reset() {
 	*x = FixedWidth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flatfile_v1_annotations_proto_msgTypes[1]
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -250,7 +1189,7 @@ func (x *FixedWidth) String() string {
 func (*FixedWidth) ProtoMessage() {}
 
 func (x *FixedWidth) ProtoReflect() protoreflect.Message {
-	mi := &file_flatfile_v1_annotations_proto_msgTypes[1]
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +1202,7 @@ func (x *FixedWidth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixedWidth.ProtoReflect.Descriptor instead.
 func (*FixedWidth) Descriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{1}
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *FixedWidth) GetOffset() uint32 {
@@ -286,19 +1225,49 @@ type Field struct {
 	unknownFields protoimpl.UnknownFields
 
 	FixedWidth *FixedWidth `protobuf:"bytes,1,opt,name=fixed_width,json=fixedWidth,proto3" json:"fixed_width,omitempty"`
+	// When set, the trimmed value must end with a valid check digit for the
+	// algorithm. Blank values are not checked.
+	CheckDigit CheckDigit `protobuf:"varint,2,opt,name=check_digit,json=checkDigit,proto3,enum=flatfile.v1.CheckDigit" json:"check_digit,omitempty"`
+	// The name of a string field in the same message which receives the raw,
+	// untrimmed text of this field, e.g. to audit values which were salvaged
+	// or keep the original code of an enum. The raw field is set even when this
+	// field fails to parse.
+	RawField string `protobuf:"bytes,3,opt,name=raw_field,json=rawField,proto3" json:"raw_field,omitempty"`
+	// How a failure to parse this field affects the record. Warnings leave the
+	// field unset and are returned alongside the parsed record.
+	OnError Severity `protobuf:"varint,4,opt,name=on_error,json=onError,proto3,enum=flatfile.v1.Severity" json:"on_error,omitempty"`
+	// What to do when the record ends before the end of this field, e.g. for
+	// trailing optional fields which partners omit.
+	TreatShortAs ShortIs `protobuf:"varint,5,opt,name=treat_short_as,json=treatShortAs,proto3,enum=flatfile.v1.ShortIs" json:"treat_short_as,omitempty"`
+	// When true, a field which is blank (only spaces or NULs) or missing from a
+	// short record is an error, rather than leaving the field unset or zero.
+	// Not checked for binary encoded numbers, where NULs are a valid zero.
+	Required bool `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	// When set, the field holds a checksum over other bytes of the record which
+	// is verified during parsing. Blank values are not checked.
+	Checksum *Checksum `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Documents the field in layouts and generated specifications. It is used
+	// in place of the field's comment, which is lost from descriptors built
+	// without source info.
+	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	// A value of the field as written in the file, e.g. "00001250" for an
+	// amount, shown alongside the description in generated specifications.
+	Example string `protobuf:"bytes,9,opt,name=example,proto3" json:"example,omitempty"`
 	// Types that are assignable to FieldType:
 	//
 	//	*Field_String_
 	//	*Field_Bool
 	//	*Field_Date
 	//	*Field_Number
+	//	*Field_Enum
+	//	*Field_Custom
 	FieldType isField_FieldType `protobuf_oneof:"field_type"`
 }
 
 func (x *Field) Reset() {
 	*x = Field{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flatfile_v1_annotations_proto_msgTypes[2]
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -311,7 +1280,7 @@ func (x *Field) String() string {
 func (*Field) ProtoMessage() {}
 
 func (x *Field) ProtoReflect() protoreflect.Message {
-	mi := &file_flatfile_v1_annotations_proto_msgTypes[2]
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +1293,7 @@ func (x *Field) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Field.ProtoReflect.Descriptor instead.
 func (*Field) Descriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{2}
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Field) GetFixedWidth() *FixedWidth {
@@ -334,6 +1303,62 @@ func (x *Field) GetFixedWidth() *FixedWidth {
 	return nil
 }
 
+func (x *Field) GetCheckDigit() CheckDigit {
+	if x != nil {
+		return x.CheckDigit
+	}
+	return CheckDigit_CHECK_DIGIT_UNSPECIFIED
+}
+
+func (x *Field) GetRawField() string {
+	if x != nil {
+		return x.RawField
+	}
+	return ""
+}
+
+func (x *Field) GetOnError() Severity {
+	if x != nil {
+		return x.OnError
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *Field) GetTreatShortAs() ShortIs {
+	if x != nil {
+		return x.TreatShortAs
+	}
+	return ShortIs_SHORT_IS_UNSPECIFIED
+}
+
+func (x *Field) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Field) GetChecksum() *Checksum {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (x *Field) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Field) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
 func (m *Field) GetFieldType() isField_FieldType {
 	if m != nil {
 		return m.FieldType
@@ -355,47 +1380,198 @@ func (x *Field) GetBool() *BoolField {
 	return nil
 }
 
-func (x *Field) GetDate() *DateField {
-	if x, ok := x.GetFieldType().(*Field_Date); ok {
-		return x.Date
-	}
-	return nil
+func (x *Field) GetDate() *DateField {
+	if x, ok := x.GetFieldType().(*Field_Date); ok {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Field) GetNumber() *NumberField {
+	if x, ok := x.GetFieldType().(*Field_Number); ok {
+		return x.Number
+	}
+	return nil
+}
+
+func (x *Field) GetEnum() *EnumField {
+	if x, ok := x.GetFieldType().(*Field_Enum); ok {
+		return x.Enum
+	}
+	return nil
+}
+
+func (x *Field) GetCustom() *CustomField {
+	if x, ok := x.GetFieldType().(*Field_Custom); ok {
+		return x.Custom
+	}
+	return nil
+}
+
+type isField_FieldType interface {
+	isField_FieldType()
+}
+
+type Field_String_ struct {
+	String_ *StringField `protobuf:"bytes,10,opt,name=string,proto3,oneof"`
+}
+
+type Field_Bool struct {
+	Bool *BoolField `protobuf:"bytes,11,opt,name=bool,proto3,oneof"`
+}
+
+type Field_Date struct {
+	Date *DateField `protobuf:"bytes,12,opt,name=date,proto3,oneof"`
+}
+
+type Field_Number struct {
+	Number *NumberField `protobuf:"bytes,13,opt,name=number,proto3,oneof"`
+}
+
+type Field_Enum struct {
+	Enum *EnumField `protobuf:"bytes,14,opt,name=enum,proto3,oneof"`
+}
+
+type Field_Custom struct {
+	Custom *CustomField `protobuf:"bytes,15,opt,name=custom,proto3,oneof"`
+}
+
+func (*Field_String_) isField_FieldType() {}
+
+func (*Field_Bool) isField_FieldType() {}
+
+func (*Field_Date) isField_FieldType() {}
+
+func (*Field_Number) isField_FieldType() {}
+
+func (*Field_Enum) isField_FieldType() {}
+
+func (*Field_Custom) isField_FieldType() {}
+
+type CustomField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name the application registered its codec under, e.g. "ssn". The
+	// codec reads and writes the field in place of the handling of its type,
+	// for formats such as composite IDs which the other options can't express.
+	Codec string `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+}
+
+func (x *CustomField) Reset() {
+	*x = CustomField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomField) ProtoMessage() {}
+
+func (x *CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomField.ProtoReflect.Descriptor instead.
+func (*CustomField) Descriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CustomField) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+type Checksum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Algorithm ChecksumAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=flatfile.v1.ChecksumAlgorithm" json:"algorithm,omitempty"`
+	// The bytes covered by the checksum, in the same offset base as the fields.
+	Range *FixedWidth `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	// The modulus for CHECKSUM_ALGORITHM_SUM, defaults to 256.
+	Modulus uint32 `protobuf:"varint,3,opt,name=modulus,proto3" json:"modulus,omitempty"`
+	// When true the checksum is written as hex digits rather than decimal.
+	// Binary encoded numbers are always read as a big endian integer.
+	Hex bool `protobuf:"varint,4,opt,name=hex,proto3" json:"hex,omitempty"`
 }
 
-func (x *Field) GetNumber() *NumberField {
-	if x, ok := x.GetFieldType().(*Field_Number); ok {
-		return x.Number
+func (x *Checksum) Reset() {
+	*x = Checksum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isField_FieldType interface {
-	isField_FieldType()
+func (x *Checksum) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Field_String_ struct {
-	String_ *StringField `protobuf:"bytes,10,opt,name=string,proto3,oneof"`
-}
+func (*Checksum) ProtoMessage() {}
 
-type Field_Bool struct {
-	Bool *BoolField `protobuf:"bytes,11,opt,name=bool,proto3,oneof"`
+func (x *Checksum) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Field_Date struct {
-	Date *DateField `protobuf:"bytes,12,opt,name=date,proto3,oneof"`
+// Deprecated: Use Checksum.ProtoReflect.Descriptor instead.
+func (*Checksum) Descriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{6}
 }
 
-type Field_Number struct {
-	Number *NumberField `protobuf:"bytes,13,opt,name=number,proto3,oneof"`
+func (x *Checksum) GetAlgorithm() ChecksumAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ChecksumAlgorithm_CHECKSUM_ALGORITHM_UNSPECIFIED
 }
 
-func (*Field_String_) isField_FieldType() {}
-
-func (*Field_Bool) isField_FieldType() {}
+func (x *Checksum) GetRange() *FixedWidth {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
 
-func (*Field_Date) isField_FieldType() {}
+func (x *Checksum) GetModulus() uint32 {
+	if x != nil {
+		return x.Modulus
+	}
+	return 0
+}
 
-func (*Field_Number) isField_FieldType() {}
+func (x *Checksum) GetHex() bool {
+	if x != nil {
+		return x.Hex
+	}
+	return false
+}
 
 type StringField struct {
 	state         protoimpl.MessageState
@@ -406,12 +1582,36 @@ type StringField struct {
 	// default is space only, specify as a string of
 	// characters to trim, e.g. " 0" to trim both space and 0s
 	TrimChars string `protobuf:"bytes,2,opt,name=trim_chars,json=trimChars,proto3" json:"trim_chars,omitempty"`
+	// A regular expression (Go RE2 syntax) which non-empty values must match
+	// after trimming. Anchor with ^ and $ to match the whole value.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Restricts the characters allowed in non-empty values after trimming, a
+	// cheaper alternative to pattern for the common cases.
+	CharsetClass CharsetClass `protobuf:"varint,4,opt,name=charset_class,json=charsetClass,proto3,enum=flatfile.v1.CharsetClass" json:"charset_class,omitempty"`
+	// Replaces coded values after trimming and validation, e.g. "01" to
+	// "CHECKING". Values without an entry are kept as-is unless
+	// mapping_required is set.
+	Mapping map[string]string `protobuf:"bytes,5,rep,name=mapping,proto3" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// When true, a non-empty value without an entry in mapping is an error.
+	MappingRequired bool `protobuf:"varint,6,opt,name=mapping_required,json=mappingRequired,proto3" json:"mapping_required,omitempty"`
+	// Changes the case of the value after trimming, before validation and
+	// mapping.
+	Normalize Normalize `protobuf:"varint,7,opt,name=normalize,proto3,enum=flatfile.v1.Normalize" json:"normalize,omitempty"`
+	// Replaces runs of spaces inside the value with a single space, after
+	// trimming, for space-stuffed free text columns.
+	CollapseSpaces bool `protobuf:"varint,8,opt,name=collapse_spaces,json=collapseSpaces,proto3" json:"collapse_spaces,omitempty"`
+	// Length limits in characters for non-empty values after trimming. Set
+	// both to the same value to require an exact length. 0 is no limit.
+	MinLength uint32 `protobuf:"varint,9,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength uint32 `protobuf:"varint,10,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	// Whether a value which is empty after trimming sets the field.
+	TreatBlankAs BlankIs `protobuf:"varint,11,opt,name=treat_blank_as,json=treatBlankAs,proto3,enum=flatfile.v1.BlankIs" json:"treat_blank_as,omitempty"`
 }
 
 func (x *StringField) Reset() {
 	*x = StringField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flatfile_v1_annotations_proto_msgTypes[3]
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -424,7 +1624,7 @@ func (x *StringField) String() string {
 func (*StringField) ProtoMessage() {}
 
 func (x *StringField) ProtoReflect() protoreflect.Message {
-	mi := &file_flatfile_v1_annotations_proto_msgTypes[3]
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +1637,7 @@ func (x *StringField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringField.ProtoReflect.Descriptor instead.
 func (*StringField) Descriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{3}
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StringField) GetTrim() Trim {
@@ -454,6 +1654,69 @@ func (x *StringField) GetTrimChars() string {
 	return ""
 }
 
+func (x *StringField) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *StringField) GetCharsetClass() CharsetClass {
+	if x != nil {
+		return x.CharsetClass
+	}
+	return CharsetClass_CHARSET_CLASS_UNSPECIFIED
+}
+
+func (x *StringField) GetMapping() map[string]string {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *StringField) GetMappingRequired() bool {
+	if x != nil {
+		return x.MappingRequired
+	}
+	return false
+}
+
+func (x *StringField) GetNormalize() Normalize {
+	if x != nil {
+		return x.Normalize
+	}
+	return Normalize_NORMALIZE_UNSPECIFIED
+}
+
+func (x *StringField) GetCollapseSpaces() bool {
+	if x != nil {
+		return x.CollapseSpaces
+	}
+	return false
+}
+
+func (x *StringField) GetMinLength() uint32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *StringField) GetMaxLength() uint32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *StringField) GetTreatBlankAs() BlankIs {
+	if x != nil {
+		return x.TreatBlankAs
+	}
+	return BlankIs_BLANK_IS_UNSPECIFIED
+}
+
 type BoolField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -462,12 +1725,21 @@ type BoolField struct {
 	TrueValues     []string  `protobuf:"bytes,1,rep,name=true_values,json=trueValues,proto3" json:"true_values,omitempty"`
 	FalseValues    []string  `protobuf:"bytes,2,rep,name=false_values,json=falseValues,proto3" json:"false_values,omitempty"`
 	TreatMissingAs MissingIs `protobuf:"varint,3,opt,name=treat_missing_as,json=treatMissingAs,proto3,enum=flatfile.v1.MissingIs" json:"treat_missing_as,omitempty"`
+	// When true, true_values and false_values match regardless of case.
+	CaseInsensitive bool `protobuf:"varint,4,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// Spaces to trim from the raw value before comparing, so "Y " in a two
+	// wide column matches "Y". Defaults to no trimming.
+	Trim Trim `protobuf:"varint,5,opt,name=trim,proto3,enum=flatfile.v1.Trim" json:"trim,omitempty"`
+	// Values which leave the field unset rather than true or false, for
+	// google.protobuf.BoolValue and optional bool fields. Checked before the
+	// true and false values.
+	NullValues []string `protobuf:"bytes,6,rep,name=null_values,json=nullValues,proto3" json:"null_values,omitempty"`
 }
 
 func (x *BoolField) Reset() {
 	*x = BoolField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flatfile_v1_annotations_proto_msgTypes[4]
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -480,7 +1752,7 @@ func (x *BoolField) String() string {
 func (*BoolField) ProtoMessage() {}
 
 func (x *BoolField) ProtoReflect() protoreflect.Message {
-	mi := &file_flatfile_v1_annotations_proto_msgTypes[4]
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +1765,7 @@ func (x *BoolField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolField.ProtoReflect.Descriptor instead.
 func (*BoolField) Descriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{4}
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BoolField) GetTrueValues() []string {
@@ -517,6 +1789,27 @@ func (x *BoolField) GetTreatMissingAs() MissingIs {
 	return MissingIs_MISSING_IS_UNSPECIFIED
 }
 
+func (x *BoolField) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *BoolField) GetTrim() Trim {
+	if x != nil {
+		return x.Trim
+	}
+	return Trim_TRIM_UNSPECIFIED
+}
+
+func (x *BoolField) GetNullValues() []string {
+	if x != nil {
+		return x.NullValues
+	}
+	return nil
+}
+
 type NumberField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -525,12 +1818,21 @@ type NumberField struct {
 	// Numbers are encoded different ways into the fixed width file.
 	Encoding   Encoding `protobuf:"varint,1,opt,name=encoding,proto3,enum=flatfile.v1.Encoding" json:"encoding,omitempty"`
 	FixedScale int32    `protobuf:"varint,2,opt,name=fixed_scale,json=fixedScale,proto3" json:"fixed_scale,omitempty"`
+	// When set, the number may not have more than this many significant
+	// digits, counting both sides of the decimal point. Leading zeros are
+	// padding and are not counted.
+	MaxDigits uint32 `protobuf:"varint,3,opt,name=max_digits,json=maxDigits,proto3" json:"max_digits,omitempty"`
+	// When set, the number may not have more than this many digits after the
+	// decimal point. Set to 0 to reject a decimal point in an integer column.
+	MaxFractionDigits *uint32 `protobuf:"varint,4,opt,name=max_fraction_digits,json=maxFractionDigits,proto3,oneof" json:"max_fraction_digits,omitempty"`
+	// How to treat characters which are not part of a number.
+	Policy NumberPolicy `protobuf:"varint,5,opt,name=policy,proto3,enum=flatfile.v1.NumberPolicy" json:"policy,omitempty"`
 }
 
 func (x *NumberField) Reset() {
 	*x = NumberField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flatfile_v1_annotations_proto_msgTypes[5]
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -543,7 +1845,7 @@ func (x *NumberField) String() string {
 func (*NumberField) ProtoMessage() {}
 
 func (x *NumberField) ProtoReflect() protoreflect.Message {
-	mi := &file_flatfile_v1_annotations_proto_msgTypes[5]
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +1858,7 @@ func (x *NumberField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NumberField.ProtoReflect.Descriptor instead.
 func (*NumberField) Descriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{5}
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NumberField) GetEncoding() Encoding {
@@ -573,6 +1875,104 @@ func (x *NumberField) GetFixedScale() int32 {
 	return 0
 }
 
+func (x *NumberField) GetMaxDigits() uint32 {
+	if x != nil {
+		return x.MaxDigits
+	}
+	return 0
+}
+
+func (x *NumberField) GetMaxFractionDigits() uint32 {
+	if x != nil && x.MaxFractionDigits != nil {
+		return *x.MaxFractionDigits
+	}
+	return 0
+}
+
+func (x *NumberField) GetPolicy() NumberPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return NumberPolicy_NUMBER_POLICY_UNSPECIFIED
+}
+
+type EnumField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Trims spaces from the raw value before matching keys.
+	Trim Trim `protobuf:"varint,1,opt,name=trim,proto3,enum=flatfile.v1.Trim" json:"trim,omitempty"`
+	// When true, keys match regardless of case.
+	CaseInsensitive bool `protobuf:"varint,2,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// What to do with a value which matches no key. Use raw_field on the
+	// Field to keep the original code.
+	TreatUnknownAs UnknownIs `protobuf:"varint,3,opt,name=treat_unknown_as,json=treatUnknownAs,proto3,enum=flatfile.v1.UnknownIs" json:"treat_unknown_as,omitempty"`
+	// The name of the enum value (e.g. "STATUS_OTHER") used for
+	// UNKNOWN_IS_FALLBACK.
+	FallbackValue string `protobuf:"bytes,4,opt,name=fallback_value,json=fallbackValue,proto3" json:"fallback_value,omitempty"`
+}
+
+func (x *EnumField) Reset() {
+	*x = EnumField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumField) ProtoMessage() {}
+
+func (x *EnumField) ProtoReflect() protoreflect.Message {
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumField.ProtoReflect.Descriptor instead.
+func (*EnumField) Descriptor() ([]byte, []int) {
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EnumField) GetTrim() Trim {
+	if x != nil {
+		return x.Trim
+	}
+	return Trim_TRIM_UNSPECIFIED
+}
+
+func (x *EnumField) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *EnumField) GetTreatUnknownAs() UnknownIs {
+	if x != nil {
+		return x.TreatUnknownAs
+	}
+	return UnknownIs_UNKNOWN_IS_UNSPECIFIED
+}
+
+func (x *EnumField) GetFallbackValue() string {
+	if x != nil {
+		return x.FallbackValue
+	}
+	return ""
+}
+
 type Enum struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -584,7 +1984,7 @@ type Enum struct {
 func (x *Enum) Reset() {
 	*x = Enum{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flatfile_v1_annotations_proto_msgTypes[6]
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -597,7 +1997,7 @@ func (x *Enum) String() string {
 func (*Enum) ProtoMessage() {}
 
 func (x *Enum) ProtoReflect() protoreflect.Message {
-	mi := &file_flatfile_v1_annotations_proto_msgTypes[6]
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +2010,7 @@ func (x *Enum) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Enum.ProtoReflect.Descriptor instead.
 func (*Enum) Descriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{6}
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Enum) GetKey() string {
@@ -636,12 +2036,16 @@ type DateField struct {
 	// space strings, all 0s etc will be automatically handled, but e.g. some
 	// date fields have "00711111" as the 'empty' value.
 	ZeroVals []string `protobuf:"bytes,5,rep,name=zero_vals,json=zeroVals,proto3" json:"zero_vals,omitempty"`
+	// For formats with two digit years (YY), years below the pivot are in the
+	// 2000s and the others in the 1900s, e.g. 50 reads "49" as 2049 and "50" as
+	// 1950. Unset reads years below 69 as in the 2000s.
+	CenturyPivot *uint32 `protobuf:"varint,6,opt,name=century_pivot,json=centuryPivot,proto3,oneof" json:"century_pivot,omitempty"`
 }
 
 func (x *DateField) Reset() {
 	*x = DateField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flatfile_v1_annotations_proto_msgTypes[7]
+		mi := &file_flatfile_v1_annotations_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -654,7 +2058,7 @@ func (x *DateField) String() string {
 func (*DateField) ProtoMessage() {}
 
 func (x *DateField) ProtoReflect() protoreflect.Message {
-	mi := &file_flatfile_v1_annotations_proto_msgTypes[7]
+	mi := &file_flatfile_v1_annotations_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +2071,7 @@ func (x *DateField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateField.ProtoReflect.Descriptor instead.
 func (*DateField) Descriptor() ([]byte, []int) {
-	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{7}
+	return file_flatfile_v1_annotations_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DateField) GetFormat() string {
@@ -684,6 +2088,13 @@ func (x *DateField) GetZeroVals() []string {
 	return nil
 }
 
+func (x *DateField) GetCenturyPivot() uint32 {
+	if x != nil && x.CenturyPivot != nil {
+		return *x.CenturyPivot
+	}
+	return 0
+}
+
 var file_flatfile_v1_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
@@ -736,97 +2147,346 @@ var file_flatfile_v1_annotations_proto_rawDesc = []byte{
 	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0b, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26,
-	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x65,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x6e,
-	0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x22, 0x3c, 0x0a, 0x0a, 0x46, 0x69, 0x78, 0x65, 0x64, 0x57,
-	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65,
-	0x6e, 0x67, 0x74, 0x68, 0x22, 0x93, 0x02, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x38,
-	0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x52, 0x0a, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66,
-	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x04,
-	0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61,
-	0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66,
-	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66,
-	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x53, 0x0a, 0x0b, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x72, 0x69,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x04, 0x74, 0x72, 0x69, 0x6d,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x69, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x73, 0x22,
-	0x91, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x75, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x12, 0x40, 0x0a, 0x10, 0x74, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x5f, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x6c,
-	0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
-	0x67, 0x49, 0x73, 0x52, 0x0e, 0x74, 0x72, 0x65, 0x61, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
-	0x67, 0x41, 0x73, 0x22, 0x61, 0x0a, 0x0b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63,
-	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x18, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x22, 0x40, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x76, 0x61,
-	0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x7a, 0x65, 0x72, 0x6f, 0x56, 0x61,
-	0x6c, 0x73, 0x2a, 0x4a, 0x0a, 0x04, 0x54, 0x72, 0x69, 0x6d, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52,
-	0x49, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0d, 0x0a, 0x09, 0x54, 0x52, 0x49, 0x4d, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x01, 0x12,
-	0x0e, 0x0a, 0x0a, 0x54, 0x52, 0x49, 0x4d, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x12,
-	0x0d, 0x0a, 0x09, 0x54, 0x52, 0x49, 0x4d, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x2a, 0x68,
-	0x0a, 0x09, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x4d,
-	0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x49, 0x53, 0x53, 0x49,
-	0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a,
-	0x0f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x54, 0x52, 0x55, 0x45,
-	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53,
-	0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0x03, 0x2a, 0x6e, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
-	0x0a, 0x17, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45,
-	0x44, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45,
-	0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x50, 0x55, 0x4e, 0x43,
-	0x48, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f,
-	0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x03, 0x3a, 0x52, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa3, 0xb3, 0x93, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x4a, 0x0a, 0x05,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa4, 0xb3, 0x93, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4b, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d,
-	0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0xa5, 0xb3, 0x93, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66,
-	0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52,
-	0x04, 0x65, 0x6e, 0x75, 0x6d, 0x42, 0x52, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x74,
-	0x66, 0x69, 0x6c, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x62,
-	0xf2, 0x85, 0x8f, 0x02, 0x14, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x6c, 0x69, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4,
+	0x05, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e,
+	0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f,
+	0x6e, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x12,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x08, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x74, 0x72, 0x65, 0x61, 0x74,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x52, 0x0f, 0x74, 0x72, 0x65,
+	0x61, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x6f,
+	0x6e, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x6f, 0x6e,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x34,
+	0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x54, 0x72, 0x69, 0x6d, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x66, 0x6c, 0x61,
+	0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x66, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x40, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x0a, 0x46, 0x69, 0x78, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x22, 0xc5, 0x05, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x64,
+	0x69, 0x67, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61,
+	0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69,
+	0x67, 0x69, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x67, 0x69, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x77, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x08,
+	0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x07, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3a,
+	0x0a, 0x0e, 0x74, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x73, 0x52, 0x0c, 0x74, 0x72,
+	0x65, 0x61, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x52,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x6f, 0x6f,
+	0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48,
+	0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48,
+	0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x65, 0x6e, 0x75,
+	0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48,
+	0x00, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x48, 0x00, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x22, 0xa3,
+	0x01, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x3c, 0x0a, 0x09, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x68, 0x65, 0x78, 0x22, 0xae, 0x04, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x72, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x04, 0x74, 0x72, 0x69, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x72, 0x69, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x72, 0x69, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x66, 0x6c,
+	0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x73, 0x65,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x34, 0x0a, 0x09, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x09, 0x6e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x3a, 0x0a,
+	0x0e, 0x74, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x49, 0x73, 0x52, 0x0c, 0x74, 0x72, 0x65,
+	0x61, 0x74, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x41, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x02, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x75, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x73,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x10, 0x74, 0x72, 0x65, 0x61, 0x74,
+	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x52, 0x0e, 0x74, 0x72, 0x65, 0x61, 0x74,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x73,
+	0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x72, 0x69, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x04, 0x74, 0x72, 0x69, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x80, 0x02, 0x0a,
+	0x0b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x08,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65, 0x64, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x67, 0x69, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x11,
+	0x6d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x69, 0x74,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x22,
+	0xc6, 0x01, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a,
+	0x04, 0x74, 0x72, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x6c,
+	0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x04,
+	0x74, 0x72, 0x69, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x63, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x40, 0x0a, 0x10, 0x74, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x5f, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x74,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x49,
+	0x73, 0x52, 0x0e, 0x74, 0x72, 0x65, 0x61, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x7c, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x7a, 0x65, 0x72, 0x6f, 0x5f,
+	0x76, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x7a, 0x65, 0x72, 0x6f,
+	0x56, 0x61, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x79, 0x5f,
+	0x70, 0x69, 0x76, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c, 0x63,
+	0x65, 0x6e, 0x74, 0x75, 0x72, 0x79, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x79, 0x5f, 0x70, 0x69, 0x76, 0x6f, 0x74,
+	0x2a, 0x8d, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
+	0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x4f, 0x4c, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x55,
+	0x4d, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x54,
+	0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x03,
+	0x2a, 0x73, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x12, 0x1b,
+	0x0a, 0x17, 0x54, 0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54,
+	0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47,
+	0x5f, 0x49, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x54,
+	0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55,
+	0x52, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x4e, 0x0a, 0x08, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43,
+	0x4f, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x57, 0x41, 0x52, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0x72, 0x0a, 0x0a, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x4e, 0x47, 0x54,
+	0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x55, 0x4d, 0x10, 0x02,
+	0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x4d, 0x41, 0x58, 0x49, 0x4d, 0x55, 0x4d, 0x10, 0x03, 0x2a, 0x4e, 0x0a, 0x08, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x07, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x49, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x5f, 0x49, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x5f, 0x49, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x5f, 0x49, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x45, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x5f,
+	0x49, 0x53, 0x5f, 0x50, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x6b, 0x0a, 0x0a, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x67, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x5f, 0x44, 0x49, 0x47, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
+	0x44, 0x49, 0x47, 0x49, 0x54, 0x5f, 0x4c, 0x55, 0x48, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x49, 0x47, 0x49, 0x54, 0x5f, 0x41, 0x42, 0x41, 0x10,
+	0x02, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x49, 0x47, 0x49, 0x54,
+	0x5f, 0x4d, 0x4f, 0x44, 0x31, 0x31, 0x10, 0x03, 0x2a, 0x8f, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x22,
+	0x0a, 0x1e, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52,
+	0x49, 0x54, 0x48, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f, 0x41,
+	0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x43, 0x52, 0x43, 0x31, 0x36, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x47,
+	0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x10, 0x02, 0x12, 0x1a,
+	0x0a, 0x16, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52,
+	0x49, 0x54, 0x48, 0x4d, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x03, 0x2a, 0x4b, 0x0a, 0x07, 0x42, 0x6c,
+	0x61, 0x6e, 0x6b, 0x49, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x5f, 0x49,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x5f, 0x49, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x45,
+	0x54, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x5f, 0x49, 0x53, 0x5f,
+	0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x02, 0x2a, 0x65, 0x0a, 0x09, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x49, 0x5a,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x5f, 0x55, 0x50, 0x50,
+	0x45, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x49, 0x5a,
+	0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x4f, 0x52,
+	0x4d, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x03, 0x2a, 0x9e,
+	0x01, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x1d, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f,
+	0x4e, 0x55, 0x4d, 0x45, 0x52, 0x49, 0x43, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41,
+	0x52, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41,
+	0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c,
+	0x41, 0x53, 0x53, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x49, 0x43,
+	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c,
+	0x41, 0x53, 0x53, 0x5f, 0x50, 0x52, 0x49, 0x4e, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x2a,
+	0x62, 0x0a, 0x04, 0x54, 0x72, 0x69, 0x6d, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x49, 0x4d, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x54, 0x52, 0x49, 0x4d, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x54, 0x52, 0x49, 0x4d, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x54, 0x52, 0x49, 0x4d, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54,
+	0x52, 0x49, 0x4d, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x50, 0x41, 0x44, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x04, 0x2a, 0x68, 0x0a, 0x09, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x73,
+	0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x53,
+	0x5f, 0x54, 0x52, 0x55, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4e, 0x47, 0x5f, 0x49, 0x53, 0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0x03, 0x2a, 0x62, 0x0a,
+	0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a,
+	0x19, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x54,
+	0x52, 0x49, 0x43, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x41, 0x4c, 0x56, 0x41, 0x47, 0x45, 0x10,
+	0x02, 0x2a, 0x6e, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a,
+	0x14, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x43, 0x4f, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x4d,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x4f, 0x56, 0x45, 0x52, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
+	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10,
+	0x03, 0x2a, 0x6b, 0x0a, 0x09, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x49, 0x73, 0x12, 0x1a,
+	0x0a, 0x16, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x5a,
+	0x45, 0x52, 0x4f, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x49, 0x53, 0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x3a, 0x52,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa3, 0xb3, 0x93, 0x2c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x3a, 0x4a, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa4, 0xb3, 0x93, 0x2c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4b,
+	0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa5, 0xb3, 0x93, 0x2c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x42, 0x52, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70,
+	0x73, 0x2f, 0x66, 0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x66,
+	0x6c, 0x61, 0x74, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x61, 0x74, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0xf2, 0x85, 0x8f, 0x02, 0x14, 0x0a, 0x12, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x6c, 0x69, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -841,44 +2501,88 @@ func file_flatfile_v1_annotations_proto_rawDescGZIP() []byte {
 	return file_flatfile_v1_annotations_proto_rawDescData
 }
 
-var file_flatfile_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_flatfile_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_flatfile_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_flatfile_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_flatfile_v1_annotations_proto_goTypes = []any{
-	(Trim)(0),                             // 0: flatfile.v1.Trim
-	(MissingIs)(0),                        // 1: flatfile.v1.MissingIs
-	(Encoding)(0),                         // 2: flatfile.v1.Encoding
-	(*Message)(nil),                       // 3: flatfile.v1.Message
-	(*FixedWidth)(nil),                    // 4: flatfile.v1.FixedWidth
-	(*Field)(nil),                         // 5: flatfile.v1.Field
-	(*StringField)(nil),                   // 6: flatfile.v1.StringField
-	(*BoolField)(nil),                     // 7: flatfile.v1.BoolField
-	(*NumberField)(nil),                   // 8: flatfile.v1.NumberField
-	(*Enum)(nil),                          // 9: flatfile.v1.Enum
-	(*DateField)(nil),                     // 10: flatfile.v1.DateField
-	(*descriptorpb.MessageOptions)(nil),   // 11: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),     // 12: google.protobuf.FieldOptions
-	(*descriptorpb.EnumValueOptions)(nil), // 13: google.protobuf.EnumValueOptions
+	(ControlTotalKind)(0),                 // 0: flatfile.v1.ControlTotalKind
+	(TrailingIs)(0),                       // 1: flatfile.v1.TrailingIs
+	(Coverage)(0),                         // 2: flatfile.v1.Coverage
+	(LengthMode)(0),                       // 3: flatfile.v1.LengthMode
+	(Severity)(0),                         // 4: flatfile.v1.Severity
+	(ShortIs)(0),                          // 5: flatfile.v1.ShortIs
+	(CheckDigit)(0),                       // 6: flatfile.v1.CheckDigit
+	(ChecksumAlgorithm)(0),                // 7: flatfile.v1.ChecksumAlgorithm
+	(BlankIs)(0),                          // 8: flatfile.v1.BlankIs
+	(Normalize)(0),                        // 9: flatfile.v1.Normalize
+	(CharsetClass)(0),                     // 10: flatfile.v1.CharsetClass
+	(Trim)(0),                             // 11: flatfile.v1.Trim
+	(MissingIs)(0),                        // 12: flatfile.v1.MissingIs
+	(NumberPolicy)(0),                     // 13: flatfile.v1.NumberPolicy
+	(Encoding)(0),                         // 14: flatfile.v1.Encoding
+	(UnknownIs)(0),                        // 15: flatfile.v1.UnknownIs
+	(*Message)(nil),                       // 16: flatfile.v1.Message
+	(*ControlTotal)(nil),                  // 17: flatfile.v1.ControlTotal
+	(*Rule)(nil),                          // 18: flatfile.v1.Rule
+	(*FixedWidth)(nil),                    // 19: flatfile.v1.FixedWidth
+	(*Field)(nil),                         // 20: flatfile.v1.Field
+	(*CustomField)(nil),                   // 21: flatfile.v1.CustomField
+	(*Checksum)(nil),                      // 22: flatfile.v1.Checksum
+	(*StringField)(nil),                   // 23: flatfile.v1.StringField
+	(*BoolField)(nil),                     // 24: flatfile.v1.BoolField
+	(*NumberField)(nil),                   // 25: flatfile.v1.NumberField
+	(*EnumField)(nil),                     // 26: flatfile.v1.EnumField
+	(*Enum)(nil),                          // 27: flatfile.v1.Enum
+	(*DateField)(nil),                     // 28: flatfile.v1.DateField
+	nil,                                   // 29: flatfile.v1.StringField.MappingEntry
+	(*descriptorpb.MessageOptions)(nil),   // 30: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 31: google.protobuf.FieldOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 32: google.protobuf.EnumValueOptions
 }
 var file_flatfile_v1_annotations_proto_depIdxs = []int32{
-	4,  // 0: flatfile.v1.Field.fixed_width:type_name -> flatfile.v1.FixedWidth
-	6,  // 1: flatfile.v1.Field.string:type_name -> flatfile.v1.StringField
-	7,  // 2: flatfile.v1.Field.bool:type_name -> flatfile.v1.BoolField
-	10, // 3: flatfile.v1.Field.date:type_name -> flatfile.v1.DateField
-	8,  // 4: flatfile.v1.Field.number:type_name -> flatfile.v1.NumberField
-	0,  // 5: flatfile.v1.StringField.trim:type_name -> flatfile.v1.Trim
-	1,  // 6: flatfile.v1.BoolField.treat_missing_as:type_name -> flatfile.v1.MissingIs
-	2,  // 7: flatfile.v1.NumberField.encoding:type_name -> flatfile.v1.Encoding
-	11, // 8: flatfile.v1.message:extendee -> google.protobuf.MessageOptions
-	12, // 9: flatfile.v1.field:extendee -> google.protobuf.FieldOptions
-	13, // 10: flatfile.v1.enum:extendee -> google.protobuf.EnumValueOptions
-	3,  // 11: flatfile.v1.message:type_name -> flatfile.v1.Message
-	5,  // 12: flatfile.v1.field:type_name -> flatfile.v1.Field
-	9,  // 13: flatfile.v1.enum:type_name -> flatfile.v1.Enum
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	11, // [11:14] is the sub-list for extension type_name
-	8,  // [8:11] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	3,  // 0: flatfile.v1.Message.record_length_mode:type_name -> flatfile.v1.LengthMode
+	19, // 1: flatfile.v1.Message.filler:type_name -> flatfile.v1.FixedWidth
+	2,  // 2: flatfile.v1.Message.coverage:type_name -> flatfile.v1.Coverage
+	1,  // 3: flatfile.v1.Message.treat_trailing_as:type_name -> flatfile.v1.TrailingIs
+	18, // 4: flatfile.v1.Message.rules:type_name -> flatfile.v1.Rule
+	4,  // 5: flatfile.v1.Message.on_duplicate_key:type_name -> flatfile.v1.Severity
+	17, // 6: flatfile.v1.Message.control_totals:type_name -> flatfile.v1.ControlTotal
+	11, // 7: flatfile.v1.Message.default_trim:type_name -> flatfile.v1.Trim
+	0,  // 8: flatfile.v1.ControlTotal.kind:type_name -> flatfile.v1.ControlTotalKind
+	19, // 9: flatfile.v1.Field.fixed_width:type_name -> flatfile.v1.FixedWidth
+	6,  // 10: flatfile.v1.Field.check_digit:type_name -> flatfile.v1.CheckDigit
+	4,  // 11: flatfile.v1.Field.on_error:type_name -> flatfile.v1.Severity
+	5,  // 12: flatfile.v1.Field.treat_short_as:type_name -> flatfile.v1.ShortIs
+	22, // 13: flatfile.v1.Field.checksum:type_name -> flatfile.v1.Checksum
+	23, // 14: flatfile.v1.Field.string:type_name -> flatfile.v1.StringField
+	24, // 15: flatfile.v1.Field.bool:type_name -> flatfile.v1.BoolField
+	28, // 16: flatfile.v1.Field.date:type_name -> flatfile.v1.DateField
+	25, // 17: flatfile.v1.Field.number:type_name -> flatfile.v1.NumberField
+	26, // 18: flatfile.v1.Field.enum:type_name -> flatfile.v1.EnumField
+	21, // 19: flatfile.v1.Field.custom:type_name -> flatfile.v1.CustomField
+	7,  // 20: flatfile.v1.Checksum.algorithm:type_name -> flatfile.v1.ChecksumAlgorithm
+	19, // 21: flatfile.v1.Checksum.range:type_name -> flatfile.v1.FixedWidth
+	11, // 22: flatfile.v1.StringField.trim:type_name -> flatfile.v1.Trim
+	10, // 23: flatfile.v1.StringField.charset_class:type_name -> flatfile.v1.CharsetClass
+	29, // 24: flatfile.v1.StringField.mapping:type_name -> flatfile.v1.StringField.MappingEntry
+	9,  // 25: flatfile.v1.StringField.normalize:type_name -> flatfile.v1.Normalize
+	8,  // 26: flatfile.v1.StringField.treat_blank_as:type_name -> flatfile.v1.BlankIs
+	12, // 27: flatfile.v1.BoolField.treat_missing_as:type_name -> flatfile.v1.MissingIs
+	11, // 28: flatfile.v1.BoolField.trim:type_name -> flatfile.v1.Trim
+	14, // 29: flatfile.v1.NumberField.encoding:type_name -> flatfile.v1.Encoding
+	13, // 30: flatfile.v1.NumberField.policy:type_name -> flatfile.v1.NumberPolicy
+	11, // 31: flatfile.v1.EnumField.trim:type_name -> flatfile.v1.Trim
+	15, // 32: flatfile.v1.EnumField.treat_unknown_as:type_name -> flatfile.v1.UnknownIs
+	30, // 33: flatfile.v1.message:extendee -> google.protobuf.MessageOptions
+	31, // 34: flatfile.v1.field:extendee -> google.protobuf.FieldOptions
+	32, // 35: flatfile.v1.enum:extendee -> google.protobuf.EnumValueOptions
+	16, // 36: flatfile.v1.message:type_name -> flatfile.v1.Message
+	20, // 37: flatfile.v1.field:type_name -> flatfile.v1.Field
+	27, // 38: flatfile.v1.enum:type_name -> flatfile.v1.Enum
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	36, // [36:39] is the sub-list for extension type_name
+	33, // [33:36] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_flatfile_v1_annotations_proto_init() }
@@ -900,7 +2604,7 @@ func file_flatfile_v1_annotations_proto_init() {
 			}
 		}
 		file_flatfile_v1_annotations_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*FixedWidth); i {
+			switch v := v.(*ControlTotal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -912,7 +2616,7 @@ func file_flatfile_v1_annotations_proto_init() {
 			}
 		}
 		file_flatfile_v1_annotations_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Field); i {
+			switch v := v.(*Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -924,7 +2628,7 @@ func file_flatfile_v1_annotations_proto_init() {
 			}
 		}
 		file_flatfile_v1_annotations_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*StringField); i {
+			switch v := v.(*FixedWidth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -936,7 +2640,7 @@ func file_flatfile_v1_annotations_proto_init() {
 			}
 		}
 		file_flatfile_v1_annotations_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*BoolField); i {
+			switch v := v.(*Field); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -948,7 +2652,7 @@ func file_flatfile_v1_annotations_proto_init() {
 			}
 		}
 		file_flatfile_v1_annotations_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*NumberField); i {
+			switch v := v.(*CustomField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -960,7 +2664,7 @@ func file_flatfile_v1_annotations_proto_init() {
 			}
 		}
 		file_flatfile_v1_annotations_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*Enum); i {
+			switch v := v.(*Checksum); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -972,6 +2676,66 @@ func file_flatfile_v1_annotations_proto_init() {
 			}
 		}
 		file_flatfile_v1_annotations_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*StringField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_v1_annotations_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*BoolField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_v1_annotations_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*NumberField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_v1_annotations_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*EnumField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_v1_annotations_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Enum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flatfile_v1_annotations_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*DateField); i {
 			case 0:
 				return &v.state
@@ -984,19 +2748,23 @@ func file_flatfile_v1_annotations_proto_init() {
 			}
 		}
 	}
-	file_flatfile_v1_annotations_proto_msgTypes[2].OneofWrappers = []any{
+	file_flatfile_v1_annotations_proto_msgTypes[4].OneofWrappers = []any{
 		(*Field_String_)(nil),
 		(*Field_Bool)(nil),
 		(*Field_Date)(nil),
 		(*Field_Number)(nil),
+		(*Field_Enum)(nil),
+		(*Field_Custom)(nil),
 	}
+	file_flatfile_v1_annotations_proto_msgTypes[9].OneofWrappers = []any{}
+	file_flatfile_v1_annotations_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_flatfile_v1_annotations_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   8,
+			NumEnums:      16,
+			NumMessages:   14,
 			NumExtensions: 3,
 			NumServices:   0,
 		},
@@ -21,6 +21,28 @@ func (msg *Message) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
 
+func (msg *ControlTotal) Clone() any {
+	return proto.Clone(msg).(*ControlTotal)
+}
+func (msg *ControlTotal) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ControlTotal) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *Rule) Clone() any {
+	return proto.Clone(msg).(*Rule)
+}
+func (msg *Rule) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *Rule) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
 func (msg *FixedWidth) Clone() any {
 	return proto.Clone(msg).(*FixedWidth)
 }
@@ -46,6 +68,28 @@ func (msg *Field) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
 
+func (msg *CustomField) Clone() any {
+	return proto.Clone(msg).(*CustomField)
+}
+func (msg *CustomField) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CustomField) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *Checksum) Clone() any {
+	return proto.Clone(msg).(*Checksum)
+}
+func (msg *Checksum) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *Checksum) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
 func (msg *StringField) Clone() any {
 	return proto.Clone(msg).(*StringField)
 }
@@ -79,6 +123,17 @@ func (msg *NumberField) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
 
+func (msg *EnumField) Clone() any {
+	return proto.Clone(msg).(*EnumField)
+}
+func (msg *EnumField) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *EnumField) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
 func (msg *Enum) Clone() any {
 	return proto.Clone(msg).(*Enum)
 }
@@ -101,12 +156,608 @@ func (msg *DateField) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
 
+// ControlTotalKind
+const (
+	ControlTotalKind_UNSPECIFIED ControlTotalKind = 0
+	ControlTotalKind_COUNT       ControlTotalKind = 1
+	ControlTotalKind_SUM         ControlTotalKind = 2
+	ControlTotalKind_HASH        ControlTotalKind = 3
+)
+
+var (
+	ControlTotalKind_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "COUNT",
+		2: "SUM",
+		3: "HASH",
+	}
+	ControlTotalKind_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"COUNT":       1,
+		"SUM":         2,
+		"HASH":        3,
+	}
+	ControlTotalKind_value_either = map[string]int32{
+		"UNSPECIFIED":                    0,
+		"CONTROL_TOTAL_KIND_UNSPECIFIED": 0,
+		"COUNT":                          1,
+		"CONTROL_TOTAL_KIND_COUNT":       1,
+		"SUM":                            2,
+		"CONTROL_TOTAL_KIND_SUM":         2,
+		"HASH":                           3,
+		"CONTROL_TOTAL_KIND_HASH":        3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x ControlTotalKind) ShortString() string {
+	return ControlTotalKind_name_short[int32(x)]
+}
+func (x ControlTotalKind) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *ControlTotalKind) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := ControlTotalKind_value_either[strVal]
+	*x = ControlTotalKind(val)
+	return nil
+}
+
+// TrailingIs
+const (
+	TrailingIs_UNSPECIFIED TrailingIs = 0
+	TrailingIs_IGNORED     TrailingIs = 1
+	TrailingIs_ERROR       TrailingIs = 2
+	TrailingIs_CAPTURED    TrailingIs = 3
+)
+
+var (
+	TrailingIs_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "IGNORED",
+		2: "ERROR",
+		3: "CAPTURED",
+	}
+	TrailingIs_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"IGNORED":     1,
+		"ERROR":       2,
+		"CAPTURED":    3,
+	}
+	TrailingIs_value_either = map[string]int32{
+		"UNSPECIFIED":             0,
+		"TRAILING_IS_UNSPECIFIED": 0,
+		"IGNORED":                 1,
+		"TRAILING_IS_IGNORED":     1,
+		"ERROR":                   2,
+		"TRAILING_IS_ERROR":       2,
+		"CAPTURED":                3,
+		"TRAILING_IS_CAPTURED":    3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x TrailingIs) ShortString() string {
+	return TrailingIs_name_short[int32(x)]
+}
+func (x TrailingIs) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *TrailingIs) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := TrailingIs_value_either[strVal]
+	*x = TrailingIs(val)
+	return nil
+}
+
+// Coverage
+const (
+	Coverage_UNSPECIFIED Coverage = 0
+	Coverage_ERROR       Coverage = 1
+	Coverage_WARNING     Coverage = 2
+)
+
+var (
+	Coverage_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "ERROR",
+		2: "WARNING",
+	}
+	Coverage_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"ERROR":       1,
+		"WARNING":     2,
+	}
+	Coverage_value_either = map[string]int32{
+		"UNSPECIFIED":          0,
+		"COVERAGE_UNSPECIFIED": 0,
+		"ERROR":                1,
+		"COVERAGE_ERROR":       1,
+		"WARNING":              2,
+		"COVERAGE_WARNING":     2,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x Coverage) ShortString() string {
+	return Coverage_name_short[int32(x)]
+}
+func (x Coverage) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *Coverage) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := Coverage_value_either[strVal]
+	*x = Coverage(val)
+	return nil
+}
+
+// LengthMode
+const (
+	LengthMode_UNSPECIFIED LengthMode = 0
+	LengthMode_EXACT       LengthMode = 1
+	LengthMode_MINIMUM     LengthMode = 2
+	LengthMode_MAXIMUM     LengthMode = 3
+)
+
+var (
+	LengthMode_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "EXACT",
+		2: "MINIMUM",
+		3: "MAXIMUM",
+	}
+	LengthMode_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"EXACT":       1,
+		"MINIMUM":     2,
+		"MAXIMUM":     3,
+	}
+	LengthMode_value_either = map[string]int32{
+		"UNSPECIFIED":             0,
+		"LENGTH_MODE_UNSPECIFIED": 0,
+		"EXACT":                   1,
+		"LENGTH_MODE_EXACT":       1,
+		"MINIMUM":                 2,
+		"LENGTH_MODE_MINIMUM":     2,
+		"MAXIMUM":                 3,
+		"LENGTH_MODE_MAXIMUM":     3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x LengthMode) ShortString() string {
+	return LengthMode_name_short[int32(x)]
+}
+func (x LengthMode) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *LengthMode) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := LengthMode_value_either[strVal]
+	*x = LengthMode(val)
+	return nil
+}
+
+// Severity
+const (
+	Severity_UNSPECIFIED Severity = 0
+	Severity_ERROR       Severity = 1
+	Severity_WARNING     Severity = 2
+)
+
+var (
+	Severity_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "ERROR",
+		2: "WARNING",
+	}
+	Severity_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"ERROR":       1,
+		"WARNING":     2,
+	}
+	Severity_value_either = map[string]int32{
+		"UNSPECIFIED":          0,
+		"SEVERITY_UNSPECIFIED": 0,
+		"ERROR":                1,
+		"SEVERITY_ERROR":       1,
+		"WARNING":              2,
+		"SEVERITY_WARNING":     2,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x Severity) ShortString() string {
+	return Severity_name_short[int32(x)]
+}
+func (x Severity) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *Severity) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := Severity_value_either[strVal]
+	*x = Severity(val)
+	return nil
+}
+
+// ShortIs
+const (
+	ShortIs_UNSPECIFIED ShortIs = 0
+	ShortIs_ERROR       ShortIs = 1
+	ShortIs_UNSET       ShortIs = 2
+	ShortIs_PADDED      ShortIs = 3
+)
+
+var (
+	ShortIs_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "ERROR",
+		2: "UNSET",
+		3: "PADDED",
+	}
+	ShortIs_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"ERROR":       1,
+		"UNSET":       2,
+		"PADDED":      3,
+	}
+	ShortIs_value_either = map[string]int32{
+		"UNSPECIFIED":          0,
+		"SHORT_IS_UNSPECIFIED": 0,
+		"ERROR":                1,
+		"SHORT_IS_ERROR":       1,
+		"UNSET":                2,
+		"SHORT_IS_UNSET":       2,
+		"PADDED":               3,
+		"SHORT_IS_PADDED":      3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x ShortIs) ShortString() string {
+	return ShortIs_name_short[int32(x)]
+}
+func (x ShortIs) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *ShortIs) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := ShortIs_value_either[strVal]
+	*x = ShortIs(val)
+	return nil
+}
+
+// CheckDigit
+const (
+	CheckDigit_UNSPECIFIED CheckDigit = 0
+	CheckDigit_LUHN        CheckDigit = 1
+	CheckDigit_ABA         CheckDigit = 2
+	CheckDigit_MOD11       CheckDigit = 3
+)
+
+var (
+	CheckDigit_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "LUHN",
+		2: "ABA",
+		3: "MOD11",
+	}
+	CheckDigit_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"LUHN":        1,
+		"ABA":         2,
+		"MOD11":       3,
+	}
+	CheckDigit_value_either = map[string]int32{
+		"UNSPECIFIED":             0,
+		"CHECK_DIGIT_UNSPECIFIED": 0,
+		"LUHN":                    1,
+		"CHECK_DIGIT_LUHN":        1,
+		"ABA":                     2,
+		"CHECK_DIGIT_ABA":         2,
+		"MOD11":                   3,
+		"CHECK_DIGIT_MOD11":       3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x CheckDigit) ShortString() string {
+	return CheckDigit_name_short[int32(x)]
+}
+func (x CheckDigit) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *CheckDigit) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := CheckDigit_value_either[strVal]
+	*x = CheckDigit(val)
+	return nil
+}
+
+// ChecksumAlgorithm
+const (
+	ChecksumAlgorithm_UNSPECIFIED ChecksumAlgorithm = 0
+	ChecksumAlgorithm_CRC16       ChecksumAlgorithm = 1
+	ChecksumAlgorithm_CRC32       ChecksumAlgorithm = 2
+	ChecksumAlgorithm_SUM         ChecksumAlgorithm = 3
+)
+
+var (
+	ChecksumAlgorithm_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "CRC16",
+		2: "CRC32",
+		3: "SUM",
+	}
+	ChecksumAlgorithm_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"CRC16":       1,
+		"CRC32":       2,
+		"SUM":         3,
+	}
+	ChecksumAlgorithm_value_either = map[string]int32{
+		"UNSPECIFIED":                    0,
+		"CHECKSUM_ALGORITHM_UNSPECIFIED": 0,
+		"CRC16":                          1,
+		"CHECKSUM_ALGORITHM_CRC16":       1,
+		"CRC32":                          2,
+		"CHECKSUM_ALGORITHM_CRC32":       2,
+		"SUM":                            3,
+		"CHECKSUM_ALGORITHM_SUM":         3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x ChecksumAlgorithm) ShortString() string {
+	return ChecksumAlgorithm_name_short[int32(x)]
+}
+func (x ChecksumAlgorithm) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *ChecksumAlgorithm) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := ChecksumAlgorithm_value_either[strVal]
+	*x = ChecksumAlgorithm(val)
+	return nil
+}
+
+// BlankIs
+const (
+	BlankIs_UNSPECIFIED BlankIs = 0
+	BlankIs_UNSET       BlankIs = 1
+	BlankIs_EMPTY       BlankIs = 2
+)
+
+var (
+	BlankIs_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "UNSET",
+		2: "EMPTY",
+	}
+	BlankIs_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"UNSET":       1,
+		"EMPTY":       2,
+	}
+	BlankIs_value_either = map[string]int32{
+		"UNSPECIFIED":          0,
+		"BLANK_IS_UNSPECIFIED": 0,
+		"UNSET":                1,
+		"BLANK_IS_UNSET":       1,
+		"EMPTY":                2,
+		"BLANK_IS_EMPTY":       2,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x BlankIs) ShortString() string {
+	return BlankIs_name_short[int32(x)]
+}
+func (x BlankIs) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *BlankIs) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := BlankIs_value_either[strVal]
+	*x = BlankIs(val)
+	return nil
+}
+
+// Normalize
+const (
+	Normalize_UNSPECIFIED Normalize = 0
+	Normalize_UPPER       Normalize = 1
+	Normalize_LOWER       Normalize = 2
+	Normalize_TITLE       Normalize = 3
+)
+
+var (
+	Normalize_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "UPPER",
+		2: "LOWER",
+		3: "TITLE",
+	}
+	Normalize_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"UPPER":       1,
+		"LOWER":       2,
+		"TITLE":       3,
+	}
+	Normalize_value_either = map[string]int32{
+		"UNSPECIFIED":           0,
+		"NORMALIZE_UNSPECIFIED": 0,
+		"UPPER":                 1,
+		"NORMALIZE_UPPER":       1,
+		"LOWER":                 2,
+		"NORMALIZE_LOWER":       2,
+		"TITLE":                 3,
+		"NORMALIZE_TITLE":       3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x Normalize) ShortString() string {
+	return Normalize_name_short[int32(x)]
+}
+func (x Normalize) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *Normalize) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := Normalize_value_either[strVal]
+	*x = Normalize(val)
+	return nil
+}
+
+// CharsetClass
+const (
+	CharsetClass_UNSPECIFIED  CharsetClass = 0
+	CharsetClass_NUMERIC      CharsetClass = 1
+	CharsetClass_ALPHA        CharsetClass = 2
+	CharsetClass_ALPHANUMERIC CharsetClass = 3
+	CharsetClass_PRINTABLE    CharsetClass = 4
+)
+
+var (
+	CharsetClass_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "NUMERIC",
+		2: "ALPHA",
+		3: "ALPHANUMERIC",
+		4: "PRINTABLE",
+	}
+	CharsetClass_value_short = map[string]int32{
+		"UNSPECIFIED":  0,
+		"NUMERIC":      1,
+		"ALPHA":        2,
+		"ALPHANUMERIC": 3,
+		"PRINTABLE":    4,
+	}
+	CharsetClass_value_either = map[string]int32{
+		"UNSPECIFIED":                0,
+		"CHARSET_CLASS_UNSPECIFIED":  0,
+		"NUMERIC":                    1,
+		"CHARSET_CLASS_NUMERIC":      1,
+		"ALPHA":                      2,
+		"CHARSET_CLASS_ALPHA":        2,
+		"ALPHANUMERIC":               3,
+		"CHARSET_CLASS_ALPHANUMERIC": 3,
+		"PRINTABLE":                  4,
+		"CHARSET_CLASS_PRINTABLE":    4,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x CharsetClass) ShortString() string {
+	return CharsetClass_name_short[int32(x)]
+}
+func (x CharsetClass) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *CharsetClass) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := CharsetClass_value_either[strVal]
+	*x = CharsetClass(val)
+	return nil
+}
+
 // Trim
 const (
-	Trim_UNSPECIFIED Trim = 0
-	Trim_LEFT        Trim = 1
-	Trim_RIGHT       Trim = 2
-	Trim_BOTH        Trim = 3
+	Trim_UNSPECIFIED   Trim = 0
+	Trim_LEFT          Trim = 1
+	Trim_RIGHT         Trim = 2
+	Trim_BOTH          Trim = 3
+	Trim_RIGHT_PADDING Trim = 4
 )
 
 var (
@@ -115,22 +766,26 @@ var (
 		1: "LEFT",
 		2: "RIGHT",
 		3: "BOTH",
+		4: "RIGHT_PADDING",
 	}
 	Trim_value_short = map[string]int32{
-		"UNSPECIFIED": 0,
-		"LEFT":        1,
-		"RIGHT":       2,
-		"BOTH":        3,
+		"UNSPECIFIED":   0,
+		"LEFT":          1,
+		"RIGHT":         2,
+		"BOTH":          3,
+		"RIGHT_PADDING": 4,
 	}
 	Trim_value_either = map[string]int32{
-		"UNSPECIFIED":      0,
-		"TRIM_UNSPECIFIED": 0,
-		"LEFT":             1,
-		"TRIM_LEFT":        1,
-		"RIGHT":            2,
-		"TRIM_RIGHT":       2,
-		"BOTH":             3,
-		"TRIM_BOTH":        3,
+		"UNSPECIFIED":        0,
+		"TRIM_UNSPECIFIED":   0,
+		"LEFT":               1,
+		"TRIM_LEFT":          1,
+		"RIGHT":              2,
+		"TRIM_RIGHT":         2,
+		"BOTH":               3,
+		"TRIM_BOTH":          3,
+		"RIGHT_PADDING":      4,
+		"TRIM_RIGHT_PADDING": 4,
 	}
 )
 
@@ -211,6 +866,56 @@ func (x *MissingIs) Scan(value interface{}) error {
 	return nil
 }
 
+// NumberPolicy
+const (
+	NumberPolicy_UNSPECIFIED NumberPolicy = 0
+	NumberPolicy_STRICT      NumberPolicy = 1
+	NumberPolicy_SALVAGE     NumberPolicy = 2
+)
+
+var (
+	NumberPolicy_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "STRICT",
+		2: "SALVAGE",
+	}
+	NumberPolicy_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"STRICT":      1,
+		"SALVAGE":     2,
+	}
+	NumberPolicy_value_either = map[string]int32{
+		"UNSPECIFIED":               0,
+		"NUMBER_POLICY_UNSPECIFIED": 0,
+		"STRICT":                    1,
+		"NUMBER_POLICY_STRICT":      1,
+		"SALVAGE":                   2,
+		"NUMBER_POLICY_SALVAGE":     2,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x NumberPolicy) ShortString() string {
+	return NumberPolicy_name_short[int32(x)]
+}
+func (x NumberPolicy) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *NumberPolicy) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := NumberPolicy_value_either[strVal]
+	*x = NumberPolicy(val)
+	return nil
+}
+
 // Encoding
 const (
 	Encoding_UNSPECIFIED    Encoding = 0
@@ -265,3 +970,58 @@ func (x *Encoding) Scan(value interface{}) error {
 	*x = Encoding(val)
 	return nil
 }
+
+// UnknownIs
+const (
+	UnknownIs_UNSPECIFIED UnknownIs = 0
+	UnknownIs_ERROR       UnknownIs = 1
+	UnknownIs_ZERO        UnknownIs = 2
+	UnknownIs_FALLBACK    UnknownIs = 3
+)
+
+var (
+	UnknownIs_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "ERROR",
+		2: "ZERO",
+		3: "FALLBACK",
+	}
+	UnknownIs_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"ERROR":       1,
+		"ZERO":        2,
+		"FALLBACK":    3,
+	}
+	UnknownIs_value_either = map[string]int32{
+		"UNSPECIFIED":            0,
+		"UNKNOWN_IS_UNSPECIFIED": 0,
+		"ERROR":                  1,
+		"UNKNOWN_IS_ERROR":       1,
+		"ZERO":                   2,
+		"UNKNOWN_IS_ZERO":        2,
+		"FALLBACK":               3,
+		"UNKNOWN_IS_FALLBACK":    3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x UnknownIs) ShortString() string {
+	return UnknownIs_name_short[int32(x)]
+}
+func (x UnknownIs) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *UnknownIs) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := UnknownIs_value_either[strVal]
+	*x = UnknownIs(val)
+	return nil
+}
@@ -3,26 +3,33 @@ module github.com/pentops/flatfile
 go 1.25.3
 
 require (
+	buf.build/go/protovalidate v1.1.0
+	github.com/bufbuild/protocompile v0.14.1
+	github.com/google/cel-go v0.26.1
+	github.com/google/uuid v1.6.0
 	github.com/pentops/flowtest v0.0.0-20260213024423-0a79a287d66b
-	github.com/pentops/golib v0.0.0-20250326060930-8c83d58ddb63
 	github.com/pentops/j5 v0.0.0-20260204020332-0f19e0035543
 	github.com/shopspring/decimal v1.4.0
+	github.com/spf13/cobra v1.10.2
+	golang.org/x/exp v0.0.0-20251219203646-944ab1f22d93
+	golang.org/x/term v0.38.0
 	google.golang.org/protobuf v1.36.11
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.11-20251209175733-2a1774d88802.1 // indirect
-	buf.build/go/protovalidate v1.1.0 // indirect
 	cel.dev/expr v0.25.1 // indirect
-	github.com/bufbuild/protocompile v0.14.1 // indirect
+	github.com/antlr4-go/antlr/v4 v4.13.1 // indirect
 	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/google/go-cmp v0.7.0 // indirect
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware v1.4.0 // indirect
 	github.com/iancoleman/strcase v0.3.0 // indirect
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/jhump/protoreflect v1.17.0 // indirect
+	github.com/spf13/pflag v1.0.9 // indirect
+	github.com/stoewer/go-strcase v1.3.1 // indirect
 	go.opentelemetry.io/otel v1.39.0 // indirect
-	golang.org/x/exp v0.0.0-20251219203646-944ab1f22d93 // indirect
 	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
@@ -0,0 +1,224 @@
+package layoutdef
+
+import (
+	"github.com/pentops/flatfile/binfile"
+)
+
+// MessageBuilder builds a layout in code, for layouts which are generated or
+// read from a database:
+//
+//	parser, err := layoutdef.NewMessage("partner.v1.Record").
+//		String("name", 0, 30, layoutdef.Trim("right")).
+//		Decimal("amount", 30, 12, layoutdef.Scale(2)).
+//		Build()
+//
+// Offsets are zero based unless OneBased is called. Options take the values
+// of the layout properties, and are checked by Build.
+type MessageBuilder struct {
+	layout *binfile.MessageLayout
+}
+
+// FieldOption sets a property of a field.
+type FieldOption func(*binfile.FieldLayout)
+
+// NewMessage starts a layout for the message with the full name, including
+// the package.
+func NewMessage(name string) *MessageBuilder {
+	return &MessageBuilder{layout: &binfile.MessageLayout{
+		Message: name,
+		Fields:  []binfile.FieldLayout{},
+	}}
+}
+
+// OneBased counts the first byte of the record as offset 1.
+func (b *MessageBuilder) OneBased() *MessageBuilder {
+	b.layout.OneBased = true
+	return b
+}
+
+// RecordLength checks the length of records, exactly unless mode is minimum
+// or maximum.
+func (b *MessageBuilder) RecordLength(length int, mode string) *MessageBuilder {
+	b.layout.RecordLength = length
+	b.layout.RecordLengthMode = mode
+	return b
+}
+
+// Description documents the message.
+func (b *MessageBuilder) Description(description string) *MessageBuilder {
+	b.layout.Description = description
+	return b
+}
+
+// String adds a string field.
+func (b *MessageBuilder) String(name string, offset, length int, opts ...FieldOption) *MessageBuilder {
+	return b.field("string", name, offset, length, opts)
+}
+
+// Integer adds an int64 field.
+func (b *MessageBuilder) Integer(name string, offset, length int, opts ...FieldOption) *MessageBuilder {
+	return b.field("integer", name, offset, length, opts)
+}
+
+// Decimal adds a j5 decimal field.
+func (b *MessageBuilder) Decimal(name string, offset, length int, opts ...FieldOption) *MessageBuilder {
+	return b.field("decimal", name, offset, length, opts)
+}
+
+// Date adds a j5 date field written in the format, e.g. YYYYMMDD.
+func (b *MessageBuilder) Date(name string, offset, length int, format string, opts ...FieldOption) *MessageBuilder {
+	opts = append([]FieldOption{func(field *binfile.FieldLayout) {
+		field.DateFormat = format
+	}}, opts...)
+	return b.field("date", name, offset, length, opts)
+}
+
+// Bool adds a bool field, read from Y/T/1 and N/F/0 unless Values is given.
+func (b *MessageBuilder) Bool(name string, offset, length int, opts ...FieldOption) *MessageBuilder {
+	return b.field("bool", name, offset, length, opts)
+}
+
+// Enum adds an enum field with a value for each key. Value names are
+// prefixed with the upper case field name.
+func (b *MessageBuilder) Enum(name string, offset, length int, keys []binfile.EnumKey, opts ...FieldOption) *MessageBuilder {
+	opts = append([]FieldOption{func(field *binfile.FieldLayout) {
+		field.EnumKeys = keys
+	}}, opts...)
+	return b.field("enum", name, offset, length, opts)
+}
+
+// Filler adds a range of the record which is not read, and written as
+// spaces.
+func (b *MessageBuilder) Filler(offset, length int) *MessageBuilder {
+	b.layout.Fields = append(b.layout.Fields, binfile.FieldLayout{
+		Filler: true,
+		Offset: offset,
+		Length: length,
+	})
+	return b
+}
+
+func (b *MessageBuilder) field(typ, name string, offset, length int, opts []FieldOption) *MessageBuilder {
+	field := binfile.FieldLayout{
+		Name:   name,
+		Offset: offset,
+		Length: length,
+		Type:   typ,
+	}
+	for _, opt := range opts {
+		opt(&field)
+	}
+	b.layout.Fields = append(b.layout.Fields, field)
+	return b
+}
+
+// Layout returns the layout built so far, to compile with others in the same
+// package with Compile, or to save as JSON for Load.
+func (b *MessageBuilder) Layout() *binfile.MessageLayout {
+	return b.layout
+}
+
+// Build compiles the layout into a parser, which also formats records with
+// AppendRecord.
+func (b *MessageBuilder) Build() (*binfile.MessageParser, error) {
+	parsers, err := Compile([]*binfile.MessageLayout{b.layout})
+	if err != nil {
+		return nil, err
+	}
+	return parsers[0], nil
+}
+
+// Required rejects records where the field is empty.
+func Required() FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Required = true
+	}
+}
+
+// Trim trims strings, enums and bools: left, right, both or right_padding.
+func Trim(trim string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Trim = trim
+	}
+}
+
+// Encoding sets how numbers are written: digits, packed_decimal, overpunch or
+// binary.
+func Encoding(encoding string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Encoding = encoding
+	}
+}
+
+// Scale is the implied decimal places of a decimal.
+func Scale(scale int) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Scale = scale
+	}
+}
+
+// MaxDigits limits the digits of a number.
+func MaxDigits(digits int) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.MaxDigits = digits
+	}
+}
+
+// Charset restricts a string to numeric, alpha, alphanumeric or printable
+// characters.
+func Charset(charset string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Charset = charset
+	}
+}
+
+// Pattern is a regular expression strings must match.
+func Pattern(pattern string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Pattern = pattern
+	}
+}
+
+// MinLength is the fewest characters of a string.
+func MinLength(length int) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.MinLength = length
+	}
+}
+
+// CheckDigit checks the last digit of the field: luhn, aba or mod11.
+func CheckDigit(algorithm string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.CheckDigit = algorithm
+	}
+}
+
+// Codec reads and writes the field with the codec registered under the name
+// with binfile.RegisterFieldCodec.
+func Codec(name string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Codec = name
+	}
+}
+
+// Values are the values of a bool, which replace the defaults.
+func Values(trueValues, falseValues []string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.TrueValues = trueValues
+		field.FalseValues = falseValues
+	}
+}
+
+// Describe documents the field.
+func Describe(description string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Description = description
+	}
+}
+
+// Example is a value of the field as written in the file.
+func Example(example string) FieldOption {
+	return func(field *binfile.FieldLayout) {
+		field.Example = example
+	}
+}
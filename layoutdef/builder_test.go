@@ -0,0 +1,59 @@
+package layoutdef
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/binfile"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestMessageBuilder(t *testing.T) {
+	parser, err := NewMessage("built.v1.Record").
+		RecordLength(47, "").
+		String("name", 0, 30, Trim("right"), Required()).
+		Decimal("amount", 30, 12, Scale(2)).
+		Enum("kind", 42, 1, []binfile.EnumKey{{Key: "C", Value: "CREDIT"}, {Key: "D", Value: "DEBIT"}}).
+		Bool("active", 43, 1).
+		Filler(44, 3).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := "WIDGET" + strings.Repeat(" ", 24) + "000000012345" + "D" + "T" + "   "
+	msg := dynamicpb.NewMessage(parser.Descriptor())
+	if err := parser.Parse(msg, []byte(record)); err != nil {
+		t.Fatal(err)
+	}
+	fields := parser.Descriptor().Fields()
+	if got := msg.Get(fields.ByName("name")).String(); got != "WIDGET" {
+		t.Errorf("got name %q", got)
+	}
+	amount := msg.Get(fields.ByName("amount")).Message()
+	if got := amount.Get(amount.Descriptor().Fields().ByName("value")).String(); got != "123.45" {
+		t.Errorf("got amount %q, want 123.45", got)
+	}
+	if got := msg.Get(fields.ByName("kind")).Enum(); got != 2 {
+		t.Errorf("got kind %d, want KIND_DEBIT", got)
+	}
+
+	// The parser writes the records it reads.
+	out, err := parser.AppendRecord(nil, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != record {
+		t.Errorf("got %q, want %q", out, record)
+	}
+
+	if err := parser.Parse(dynamicpb.NewMessage(parser.Descriptor()), []byte(strings.Repeat(" ", 47))); err == nil {
+		t.Error("expected an error for the required name")
+	}
+
+	// Invalid options are reported when the layout is built.
+	_, err = NewMessage("built.v1.Bad").Integer("count", 0, 4, Encoding("zoned")).Build()
+	if err == nil || !strings.Contains(err.Error(), `field count: encoding: unknown value "zoned"`) {
+		t.Errorf("got error %v", err)
+	}
+}
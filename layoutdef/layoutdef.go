@@ -0,0 +1,447 @@
+// Package layoutdef loads record layouts from JSON or YAML at runtime, so
+// that a new file can be read without writing and deploying proto
+// annotations.
+//
+// A definition is a binfile.MessageLayout, or a list of them, as `flatfile
+// doc -f json` writes them:
+//
+//	message: partner.v1.Detail
+//	oneBased: true
+//	recordLength: 40
+//	fields:
+//	  - {name: account, offset: 1, length: 10, type: string, trim: right}
+//	  - {name: amount, offset: 11, length: 9, type: decimal, scale: 2}
+//	  - {name: posted, offset: 20, length: 8, type: date, dateFormat: YYYYMMDD}
+//	  - {filler: true, offset: 28, length: 13}
+//
+// The layouts are built into proto descriptors annotated as they would be in
+// source, and records are parsed into dynamicpb messages of them. Format is
+// ignored, being a summary of the other properties.
+//
+// Layouts can also be built in code, with NewMessage.
+package layoutdef
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flatfile/gen/flatfile/v1/flatfile_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v3"
+)
+
+// Load reads a layout, or a list of layouts, from JSON or YAML. Unknown
+// properties are an error, so that misspelled options are not ignored.
+func Load(data []byte) ([]*binfile.MessageLayout, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	// YAML is read through JSON so that the properties are those of the
+	// json tags.
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var layouts []*binfile.MessageLayout
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if _, ok := doc.([]any); ok {
+		err = dec.Decode(&layouts)
+	} else {
+		layout := &binfile.MessageLayout{}
+		err = dec.Decode(layout)
+		layouts = append(layouts, layout)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, layout := range layouts {
+		if layout == nil || layout.Message == "" {
+			return nil, fmt.Errorf("layout %d has no message name", idx)
+		}
+	}
+	return layouts, nil
+}
+
+// Files builds a proto file for each package of the layouts, registered in a
+// new registry. Messages are named by the Message of their layout, which is
+// a full name with the package.
+func Files(layouts []*binfile.MessageLayout) (*protoregistry.Files, error) {
+	files := map[string]*descriptorpb.FileDescriptorProto{}
+	var order []string
+
+	for _, layout := range layouts {
+		fullName := protoreflect.FullName(layout.Message)
+		if !fullName.IsValid() {
+			return nil, fmt.Errorf("layout %q: invalid message name", layout.Message)
+		}
+		pkg := string(fullName.Parent())
+		file, ok := files[pkg]
+		if !ok {
+			path := "layout.proto"
+			if pkg != "" {
+				path = strings.ReplaceAll(pkg, ".", "/") + "/layout.proto"
+			}
+			file = &descriptorpb.FileDescriptorProto{
+				Name:           proto.String(path),
+				Package:        proto.String(pkg),
+				Syntax:         proto.String("proto3"),
+				Dependency:     []string{"flatfile/v1/annotations.proto"},
+				SourceCodeInfo: &descriptorpb.SourceCodeInfo{},
+			}
+			files[pkg] = file
+			order = append(order, pkg)
+		}
+
+		b := &fileBuilder{file: file, msgIndex: int32(len(file.MessageType))}
+		msg, err := b.message(layout)
+		if err != nil {
+			return nil, fmt.Errorf("layout %s: %w", layout.Message, err)
+		}
+		file.MessageType = append(file.MessageType, msg)
+	}
+
+	registry := &protoregistry.Files{}
+	for _, pkg := range order {
+		fd, err := protodesc.NewFile(files[pkg], protoregistry.GlobalFiles)
+		if err != nil {
+			return nil, err
+		}
+		if err := registry.RegisterFile(fd); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+// Compile builds a parser for each layout, in order. Parse records into
+// dynamicpb.NewMessage(parser.Descriptor()).
+func Compile(layouts []*binfile.MessageLayout) ([]*binfile.MessageParser, error) {
+	files, err := Files(layouts)
+	if err != nil {
+		return nil, err
+	}
+	parsers := make([]*binfile.MessageParser, 0, len(layouts))
+	for _, layout := range layouts {
+		desc, err := files.FindDescriptorByName(protoreflect.FullName(layout.Message))
+		if err != nil {
+			return nil, err
+		}
+		parser, err := binfile.Compile(desc.(protoreflect.MessageDescriptor))
+		if err != nil {
+			return nil, fmt.Errorf("layout %s: %w", layout.Message, err)
+		}
+		parsers = append(parsers, parser)
+	}
+	return parsers, nil
+}
+
+type fileBuilder struct {
+	file     *descriptorpb.FileDescriptorProto
+	msgIndex int32
+	msg      *descriptorpb.DescriptorProto
+}
+
+func (b *fileBuilder) message(layout *binfile.MessageLayout) (*descriptorpb.DescriptorProto, error) {
+	ext := &flatfile_pb.Message{
+		OneBased:     layout.OneBased,
+		RecordLength: uint32(layout.RecordLength),
+	}
+	if layout.RecordLengthMode != "" {
+		mode, err := enumValue(flatfile_pb.LengthMode_value, "LENGTH_MODE_", layout.RecordLengthMode)
+		if err != nil {
+			return nil, fmt.Errorf("recordLengthMode: %w", err)
+		}
+		ext.RecordLengthMode = flatfile_pb.LengthMode(mode)
+	}
+
+	b.msg = &descriptorpb.DescriptorProto{
+		Name:    proto.String(string(protoreflect.FullName(layout.Message).Name())),
+		Options: &descriptorpb.MessageOptions{},
+	}
+	b.comment(layout.Description, 4, b.msgIndex)
+
+	for idx := range layout.Fields {
+		field := &layout.Fields[idx]
+		if field.Length <= 0 {
+			return nil, fmt.Errorf("field %d: length must be positive", idx)
+		}
+		if field.Filler {
+			ext.Filler = append(ext.Filler, &flatfile_pb.FixedWidth{
+				Offset: uint32(field.Offset),
+				Length: uint32(field.Length),
+			})
+			continue
+		}
+		if field.Name == "" {
+			return nil, fmt.Errorf("field %d has no name", idx)
+		}
+		if err := b.field(field); err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+
+	proto.SetExtension(b.msg.Options, flatfile_pb.E_Message, ext)
+	return b.msg, nil
+}
+
+func (b *fileBuilder) field(field *binfile.FieldLayout) error {
+	fieldDesc := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(field.Name),
+		JsonName: proto.String(jsonName(field.Name)),
+		Number:   proto.Int32(int32(len(b.msg.Field) + 1)),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Options:  &descriptorpb.FieldOptions{},
+	}
+	opts := &flatfile_pb.Field{
+		FixedWidth: &flatfile_pb.FixedWidth{
+			Offset: uint32(field.Offset),
+			Length: uint32(field.Length),
+		},
+		Required:    field.Required,
+		Description: field.Description,
+		Example:     field.Example,
+	}
+
+	var trim flatfile_pb.Trim
+	if field.Trim != "" {
+		value, err := enumValue(flatfile_pb.Trim_value, "TRIM_", field.Trim)
+		if err != nil {
+			return fmt.Errorf("trim: %w", err)
+		}
+		trim = flatfile_pb.Trim(value)
+	}
+	if trim != flatfile_pb.Trim_TRIM_UNSPECIFIED && field.Type != "string" && field.Type != "enum" && field.Type != "bool" {
+		return fmt.Errorf("trim is for string, enum and bool fields")
+	}
+	if field.Scale != 0 && field.Type != "decimal" {
+		return fmt.Errorf("scale is for decimal fields")
+	}
+
+	switch field.Type {
+	case "string", "":
+		fieldDesc.Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+		stringField := &flatfile_pb.StringField{
+			Trim:      trim,
+			Pattern:   field.Pattern,
+			MinLength: uint32(field.MinLength),
+		}
+		if field.Charset != "" {
+			class, err := enumValue(flatfile_pb.CharsetClass_value, "CHARSET_CLASS_", field.Charset)
+			if err != nil {
+				return fmt.Errorf("charset: %w", err)
+			}
+			stringField.CharsetClass = flatfile_pb.CharsetClass(class)
+		}
+		if proto.Size(stringField) > 0 {
+			opts.FieldType = &flatfile_pb.Field_String_{String_: stringField}
+		}
+
+	case "integer", "decimal":
+		fieldDesc.Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+		if field.Type == "decimal" {
+			fieldDesc.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			fieldDesc.TypeName = proto.String(".j5.types.decimal.v1.Decimal")
+			b.depend("j5/types/decimal/v1/decimal.proto")
+		}
+		number := &flatfile_pb.NumberField{
+			FixedScale: int32(field.Scale),
+			MaxDigits:  uint32(field.MaxDigits),
+		}
+		if field.Encoding != "" && field.Encoding != "digits" {
+			encoding, err := enumValue(flatfile_pb.Encoding_value, "ENCODING_", field.Encoding)
+			if err != nil {
+				return fmt.Errorf("encoding: %w", err)
+			}
+			number.Encoding = flatfile_pb.Encoding(encoding)
+		}
+		if proto.Size(number) > 0 {
+			opts.FieldType = &flatfile_pb.Field_Number{Number: number}
+		}
+
+	case "date":
+		if field.DateFormat == "" {
+			return fmt.Errorf("date fields need a dateFormat")
+		}
+		fieldDesc.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+		fieldDesc.TypeName = proto.String(".j5.types.date.v1.Date")
+		b.depend("j5/types/date/v1/date.proto")
+		opts.FieldType = &flatfile_pb.Field_Date{Date: &flatfile_pb.DateField{Format: field.DateFormat}}
+
+	case "bool":
+		fieldDesc.Type = descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum()
+		switch {
+		case len(field.TrueValues) > 0 && len(field.FalseValues) > 0:
+			opts.FieldType = &flatfile_pb.Field_Bool{Bool: &flatfile_pb.BoolField{
+				TrueValues:  field.TrueValues,
+				FalseValues: field.FalseValues,
+				Trim:        trim,
+			}}
+		case len(field.TrueValues) > 0 || len(field.FalseValues) > 0 || trim != flatfile_pb.Trim_TRIM_UNSPECIFIED:
+			// Options replace the defaults, rather than adding to them.
+			return fmt.Errorf("bool fields need both trueValues and falseValues, or neither for the defaults")
+		}
+
+	case "enum":
+		if len(field.EnumKeys) == 0 {
+			return fmt.Errorf("enum fields need enumKeys")
+		}
+		enum := enumType(field)
+		b.msg.EnumType = append(b.msg.EnumType, enum)
+		fieldDesc.Type = descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum()
+		fieldDesc.TypeName = proto.String("." + b.msgFullName() + "." + enum.GetName())
+		if trim != flatfile_pb.Trim_TRIM_UNSPECIFIED {
+			opts.FieldType = &flatfile_pb.Field_Enum{Enum: &flatfile_pb.EnumField{Trim: trim}}
+		}
+
+	default:
+		return fmt.Errorf("unsupported type %q", field.Type)
+	}
+
+	if field.Codec != "" {
+		if opts.FieldType != nil {
+			return fmt.Errorf("codec fields take no options of their type")
+		}
+		opts.FieldType = &flatfile_pb.Field_Custom{Custom: &flatfile_pb.CustomField{Codec: field.Codec}}
+	}
+
+	if field.CheckDigit != "" {
+		value, err := enumValue(flatfile_pb.CheckDigit_value, "CHECK_DIGIT_", field.CheckDigit)
+		if err != nil {
+			return fmt.Errorf("checkDigit: %w", err)
+		}
+		opts.CheckDigit = flatfile_pb.CheckDigit(value)
+	}
+	if field.Checksum != nil {
+		value, err := enumValue(flatfile_pb.ChecksumAlgorithm_value, "CHECKSUM_ALGORITHM_", field.Checksum.Algorithm)
+		if err != nil {
+			return fmt.Errorf("checksum: %w", err)
+		}
+		opts.Checksum = &flatfile_pb.Checksum{
+			Algorithm: flatfile_pb.ChecksumAlgorithm(value),
+			Range: &flatfile_pb.FixedWidth{
+				Offset: uint32(field.Checksum.Offset),
+				Length: uint32(field.Checksum.Length),
+			},
+			Modulus: uint32(field.Checksum.Modulus),
+			Hex:     field.Checksum.Hex,
+		}
+	}
+
+	proto.SetExtension(fieldDesc.Options, flatfile_pb.E_Field, opts)
+	b.msg.Field = append(b.msg.Field, fieldDesc)
+	return nil
+}
+
+func (b *fileBuilder) msgFullName() string {
+	if pkg := b.file.GetPackage(); pkg != "" {
+		return pkg + "." + b.msg.GetName()
+	}
+	return b.msg.GetName()
+}
+
+func (b *fileBuilder) depend(path string) {
+	for _, dep := range b.file.Dependency {
+		if dep == path {
+			return
+		}
+	}
+	b.file.Dependency = append(b.file.Dependency, path)
+}
+
+// comment records the description as the leading comment of the element at
+// path, so that it is documented as it would be from source. Fields carry
+// theirs in the description option.
+func (b *fileBuilder) comment(description string, path ...int32) {
+	if description == "" {
+		return
+	}
+	b.file.SourceCodeInfo.Location = append(b.file.SourceCodeInfo.Location, &descriptorpb.SourceCodeInfo_Location{
+		Path:            path,
+		Span:            []int32{0, 0, 0},
+		LeadingComments: proto.String(" " + description + "\n"),
+	})
+}
+
+// enumType builds the enum of a field, nested in its message. Values are
+// prefixed with the field name, as values share the scope of the message.
+func enumType(field *binfile.FieldLayout) *descriptorpb.EnumDescriptorProto {
+	prefix := strings.ToUpper(field.Name) + "_"
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: proto.String(typeName(field.Name)),
+		Value: []*descriptorpb.EnumValueDescriptorProto{{
+			Name:   proto.String(prefix + "UNSPECIFIED"),
+			Number: proto.Int32(0),
+		}},
+	}
+	for _, key := range field.EnumKeys {
+		name := strings.ToUpper(key.Value)
+		if !strings.HasPrefix(name, prefix) {
+			name = prefix + name
+		}
+		options := &descriptorpb.EnumValueOptions{}
+		proto.SetExtension(options, flatfile_pb.E_Enum, &flatfile_pb.Enum{Key: key.Key})
+		if name == prefix+"UNSPECIFIED" {
+			enum.Value[0].Options = options
+			continue
+		}
+		enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:    proto.String(name),
+			Number:  proto.Int32(int32(len(enum.Value))),
+			Options: options,
+		})
+	}
+	return enum
+}
+
+// enumValue finds the value of a proto enum by its lower case name without
+// the prefix, as DescribeLayout writes them.
+func enumValue(values map[string]int32, prefix, name string) (int32, error) {
+	value, ok := values[prefix+strings.ToUpper(name)]
+	if !ok || value == 0 {
+		return 0, fmt.Errorf("unknown value %q", name)
+	}
+	return value, nil
+}
+
+// typeName turns a snake case field name into an upper camel case type name.
+func typeName(name string) string {
+	var sb strings.Builder
+	for part := range strings.SplitSeq(name, "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
+
+// jsonName is the lower camel case name protoc gives a field.
+func jsonName(name string) string {
+	var sb strings.Builder
+	upper := false
+	for _, c := range name {
+		switch {
+		case c == '_':
+			upper = true
+		case upper && c >= 'a' && c <= 'z':
+			sb.WriteRune(c - 'a' + 'A')
+			upper = false
+		default:
+			sb.WriteRune(c)
+			upper = false
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,142 @@
+package layoutdef
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/binfile"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const testLayout = `
+message: partner.v1.Detail
+description: One payment.
+oneBased: true
+recordLength: 40
+recordLengthMode: exact
+fields:
+  - name: account
+    offset: 1
+    length: 10
+    type: string
+    trim: right
+    required: true
+    description: The partner's account number.
+    example: "ACCT1     "
+  - {name: amount, offset: 11, length: 9, type: integer, encoding: digits}
+  - {name: posted, offset: 20, length: 8, type: date, dateFormat: YYYYMMDD}
+  - name: status
+    offset: 28
+    length: 1
+    type: enum
+    enumKeys:
+      - {key: A, value: STATUS_ACTIVE}
+      - {key: C, value: CLOSED}
+  - {name: active, offset: 29, length: 1, type: bool}
+  - {filler: true, offset: 30, length: 11}
+`
+
+func TestCompile(t *testing.T) {
+	layouts, err := Load([]byte(testLayout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsers, err := Compile(layouts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parser := parsers[0]
+	if name := parser.Descriptor().FullName(); name != "partner.v1.Detail" {
+		t.Errorf("got message %s", name)
+	}
+
+	record := "ACCT1     " + "000012345" + "20240131" + "C" + "Y" + strings.Repeat(" ", 11)
+	msg := dynamicpb.NewMessage(parser.Descriptor())
+	if err := parser.Parse(msg, []byte(record)); err != nil {
+		t.Fatal(err)
+	}
+	fields := parser.Descriptor().Fields()
+	get := func(name protoreflect.Name) protoreflect.Value {
+		return msg.Get(fields.ByName(name))
+	}
+	if got := get("account").String(); got != "ACCT1" {
+		t.Errorf("got account %q", got)
+	}
+	if got := get("amount").Int(); got != 12345 {
+		t.Errorf("got amount %d", got)
+	}
+	if got := get("status").Enum(); got != 2 {
+		t.Errorf("got status %d, want STATUS_CLOSED", got)
+	}
+	if !get("active").Bool() || !msg.Has(fields.ByName("posted")) {
+		t.Errorf("got active %v, posted %v", get("active"), get("posted"))
+	}
+
+	// The records are checked as annotated.
+	if err := parser.Parse(dynamicpb.NewMessage(parser.Descriptor()), []byte(record[:30])); err == nil {
+		t.Error("expected an error for a short record")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	layouts, err := Load([]byte(testLayout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := Files(layouts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc, err := files.FindDescriptorByName("partner.v1.Detail")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Describing the built message gives the definition back, with enum
+	// values prefixed by the field, as JSON which loads again.
+	described := binfile.DescribeLayout(desc.(protoreflect.MessageDescriptor))
+	data, err := json.Marshal(described)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := Load(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx := range again[0].Fields {
+		again[0].Fields[idx].Format = ""
+	}
+	layouts[0].Fields[3].EnumKeys[1].Value = "STATUS_CLOSED"
+	want, _ := json.Marshal(layouts[0])
+	got, _ := json.Marshal(again[0])
+	if string(got) != string(want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, tc := range []struct {
+		source string
+		err    string
+	}{
+		{`{message: a.B, fields: [{name: x, offset: 0, length: 1, typo: string}]}`, `unknown field "typo"`},
+		{`{fields: []}`, "no message name"},
+		{`{message: a.B, fields: [{name: x, offset: 0, length: 1, type: bytes}]}`, `unsupported type "bytes"`},
+		{`{message: a.B, fields: [{name: x, offset: 0, length: 8, type: date}]}`, "need a dateFormat"},
+		{`{message: a.B, fields: [{name: x, offset: 0, length: 1, trim: sideways}]}`, `trim: unknown value "sideways"`},
+		{`{message: a.B, fields: [{name: x, offset: 0, length: 1, type: bool, trueValues: [Y]}]}`, "both trueValues and falseValues"},
+		{`{message: a.B, fields: [{name: x, offset: 0, length: 0}]}`, "length must be positive"},
+		{`{message: a.B, fields: [{name: x, offset: 0, length: 1, codec: ssn, pattern: "[0-9]"}]}`, "codec fields take no options of their type"},
+		{`{message: a.B, fields: [{name: x, offset: 0, length: 1, codec: unregistered}]}`, `custom codec "unregistered" is not registered`},
+	} {
+		layouts, err := Load([]byte(tc.source))
+		if err == nil {
+			_, err = Compile(layouts)
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: got error %v, want %q", tc.source, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/pentops/flatfile/arrow"
+)
+
+// leafPath locates a leaf in a record: the column, the child of each struct
+// or list on the way down, and the names of the schema elements.
+type leafPath struct {
+	column   int
+	children []int
+	names    []string
+}
+
+func (p leafPath) child(idx int) leafPath {
+	p.children = append(p.children[:len(p.children):len(p.children)], idx)
+	return p
+}
+
+// column is a leaf of the schema, with the definition and repetition levels
+// of a value which is present.
+type column struct {
+	path   leafPath
+	elem   *schemaElement
+	maxDef int
+	maxRep int
+}
+
+// columnChunk is a column of one row group, encoded as a data page.
+type columnChunk struct {
+	data      []byte
+	offset    int64
+	numValues int64
+	size      int64
+}
+
+// pageBuilder collects the levels and values of a column, shredding nested
+// values as Dremel does. A level is written for every value, and for every
+// null or empty list on the way down, and the values only where present.
+type pageBuilder struct {
+	col       *column
+	defLevels []int
+	repLevels []int
+	values    []byte
+	bools     []bool
+}
+
+// encode writes the column of the record as a page header and data page.
+func (c *column) encode(rec *arrow.Record) *columnChunk {
+	p := &pageBuilder{col: c}
+	arr := rec.Columns[c.path.column]
+	for row := range rec.NumRows {
+		p.shred(arr, 0, row, 0, 0, 0)
+	}
+
+	page := []byte{}
+	if c.maxRep > 0 {
+		page = appendLevels(page, p.repLevels, c.maxRep)
+	}
+	if c.maxDef > 0 {
+		page = appendLevels(page, p.defLevels, c.maxDef)
+	}
+	if c.elem.physicalType == typeBoolean {
+		page = append(page, packBools(p.bools)...)
+	} else {
+		page = append(page, p.values...)
+	}
+
+	t := &thriftWriter{}
+	t.begin()
+	t.i32(1, pageData)
+	t.i32(2, int32(len(page)))
+	t.i32(3, int32(len(page)))
+	t.structField(5)
+	t.i32(1, int32(len(p.defLevels)))
+	t.i32(2, encodingPlain)
+	t.i32(3, encodingRLE)
+	t.i32(4, encodingRLE)
+	t.end()
+	t.end()
+
+	data := append(t.buf, page...)
+	return &columnChunk{
+		data:      data,
+		numValues: int64(len(p.defLevels)),
+		size:      int64(len(data)),
+	}
+}
+
+// shred adds the levels of the value at idx of arr, the array at depth of
+// the path, and of the values within it. rep and def are the levels of the
+// value, from its parents, and lists the number of lists it is within.
+func (p *pageBuilder) shred(arr *arrow.Array, depth, idx, rep, def, lists int) {
+	if arr.Field.Nullable {
+		if arr.IsNull(idx) {
+			p.level(rep, def)
+			return
+		}
+		def++
+	}
+
+	switch arr.Field.Type.Type {
+	case arrow.Struct:
+		p.shred(arr.Children[p.col.path.children[depth]], depth+1, idx, rep, def, lists)
+		return
+	case arrow.List:
+		start, end := arr.ListRange(idx)
+		if start == end {
+			p.level(rep, def)
+			return
+		}
+		for item := start; item < end; item++ {
+			p.shred(arr.Children[0], depth+1, item, rep, def+1, lists+1)
+			// Items after the first repeat at the level of this list.
+			rep = lists + 1
+		}
+		return
+	}
+
+	p.level(rep, def)
+	p.appendValue(arr, idx)
+}
+
+func (p *pageBuilder) level(rep, def int) {
+	p.repLevels = append(p.repLevels, rep)
+	p.defLevels = append(p.defLevels, def)
+}
+
+// appendValue writes the value in the PLAIN encoding.
+func (p *pageBuilder) appendValue(arr *arrow.Array, idx int) {
+	switch arr.Field.Type.Type {
+	case arrow.Utf8, arrow.Binary:
+		value := arr.Bytes(idx)
+		p.values = binary.LittleEndian.AppendUint32(p.values, uint32(len(value)))
+		p.values = append(p.values, value...)
+	case arrow.Boolean:
+		p.bools = append(p.bools, arr.Bool(idx))
+	case arrow.Int32, arrow.Uint32, arrow.Float32, arrow.Date32:
+		p.values = append(p.values, arr.Data[idx*4:idx*4+4]...)
+	case arrow.Int64, arrow.Uint64, arrow.Float64:
+		p.values = append(p.values, arr.Data[idx*8:idx*8+8]...)
+	case arrow.Decimal128:
+		// The low bytes of the little endian value, big endian.
+		value := arr.Data[idx*16 : idx*16+16]
+		for b := p.col.elem.typeLength - 1; b >= 0; b-- {
+			p.values = append(p.values, value[b])
+		}
+	}
+}
+
+func packBools(values []bool) []byte {
+	packed := make([]byte, (len(values)+7)/8)
+	for idx, value := range values {
+		if value {
+			packed[idx/8] |= 1 << (idx % 8)
+		}
+	}
+	return packed
+}
+
+// appendLevels writes the levels in the RLE hybrid encoding, as a run for
+// each repeated level, prefixed with their length.
+func appendLevels(buf []byte, levels []int, maxLevel int) []byte {
+	width := (bits.Len(uint(maxLevel)) + 7) / 8
+	encoded := []byte{}
+	for start := 0; start < len(levels); {
+		end := start + 1
+		for end < len(levels) && levels[end] == levels[start] {
+			end++
+		}
+		encoded = binary.AppendUvarint(encoded, uint64(end-start)<<1)
+		for b := range width {
+			encoded = append(encoded, byte(levels[start]>>(8*b)))
+		}
+		start = end
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(encoded)))
+	return append(buf, encoded...)
+}
@@ -0,0 +1,370 @@
+// Package parquet writes parsed records to Apache Parquet files, for loading
+// partner files into a warehouse without a JSON step in between.
+//
+// The records are built into Arrow record batches by an arrow.RecordBuilder,
+// and each batch written as a row group, so the schema of the file is that
+// of the builder:
+//
+//   - utf8 to BYTE_ARRAY of the STRING logical type, binary to BYTE_ARRAY,
+//     bool to BOOLEAN, float32 and float64 to FLOAT and DOUBLE;
+//   - int32 and int64 to INT32 and INT64, and uint32 and uint64 to the same
+//     of the unsigned INTEGER logical type;
+//   - date32 to INT32 of the DATE logical type;
+//   - decimal128 to FIXED_LEN_BYTE_ARRAY of the DECIMAL logical type, in the
+//     fewest bytes which hold its precision;
+//   - structs to groups, and lists to the three level LIST groups, of
+//     repeated groups named list of an element.
+//
+// Nullable fields are OPTIONAL and others REQUIRED. Each column chunk is one
+// uncompressed data page, of PLAIN values and RLE levels.
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/pentops/flatfile/arrow"
+	"google.golang.org/protobuf/proto"
+)
+
+// RowGroupSize is the number of rows at which a Writer writes a row group.
+const RowGroupSize = 64 * 1024
+
+const magic = "PAR1"
+
+// The enums of parquet.thrift.
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+
+	convertedUTF8    = 0
+	convertedList    = 3
+	convertedDecimal = 5
+	convertedDate    = 6
+	convertedUint32  = 13
+	convertedUint64  = 14
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	codecUncompressed = 0
+
+	pageData = 0
+)
+
+// Writer writes a Parquet file of the schema of the builder, a row group for
+// each batch of records.
+type Writer struct {
+	w       io.Writer
+	builder *arrow.RecordBuilder
+	schema  []*schemaElement
+	columns []*column
+
+	offset    int64
+	numRows   int64
+	rowGroups []*rowGroup
+}
+
+// NewWriter resolves the Parquet schema of the builder and writes the start
+// of the file.
+func NewWriter(w io.Writer, builder *arrow.RecordBuilder) (*Writer, error) {
+	writer := &Writer{w: w, builder: builder}
+	root := &schemaElement{name: "schema", numChildren: len(builder.Schema().Fields)}
+	writer.schema = append(writer.schema, root)
+	for idx, field := range builder.Schema().Fields {
+		if err := writer.addField(field, leafPath{column: idx}, 0, 0); err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+	if err := writer.write([]byte(magic)); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Write appends the message to the current row group, writing the group once
+// it reaches RowGroupSize rows.
+func (w *Writer) Write(msg proto.Message) error {
+	if err := w.builder.Append(msg); err != nil {
+		return fmt.Errorf("appending %s: %w", w.builder.Descriptor().FullName(), err)
+	}
+	if w.builder.Len() >= RowGroupSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes the rows of the current row group, if there are any.
+func (w *Writer) Flush() error {
+	if w.builder.Len() == 0 {
+		return nil
+	}
+	return w.WriteRecord(w.builder.NewRecord())
+}
+
+// WriteRecord writes the record as a row group, which must be of the schema
+// of the builder.
+func (w *Writer) WriteRecord(rec *arrow.Record) error {
+	if rec.Schema != w.builder.Schema() {
+		return fmt.Errorf("record is not of the schema of the file")
+	}
+	if rec.NumRows == 0 {
+		return nil
+	}
+	group := &rowGroup{numRows: int64(rec.NumRows)}
+	for _, col := range w.columns {
+		chunk := col.encode(rec)
+		chunk.offset = w.offset
+		if err := w.write(chunk.data); err != nil {
+			return err
+		}
+		group.totalSize += int64(len(chunk.data))
+		chunk.data = nil
+		group.chunks = append(group.chunks, chunk)
+	}
+	w.rowGroups = append(w.rowGroups, group)
+	w.numRows += group.numRows
+	return nil
+}
+
+// Close flushes the last row group and writes the footer, leaving the
+// underlying writer open.
+func (w *Writer) Close() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	footer := w.fileMetaData()
+	footer = binary.LittleEndian.AppendUint32(footer, uint32(len(footer)))
+	footer = append(footer, magic...)
+	return w.write(footer)
+}
+
+func (w *Writer) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.offset += int64(n)
+	return err
+}
+
+// schemaElement is a node of the flattened schema tree, a group when it has
+// children.
+type schemaElement struct {
+	name        string
+	repetition  int32
+	numChildren int
+
+	physicalType  int32
+	typeLength    int32
+	convertedType int32
+	logicalType   func(t *thriftWriter)
+	scale         int32
+	precision     int32
+	isLeaf        bool
+	hasConverted  bool
+}
+
+// addField adds the schema elements of the field, and a column for each of
+// its leaves. maxDef and maxRep are the levels of its parent.
+func (w *Writer) addField(field *arrow.Field, path leafPath, maxDef, maxRep int) error {
+	elem := &schemaElement{name: field.Name, repetition: repetitionRequired}
+	path.names = append(path.names[:len(path.names):len(path.names)], field.Name)
+	if field.Nullable {
+		elem.repetition = repetitionOptional
+		maxDef++
+	}
+	w.schema = append(w.schema, elem)
+
+	switch field.Type.Type {
+	case arrow.Struct:
+		elem.numChildren = len(field.Children)
+		for idx, child := range field.Children {
+			if err := w.addField(child, path.child(idx), maxDef, maxRep); err != nil {
+				return fmt.Errorf("%s: %w", child.Name, err)
+			}
+		}
+		return nil
+
+	case arrow.List:
+		elem.numChildren = 1
+		elem.hasConverted, elem.convertedType = true, convertedList
+		elem.logicalType = emptyLogicalType(3)
+		w.schema = append(w.schema, &schemaElement{name: "list", repetition: repetitionRepeated, numChildren: 1})
+		path.names = append(path.names, "list")
+		item := *field.Children[0]
+		item.Name = "element"
+		return w.addField(&item, path.child(0), maxDef+1, maxRep+1)
+	}
+
+	elem.isLeaf = true
+	switch field.Type.Type {
+	case arrow.Utf8:
+		elem.physicalType = typeByteArray
+		elem.hasConverted, elem.convertedType = true, convertedUTF8
+		elem.logicalType = emptyLogicalType(1)
+	case arrow.Binary:
+		elem.physicalType = typeByteArray
+	case arrow.Boolean:
+		elem.physicalType = typeBoolean
+	case arrow.Int32:
+		elem.physicalType = typeInt32
+	case arrow.Int64:
+		elem.physicalType = typeInt64
+	case arrow.Uint32:
+		elem.physicalType = typeInt32
+		elem.hasConverted, elem.convertedType = true, convertedUint32
+		elem.logicalType = intLogicalType(32)
+	case arrow.Uint64:
+		elem.physicalType = typeInt64
+		elem.hasConverted, elem.convertedType = true, convertedUint64
+		elem.logicalType = intLogicalType(64)
+	case arrow.Float32:
+		elem.physicalType = typeFloat
+	case arrow.Float64:
+		elem.physicalType = typeDouble
+	case arrow.Date32:
+		elem.physicalType = typeInt32
+		elem.hasConverted, elem.convertedType = true, convertedDate
+		elem.logicalType = emptyLogicalType(6)
+	case arrow.Decimal128:
+		elem.physicalType = typeFixedLenByteArray
+		elem.typeLength = int32(decimalSize(field.Type.Precision))
+		elem.hasConverted, elem.convertedType = true, convertedDecimal
+		elem.scale, elem.precision = field.Type.Scale, field.Type.Precision
+		elem.logicalType = func(t *thriftWriter) {
+			t.structField(5)
+			t.i32(1, field.Type.Scale)
+			t.i32(2, field.Type.Precision)
+			t.end()
+		}
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type)
+	}
+
+	w.columns = append(w.columns, &column{
+		path:   path,
+		elem:   elem,
+		maxDef: maxDef,
+		maxRep: maxRep,
+	})
+	return nil
+}
+
+// emptyLogicalType is the logical type of the union member id, of an empty
+// struct.
+func emptyLogicalType(id int16) func(t *thriftWriter) {
+	return func(t *thriftWriter) {
+		t.structField(id)
+		t.end()
+	}
+}
+
+func intLogicalType(bitWidth int8) func(t *thriftWriter) {
+	return func(t *thriftWriter) {
+		t.structField(10)
+		t.byteField(1, bitWidth)
+		t.boolField(2, false)
+		t.end()
+	}
+}
+
+// decimalSize is the fewest bytes of two's complement which hold every value
+// of the precision.
+func decimalSize(precision int32) int {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	size := 1
+	for new(big.Int).Lsh(big.NewInt(1), uint(8*size-1)).Cmp(limit) < 0 {
+		size++
+	}
+	return size
+}
+
+type rowGroup struct {
+	chunks    []*columnChunk
+	totalSize int64
+	numRows   int64
+}
+
+// fileMetaData is the Thrift encoding of the footer.
+func (w *Writer) fileMetaData() []byte {
+	t := &thriftWriter{}
+	t.begin()
+	t.i32(1, 1)
+
+	t.listField(2, thriftStruct, len(w.schema))
+	for idx, elem := range w.schema {
+		t.begin()
+		if elem.isLeaf {
+			t.i32(1, elem.physicalType)
+			if elem.typeLength > 0 {
+				t.i32(2, elem.typeLength)
+			}
+		}
+		if idx > 0 {
+			// The root has no repetition.
+			t.i32(3, elem.repetition)
+		}
+		t.stringField(4, elem.name)
+		if !elem.isLeaf {
+			t.i32(5, int32(elem.numChildren))
+		}
+		if elem.hasConverted {
+			t.i32(6, elem.convertedType)
+		}
+		if elem.precision > 0 {
+			t.i32(7, elem.scale)
+			t.i32(8, elem.precision)
+		}
+		if elem.logicalType != nil {
+			t.structField(10)
+			elem.logicalType(t)
+			t.end()
+		}
+		t.end()
+	}
+
+	t.i64(3, w.numRows)
+
+	t.listField(4, thriftStruct, len(w.rowGroups))
+	for _, group := range w.rowGroups {
+		t.begin()
+		t.listField(1, thriftStruct, len(group.chunks))
+		for idx, chunk := range group.chunks {
+			col := w.columns[idx]
+			t.begin()
+			t.i64(2, chunk.offset)
+			t.structField(3)
+			t.i32(1, col.elem.physicalType)
+			t.listField(2, thriftI32, 2)
+			t.i32Value(encodingPlain)
+			t.i32Value(encodingRLE)
+			t.listField(3, thriftBinary, len(col.path.names))
+			for _, name := range col.path.names {
+				t.binaryValue(name)
+			}
+			t.i32(4, codecUncompressed)
+			t.i64(5, chunk.numValues)
+			t.i64(6, chunk.size)
+			t.i64(7, chunk.size)
+			t.i64(9, chunk.offset)
+			t.end()
+			t.end()
+		}
+		t.i64(2, group.totalSize)
+		t.i64(3, group.numRows)
+		t.end()
+	}
+
+	t.stringField(6, "flatfile")
+	t.end()
+	return t.buf
+}
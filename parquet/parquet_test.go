@@ -0,0 +1,295 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pentops/flatfile/arrow"
+	"github.com/pentops/flatfile/binfile"
+	"github.com/pentops/flowtest/prototest"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const testProto = `
+	syntax = "proto3";
+	package parquet.v1;
+
+	import "flatfile/v1/annotations.proto";
+	import "j5/types/date/v1/date.proto";
+	import "j5/types/decimal/v1/decimal.proto";
+
+	message Payment {
+	  option (flatfile.v1.message) = { record_length: 25 };
+	  string account = 1 [(flatfile.v1.field) = { fixed_width: { offset: 0, length: 6 }, string: { trim: TRIM_RIGHT } }];
+	  j5.types.decimal.v1.Decimal amount = 2 [(flatfile.v1.field) = { fixed_width: { offset: 6, length: 7 }, number: { fixed_scale: 2 } }];
+	  j5.types.date.v1.Date posted = 3 [(flatfile.v1.field) = { fixed_width: { offset: 13, length: 8 }, date: { format: "YYYYMMDD" } }];
+	  uint32 count = 4 [(flatfile.v1.field) = { fixed_width: { offset: 21, length: 3 }, number: {} }];
+	  bool flagged = 5 [(flatfile.v1.field) = { fixed_width: { offset: 24, length: 1 } }];
+	  repeated string tags = 6;
+	  Party payee = 7;
+	}
+
+	message Party {
+	  string name = 1;
+	}`
+
+// readThrift decodes a struct of the Thrift compact protocol, as a map of
+// field id to int64, bool, []byte, []any or a nested map, returning the bytes
+// after it.
+func readThrift(t *testing.T, data []byte) (map[int16]any, []byte) {
+	t.Helper()
+	fields := map[int16]any{}
+	var lastID int16
+	for {
+		header := data[0]
+		data = data[1:]
+		if header == 0 {
+			return fields, data
+		}
+		typ := header & 0x0f
+		if delta := header >> 4; delta != 0 {
+			lastID += int16(delta)
+		} else {
+			id, n := binary.Varint(data)
+			data = data[n:]
+			lastID = int16(id)
+		}
+		fields[lastID], data = readThriftValue(t, typ, data)
+	}
+}
+
+func readThriftValue(t *testing.T, typ byte, data []byte) (any, []byte) {
+	t.Helper()
+	switch typ {
+	case 1, 2:
+		return typ == 1, data
+	case 3:
+		return int64(int8(data[0])), data[1:]
+	case 4, 5, 6:
+		v, n := binary.Varint(data)
+		return v, data[n:]
+	case 8:
+		size, n := binary.Uvarint(data)
+		data = data[n:]
+		return data[:size], data[size:]
+	case 9:
+		header := data[0]
+		data = data[1:]
+		size := uint64(header >> 4)
+		if size == 15 {
+			var n int
+			size, n = binary.Uvarint(data)
+			data = data[n:]
+		}
+		list := []any{}
+		for range size {
+			var item any
+			item, data = readThriftValue(t, header&0x0f, data)
+			list = append(list, item)
+		}
+		return list, data
+	case 12:
+		return readThrift(t, data)
+	}
+	t.Fatalf("unsupported thrift type %d", typ)
+	return nil, nil
+}
+
+// readLevels decodes levels of the RLE runs the writer encodes.
+func readLevels(t *testing.T, data []byte, count, maxLevel int) ([]int, []byte) {
+	t.Helper()
+	size := binary.LittleEndian.Uint32(data)
+	encoded := data[4 : 4+size]
+	width := (bits.Len(uint(maxLevel)) + 7) / 8
+	levels := []int{}
+	for len(levels) < count {
+		header, n := binary.Uvarint(encoded)
+		encoded = encoded[n:]
+		if header&1 != 0 {
+			t.Fatal("unexpected bit packed run")
+		}
+		level := 0
+		for b := range width {
+			level |= int(encoded[b]) << (8 * b)
+		}
+		encoded = encoded[width:]
+		for range header >> 1 {
+			levels = append(levels, level)
+		}
+	}
+	return levels, data[4+size:]
+}
+
+func TestWriter(t *testing.T) {
+	fileDesc := prototest.DescriptorsFromSource(t, map[string]string{"test.proto": testProto})
+	msgDesc := fileDesc.MessageByName(t, "parquet.v1.Payment")
+	builder, err := arrow.NewRecordBuilder(msgDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	writer, err := NewWriter(buf, builder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields := msgDesc.Fields()
+	first := dynamicpb.NewMessage(msgDesc)
+	if err := binfile.ParseMessage(first, []byte("ACME  "+"-012.50"+"20240131"+"064"+"Y")); err != nil {
+		t.Fatal(err)
+	}
+	tags := first.Mutable(fields.ByName("tags")).List()
+	tags.Append(protoreflect.ValueOfString("a"))
+	tags.Append(protoreflect.ValueOfString("b"))
+	payee := first.Mutable(fields.ByName("payee")).Message()
+	payee.Set(payee.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString("Bob"))
+	second := dynamicpb.NewMessage(msgDesc)
+	second.Set(fields.ByName("account"), protoreflect.ValueOfString("B"))
+	for _, msg := range []protoreflect.Message{first, second, first} {
+		if err := writer.Write(msg.Interface()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buf.Bytes()
+	if !bytes.HasPrefix(data, []byte(magic)) || !bytes.HasSuffix(data, []byte(magic)) {
+		t.Fatalf("got file % x", data)
+	}
+	footerSize := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	meta, rest := readThrift(t, data[len(data)-8-footerSize:len(data)-8])
+	if len(rest) != 0 {
+		t.Fatalf("got %d bytes after the footer", len(rest))
+	}
+	if meta[3] != int64(3) {
+		t.Errorf("got %v rows", meta[3])
+	}
+
+	// The schema is the flattened tree, as name: type (converted type) and
+	// repetition, with the children of groups in braces.
+	elements := meta[2].([]any)
+	var describe func() string
+	describe = func() string {
+		elem := elements[0].(map[int16]any)
+		elements = elements[1:]
+		desc := string(elem[4].([]byte))
+		if typ, ok := elem[1]; ok {
+			desc += fmt.Sprintf(": %d", typ)
+		}
+		if converted, ok := elem[6]; ok {
+			desc += fmt.Sprintf(" (%d)", converted)
+		}
+		if length, ok := elem[2]; ok {
+			desc += fmt.Sprintf(" [%d]", length)
+		}
+		if repetition, ok := elem[3]; ok {
+			desc += fmt.Sprintf(" %d", repetition)
+		}
+		if numChildren, ok := elem[5]; ok {
+			children := []string{}
+			for range numChildren.(int64) {
+				children = append(children, describe())
+			}
+			desc += " {" + strings.Join(children, ", ") + "}"
+		}
+		return desc
+	}
+	wantSchema := "schema {account: 6 (0) 0, amount: 7 (5) [4] 1, posted: 1 (6) 1, count: 1 (13) 0, flagged: 0 0, " +
+		"tags (3) 0 {list 2 {element: 6 (0) 0}}, payee 1 {name: 6 (0) 0}}"
+	if got := describe(); got != wantSchema {
+		t.Errorf("got schema:\n%s\nwant:\n%s", got, wantSchema)
+	}
+
+	rowGroups := meta[4].([]any)
+	if len(rowGroups) != 1 {
+		t.Fatalf("got %d row groups", len(rowGroups))
+	}
+	chunks := rowGroups[0].(map[int16]any)[1].([]any)
+
+	// page returns the levels and values of the data page of a column.
+	page := func(name string, maxRep, maxDef int) (rep, def []int, values []byte) {
+		t.Helper()
+		for _, chunk := range chunks {
+			colMeta := chunk.(map[int16]any)[3].(map[int16]any)
+			path := []string{}
+			for _, part := range colMeta[3].([]any) {
+				path = append(path, string(part.([]byte)))
+			}
+			if strings.Join(path, ".") != name {
+				continue
+			}
+			header, pageData := readThrift(t, data[colMeta[9].(int64):])
+			dataHeader := header[5].(map[int16]any)
+			count := int(dataHeader[1].(int64))
+			if colMeta[5] != int64(count) {
+				t.Errorf("%s: got %v values in the chunk, %d in the page", name, colMeta[5], count)
+			}
+			pageData = pageData[:header[2].(int64)]
+			if maxRep > 0 {
+				rep, pageData = readLevels(t, pageData, count, maxRep)
+			}
+			if maxDef > 0 {
+				def, pageData = readLevels(t, pageData, count, maxDef)
+			}
+			return rep, def, pageData
+		}
+		t.Fatalf("no column %s", name)
+		return nil, nil, nil
+	}
+	byteArrays := func(values []byte) []string {
+		out := []string{}
+		for len(values) > 0 {
+			size := binary.LittleEndian.Uint32(values)
+			out = append(out, string(values[4:4+size]))
+			values = values[4+size:]
+		}
+		return out
+	}
+
+	if _, _, values := page("account", 0, 0); !reflect.DeepEqual(byteArrays(values), []string{"ACME", "B", "ACME"}) {
+		t.Errorf("account: got %q", byteArrays(values))
+	}
+
+	_, def, values := page("amount", 0, 1)
+	wantAmount := []byte{0xff, 0xff, 0xfb, 0x1e, 0xff, 0xff, 0xfb, 0x1e} // -1250 twice, big endian
+	if !reflect.DeepEqual(def, []int{1, 0, 1}) || !bytes.Equal(values, wantAmount) {
+		t.Errorf("amount: got levels %v, values % x", def, values)
+	}
+
+	if _, def, values := page("posted", 0, 1); !reflect.DeepEqual(def, []int{1, 0, 1}) || binary.LittleEndian.Uint32(values) != 19753 {
+		t.Errorf("posted: got levels %v, values % x", def, values)
+	}
+
+	if _, _, values := page("flagged", 0, 0); !bytes.Equal(values, []byte{0b101}) {
+		t.Errorf("flagged: got % x", values)
+	}
+
+	rep, def, values := page("tags.list.element", 1, 1)
+	if !reflect.DeepEqual(rep, []int{0, 1, 0, 0, 1}) || !reflect.DeepEqual(def, []int{1, 1, 0, 1, 1}) {
+		t.Errorf("tags: got repetition %v, definition %v", rep, def)
+	}
+	if got := byteArrays(values); !reflect.DeepEqual(got, []string{"a", "b", "a", "b"}) {
+		t.Errorf("tags: got %q", got)
+	}
+
+	_, def, values = page("payee.name", 0, 1)
+	if !reflect.DeepEqual(def, []int{1, 0, 1}) || !reflect.DeepEqual(byteArrays(values), []string{"Bob", "Bob"}) {
+		t.Errorf("payee.name: got levels %v, values %q", def, byteArrays(values))
+	}
+}
+
+func TestDecimalSize(t *testing.T) {
+	for precision, want := range map[int32]int{1: 1, 2: 1, 3: 2, 4: 2, 7: 4, 9: 4, 10: 5, 18: 8, 38: 16} {
+		if got := decimalSize(precision); got != want {
+			t.Errorf("precision %d: got %d bytes, want %d", precision, got, want)
+		}
+	}
+}
@@ -0,0 +1,422 @@
+// Package recordio reads and writes the records of files framed the ways
+// mainframes and card networks send them: on lines, as fixed length records,
+// behind record descriptor words, or in fixed size blocks, in ASCII or EBCDIC.
+package recordio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Charset translates between the bytes of a file and ISO-8859-1.
+type Charset struct {
+	decode *[256]byte
+	encode *[256]byte
+}
+
+var latin1Identity = func() *[256]byte {
+	table := &[256]byte{}
+	for idx := range table {
+		table[idx] = byte(idx)
+	}
+	return table
+}()
+
+var (
+	// Latin1 is ISO-8859-1, of which ASCII is the lower half.
+	Latin1 = Charset{decode: latin1Identity, encode: latin1Identity}
+
+	// EBCDIC is code page 037, as used by IBM mainframes in the US.
+	EBCDIC = Charset{decode: &ebcdicToLatin1, encode: &latin1ToEBCDIC}
+)
+
+// CharsetByName returns ascii (or latin1) and ebcdic (or cp037).
+func CharsetByName(name string) (Charset, error) {
+	switch name {
+	case "ascii", "latin1":
+		return Latin1, nil
+	case "ebcdic", "cp037":
+		return EBCDIC, nil
+	default:
+		return Charset{}, fmt.Errorf("unknown character set %q, expected ascii or ebcdic", name)
+	}
+}
+
+// DecodeByte translates a byte of the charset to ISO-8859-1.
+func (c Charset) DecodeByte(b byte) byte {
+	return c.decode[b]
+}
+
+// EncodeByte translates an ISO-8859-1 byte to the charset.
+func (c Charset) EncodeByte(b byte) byte {
+	return c.encode[b]
+}
+
+// Decode translates the bytes of the charset to ISO-8859-1 in place.
+func (c Charset) Decode(data []byte) {
+	for idx, b := range data {
+		data[idx] = c.decode[b]
+	}
+}
+
+// Encode translates ISO-8859-1 bytes to the charset in place.
+func (c Charset) Encode(data []byte) {
+	for idx, b := range data {
+		data[idx] = c.encode[b]
+	}
+}
+
+// Framing is how the records of a file are separated.
+type Framing string
+
+const (
+	// Newline records end in a newline of the file's charset, and an
+	// optional carriage return before it. Newline framing cannot hold binary
+	// fields which may contain the newline byte.
+	Newline Framing = "newline"
+
+	// RDW records start with a four byte record descriptor word, as on z/OS
+	// variable length files: a big endian length including the word, and two
+	// zero bytes.
+	RDW Framing = "rdw"
+
+	// Fixed records are a set length with no separator.
+	Fixed Framing = "fixed"
+)
+
+// Reader reads the records of a file.
+type Reader struct {
+	r       *bufio.Reader
+	read    func() ([]byte, error)
+	padding *byte
+
+	// offset is the position in the file of the next record.
+	offset int
+}
+
+// FramingError is returned by Reader.Read when the framing of a record is
+// broken, such as a record descriptor word shorter than itself. It locates
+// the record in the file, with the bytes of its framing for a hex dump.
+type FramingError struct {
+	// Offset is the position of the record in the file.
+	Offset int
+
+	// Context is a copy of the bytes of the file from the start of the
+	// record: the record descriptor word and what follows it.
+	Context []byte
+
+	Err error
+}
+
+func (e *FramingError) Error() string {
+	return fmt.Sprintf("record at file offset %d: %s [bytes from offset %d: % x]", e.Offset, e.Err, e.Offset, e.Context)
+}
+
+func (e *FramingError) Unwrap() error {
+	return e.Err
+}
+
+// HexDump formats Context as rows of 16 bytes labelled with their offsets in
+// the file.
+func (e *FramingError) HexDump() string {
+	return HexDump(e.Context, e.Offset)
+}
+
+// HexDump formats data as rows of 16 bytes, each labelled with the offset of
+// its first byte, counting from offset, and followed by its printable ASCII.
+func HexDump(data []byte, offset int) string {
+	out := &strings.Builder{}
+	for start := 0; start < len(data); start += 16 {
+		row := data[start:min(start+16, len(data))]
+		fmt.Fprintf(out, "%08x  % -47x  |%s|\n", offset+start, row, printable(row))
+	}
+	return out.String()
+}
+
+func printable(data []byte) string {
+	out := make([]byte, len(data))
+	for idx, b := range data {
+		if b < 0x20 || b > 0x7e {
+			b = '.'
+		}
+		out[idx] = b
+	}
+	return string(out)
+}
+
+// framingContextBytes is how many bytes after a record descriptor word are
+// kept in FramingError.Context.
+const framingContextBytes = 16
+
+// ReaderOption configures a Reader.
+type ReaderOption func(*Reader)
+
+// WithPadding ends the file at a record which starts with the pad byte,
+// which must be followed only by more padding, as blocked files fill their
+// last block.
+func WithPadding(pad byte) ReaderOption {
+	return func(r *Reader) {
+		r.padding = &pad
+	}
+}
+
+// NewReader reads records of the framing. The charset is used to find the
+// newlines of newline framing; records are returned as they are in the file.
+// recordLength is only used by fixed framing.
+func NewReader(r io.Reader, framing Framing, set Charset, recordLength int, opts ...ReaderOption) (*Reader, error) {
+	reader := &Reader{r: bufio.NewReader(r)}
+	for _, opt := range opts {
+		opt(reader)
+	}
+	br := reader.r
+
+	switch framing {
+	case Newline:
+		newline := set.EncodeByte('\n')
+		carriageReturn := set.EncodeByte('\r')
+		reader.read = func() ([]byte, error) {
+			line, err := br.ReadBytes(newline)
+			if err == io.EOF && len(line) > 0 {
+				err = nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			reader.offset += len(line)
+			line = bytes.TrimSuffix(line, []byte{newline})
+			return bytes.TrimSuffix(line, []byte{carriageReturn}), nil
+		}
+
+	case RDW:
+		reader.read = func() ([]byte, error) {
+			header := make([]byte, 4)
+			n, err := io.ReadFull(br, header)
+			if err != nil {
+				if err == io.ErrUnexpectedEOF {
+					return nil, reader.framingError(header[:n], errors.New("truncated record descriptor word"))
+				}
+				return nil, err
+			}
+			length := int(binary.BigEndian.Uint16(header))
+			if length < 4 {
+				next, _ := br.Peek(framingContextBytes)
+				return nil, reader.framingError(append(header, next...), fmt.Errorf("record descriptor word length %d is less than 4", length))
+			}
+			record := make([]byte, length-4)
+			if n, err := io.ReadFull(br, record); err != nil {
+				got := record[:min(n, framingContextBytes)]
+				return nil, reader.framingError(append(header, got...), fmt.Errorf("reading %d byte record: %w", length-4, err))
+			}
+			reader.offset += length
+			return record, nil
+		}
+
+	case Fixed:
+		if recordLength <= 0 {
+			return nil, errors.New("fixed framing needs a record length")
+		}
+		reader.read = func() ([]byte, error) {
+			record := make([]byte, recordLength)
+			if _, err := io.ReadFull(br, record); err != nil {
+				if err == io.ErrUnexpectedEOF {
+					return nil, fmt.Errorf("file does not end on a %d byte record", recordLength)
+				}
+				return nil, err
+			}
+			reader.offset += recordLength
+			return record, nil
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown framing %q, expected newline, rdw or fixed", framing)
+	}
+	return reader, nil
+}
+
+// Read returns the next record, or io.EOF after the last.
+func (r *Reader) Read() ([]byte, error) {
+	if r.padding != nil {
+		next, err := r.r.Peek(1)
+		if err == nil && next[0] == *r.padding {
+			return nil, r.skipPadding()
+		}
+	}
+	return r.read()
+}
+
+// framingError locates a framing failure at the record being read, with a
+// copy of the bytes read from it.
+func (r *Reader) framingError(context []byte, err error) *FramingError {
+	return &FramingError{
+		Offset:  r.offset,
+		Context: bytes.Clone(context),
+		Err:     err,
+	}
+}
+
+func (r *Reader) skipPadding() error {
+	for {
+		b, err := r.r.ReadByte()
+		if err == io.EOF {
+			return io.EOF
+		} else if err != nil {
+			return err
+		}
+		if b != *r.padding {
+			return fmt.Errorf("byte 0x%02x in the padding after the last record", b)
+		}
+	}
+}
+
+// Writer writes the records of a file.
+type Writer struct {
+	w     *bufio.Writer
+	write func([]byte) error
+}
+
+// NewWriter writes records of the framing, ending newline records with a
+// newline of the charset. The records are written as given. recordLength is
+// only used by fixed framing.
+func NewWriter(w io.Writer, framing Framing, set Charset, recordLength int) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+	writer := &Writer{w: bw}
+
+	switch framing {
+	case Newline:
+		newline := set.EncodeByte('\n')
+		writer.write = func(record []byte) error {
+			if _, err := bw.Write(record); err != nil {
+				return err
+			}
+			return bw.WriteByte(newline)
+		}
+
+	case RDW:
+		writer.write = func(record []byte) error {
+			if len(record)+4 > 0xffff {
+				return fmt.Errorf("%d bytes is too long for a record descriptor word", len(record))
+			}
+			header := []byte{0, 0, 0, 0}
+			binary.BigEndian.PutUint16(header, uint16(len(record)+4))
+			if _, err := bw.Write(header); err != nil {
+				return err
+			}
+			_, err := bw.Write(record)
+			return err
+		}
+
+	case Fixed:
+		if recordLength <= 0 {
+			return nil, errors.New("fixed framing needs a record length")
+		}
+		writer.write = func(record []byte) error {
+			if len(record) != recordLength {
+				return fmt.Errorf("%d bytes does not fit fixed framing of %d byte records", len(record), recordLength)
+			}
+			_, err := bw.Write(record)
+			return err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown framing %q, expected newline, rdw or fixed", framing)
+	}
+	return writer, nil
+}
+
+// Write writes a record.
+func (w *Writer) Write(record []byte) error {
+	return w.write(record)
+}
+
+// Flush writes any buffered records to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// BlockSize is the data of each block of a blocked file, which is followed
+// by two pad bytes, as in the 1014 byte blocks of Mastercard clearing files.
+const BlockSize = 1012
+
+// BlockPadding is the pad byte of blocked files, 0x40, an EBCDIC space or an
+// ASCII '@'.
+const BlockPadding = 0x40
+
+// Unblock reads the data of a blocked file, dropping the two pad bytes after
+// each block. The padding which fills the last block is returned as data;
+// read records with WithPadding(BlockPadding) to end the file there.
+func Unblock(r io.Reader) io.Reader {
+	return &unblocker{r: bufio.NewReader(r)}
+}
+
+type unblocker struct {
+	r *bufio.Reader
+	// left is the data left in the current block.
+	left   int
+	blocks int
+}
+
+func (u *unblocker) Read(p []byte) (int, error) {
+	if u.left == 0 {
+		if u.blocks > 0 {
+			if _, err := u.r.Discard(2); err != nil {
+				return 0, err
+			}
+		}
+		if _, err := u.r.Peek(1); err != nil {
+			return 0, err
+		}
+		u.left = BlockSize
+		u.blocks++
+	}
+	n, err := u.r.Read(p[:min(len(p), u.left)])
+	u.left -= n
+	return n, err
+}
+
+// BlockWriter writes a blocked file.
+type BlockWriter struct {
+	w io.Writer
+	// used is the data in the current block.
+	used int
+}
+
+// NewBlockWriter writes data in blocks of BlockSize bytes, each followed by
+// two pad bytes. Close fills the last block.
+func NewBlockWriter(w io.Writer) *BlockWriter {
+	return &BlockWriter{w: w}
+}
+
+func (b *BlockWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n, err := b.w.Write(p[:min(len(p), BlockSize-b.used)])
+		written += n
+		b.used += n
+		p = p[n:]
+		if err != nil {
+			return written, err
+		}
+		if b.used == BlockSize {
+			if _, err := b.w.Write([]byte{BlockPadding, BlockPadding}); err != nil {
+				return written, err
+			}
+			b.used = 0
+		}
+	}
+	return written, nil
+}
+
+// Close pads the last block, leaving the underlying writer open.
+func (b *BlockWriter) Close() error {
+	if b.used == 0 {
+		return nil
+	}
+	_, err := b.w.Write(bytes.Repeat([]byte{BlockPadding}, BlockSize-b.used+2))
+	b.used = 0
+	return err
+}
@@ -0,0 +1,155 @@
+package recordio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCharset(t *testing.T) {
+	data := make([]byte, 256)
+	for idx := range data {
+		data[idx] = byte(idx)
+	}
+	EBCDIC.Encode(data)
+	if data['A'] != 0xc1 || data['0'] != 0xf0 || data[' '] != 0x40 {
+		t.Errorf("got A % x, 0 % x, space % x", data['A'], data['0'], data[' '])
+	}
+	EBCDIC.Decode(data)
+	for idx, b := range data {
+		if b != byte(idx) {
+			t.Fatalf("byte %d decoded as %d", idx, b)
+		}
+	}
+}
+
+func TestFraming(t *testing.T) {
+	records := [][]byte{[]byte("ONE"), []byte("TWO"), {0x00, '\n', 0xff}}
+	for _, framing := range []Framing{RDW, Fixed} {
+		buf := &bytes.Buffer{}
+		writer, err := NewWriter(buf, framing, EBCDIC, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, record := range records {
+			if err := writer.Write(record); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		reader, err := NewReader(buf, framing, EBCDIC, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for idx, want := range records {
+			got, err := reader.Read()
+			if err != nil {
+				t.Fatalf("%s: record %d: %v", framing, idx+1, err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s: got record % x, want % x", framing, got, want)
+			}
+		}
+		if _, err := reader.Read(); err != io.EOF {
+			t.Errorf("%s: got %v, want EOF", framing, err)
+		}
+	}
+
+	if _, err := NewReader(strings.NewReader(""), "lines", Latin1, 0); err == nil {
+		t.Error("expected an error for an unknown framing")
+	}
+}
+
+func TestFramingError(t *testing.T) {
+	// The second record descriptor word gives a length of 2.
+	data := append([]byte{0x00, 0x07, 0x00, 0x00, 'O', 'N', 'E'}, 0x00, 0x02, 0x00, 0x00, 'T', 'W', 'O')
+	reader, err := NewReader(bytes.NewReader(data), RDW, Latin1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reader.Read(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = reader.Read()
+	framingErr := &FramingError{}
+	if !errors.As(err, &framingErr) {
+		t.Fatalf("expected FramingError, got %v", err)
+	}
+	if framingErr.Offset != 7 || !bytes.Equal(framingErr.Context, data[7:]) {
+		t.Errorf("got offset %d, context % x", framingErr.Offset, framingErr.Context)
+	}
+	if want := "00000007  00 02 00 00 54 57 4f                             |....TWO|\n"; framingErr.HexDump() != want {
+		t.Errorf("got dump\n%q, want\n%q", framingErr.HexDump(), want)
+	}
+
+	// A record shorter than its descriptor word keeps the bytes read.
+	reader, err = NewReader(bytes.NewReader([]byte{0x00, 0x0a, 0x00, 0x00, 'A', 'B'}), RDW, Latin1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = reader.Read()
+	if !errors.As(err, &framingErr) || !errors.Is(err, io.ErrUnexpectedEOF) || framingErr.Offset != 0 || len(framingErr.Context) != 6 {
+		t.Errorf("got %v", err)
+	}
+	if !strings.Contains(err.Error(), "[bytes from offset 0: 00 0a 00 00 41 42]") {
+		t.Errorf("got error %q", err)
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	// Three records span a block boundary, and the last block is padded.
+	record := bytes.Repeat([]byte("R"), 400)
+	buf := &bytes.Buffer{}
+	blocks := NewBlockWriter(buf)
+	for range 3 {
+		if _, err := blocks.Write(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := blocks.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 2*(BlockSize+2) {
+		t.Fatalf("got %d bytes, want two blocks", buf.Len())
+	}
+	if trailer := buf.Bytes()[BlockSize : BlockSize+2]; !bytes.Equal(trailer, []byte{BlockPadding, BlockPadding}) {
+		t.Errorf("got block trailer % x", trailer)
+	}
+
+	reader, err := NewReader(Unblock(bytes.NewReader(buf.Bytes())), Fixed, Latin1, len(record), WithPadding(BlockPadding))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx := range 3 {
+		got, err := reader.Read()
+		if err != nil {
+			t.Fatalf("record %d: %v", idx+1, err)
+		}
+		if !bytes.Equal(got, record) {
+			t.Errorf("record %d is not as written", idx+1)
+		}
+	}
+	if _, err := reader.Read(); err != io.EOF {
+		t.Errorf("got %v, want EOF at the padding", err)
+	}
+
+	// Data in the padding is an error rather than lost.
+	data := append(bytes.Clone(buf.Bytes()[:BlockSize+2]), []byte("R@@X")...)
+	reader, err = NewReader(Unblock(bytes.NewReader(data)), Fixed, Latin1, 1, WithPadding(BlockPadding))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err = reader.Read(); err != nil {
+			break
+		}
+	}
+	if err == io.EOF {
+		t.Error("expected an error for data in the padding")
+	}
+}